@@ -43,11 +43,10 @@ func TestBasicGo(t *testing.T) {
 func TestKafkaStoreUpdateGo(t *testing.T) {
 	apiKey, server := getCredentials(t)
 
-	data, err := os.ReadFile("credentials.yaml")
-	require.NoError(t, err)
+	data := readCredentials(t)
 
 	var creds KafkaCreds
-	err = yaml.Unmarshal(data, &creds)
+	err := yaml.Unmarshal(data, &creds)
 	require.NoError(t, err)
 
 	if creds.SaslKafkaUris == "" || creds.SaslUser == "" || creds.SaslPass == "" || creds.IamKafkaUris == "" || creds.MskRole == "" || creds.MskRegion == "" {
@@ -97,11 +96,10 @@ func TestKafkaStoreUpdateGo(t *testing.T) {
 func TestKafkaStoreWithCaCertGo(t *testing.T) {
 	apiKey, server := getCredentials(t)
 
-	data, err := os.ReadFile("credentials.yaml")
-	require.NoError(t, err)
+	data := readCredentials(t)
 
 	var creds KafkaCreds
-	err = yaml.Unmarshal(data, &creds)
+	err := yaml.Unmarshal(data, &creds)
 	require.NoError(t, err)
 
 	if creds.KafkaWithCaUris == "" || creds.KafkaWithCaScramUser == "" || creds.KafkaWithCaScramPass == "" || creds.KafkaWithCaCert == "" {
@@ -152,11 +150,10 @@ func TestKafkaStoreWithCaCertGo(t *testing.T) {
 
 func TestSnowflakeStoreUpdateGo(t *testing.T) {
 	apiKey, server := getCredentials(t)
-	data, err := os.ReadFile("credentials.yaml")
-	require.NoError(t, err)
+	data := readCredentials(t)
 
 	var creds SnowflakeCreds
-	err = yaml.Unmarshal(data, &creds)
+	err := yaml.Unmarshal(data, &creds)
 	require.NoError(t, err)
 	if creds.SnowflakeUris == "" || creds.SnowflakeAccountId == "" || creds.SnowflakeRoleName == "" || creds.SnowflakeUsername == "" || creds.SnowflakeWarehouseName == "" || creds.SnowflakeCloudRegion == "" || creds.SnowflakeClientKey == "" {
 		t.Skip("Skipping Snowflake store update test: missing required snowflake credentials")
@@ -240,11 +237,10 @@ func TestSnowflakeStoreUpdateGo(t *testing.T) {
 
 func TestPostgresStoreUpdateGo(t *testing.T) {
 	apiKey, server := getCredentials(t)
-	data, err := os.ReadFile("credentials.yaml")
-	require.NoError(t, err)
+	data := readCredentials(t)
 
 	var creds PostgresCreds
-	err = yaml.Unmarshal(data, &creds)
+	err := yaml.Unmarshal(data, &creds)
 	require.NoError(t, err)
 	if creds.PostgresUris == "" || creds.PostgresUsername == "" || creds.PostgresPassword == "" {
 		t.Skip("Skipping Postgres store update test: missing required postgres credentials")
@@ -324,9 +320,8 @@ func TestQueryGo(t *testing.T) {
 	apiKey, server := getCredentials(t)
 
 	var creds KafkaCreds
-	data, err := os.ReadFile("credentials.yaml")
-	require.NoError(t, err)
-	err = yaml.Unmarshal(data, &creds)
+	data := readCredentials(t)
+	err := yaml.Unmarshal(data, &creds)
 	require.NoError(t, err)
 
 	if creds.IamKafkaUris == "" || creds.MskRole == "" || creds.MskRegion == "" {
@@ -374,9 +369,8 @@ func TestApplicationGo(t *testing.T) {
 		SnowflakeCreds `yaml:",inline"`
 		PostgresCreds  `yaml:",inline"`
 	}
-	data, err := os.ReadFile("credentials.yaml")
-	require.NoError(t, err)
-	err = yaml.Unmarshal(data, &creds)
+	data := readCredentials(t)
+	err := yaml.Unmarshal(data, &creds)
 	require.NoError(t, err)
 
 	if creds.IamKafkaUris == "" || creds.MskRole == "" || creds.MskRegion == "" {
@@ -4,7 +4,6 @@
 package examples
 
 import (
-	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -39,9 +38,8 @@ func TestQueryTs(t *testing.T) {
 	apiKey, server := getCredentials(t)
 
 	var creds KafkaCreds
-	data, err := os.ReadFile("credentials.yaml")
-	require.NoError(t, err)
-	err = yaml.Unmarshal(data, &creds)
+	data := readCredentials(t)
+	err := yaml.Unmarshal(data, &creds)
 	require.NoError(t, err)
 
 	if creds.IamKafkaUris == "" || creds.MskRole == "" || creds.MskRegion == "" {
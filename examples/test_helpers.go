@@ -1,7 +1,9 @@
 package examples
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,6 +11,8 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/testing/integration"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+
+	"github.com/deltastreaminc/pulumi-deltastream/internal/fakeds"
 )
 
 type KafkaCreds struct {
@@ -106,17 +110,66 @@ func getGoBaseOptions(t *testing.T) integration.ProgramTestOptions {
 	return baseJS
 }
 
-func getCredentials(t *testing.T) (string, string) {
+// fakeCredentials stands in for credentials.yaml when it is absent. The store settings are
+// placeholders: the fake accepts them without connecting to anything.
+const fakeCredentials = `
+saslKafkaUris: "kafka.fake.invalid:9096"
+saslUser: "user"
+saslPass: "pass"
+iamKafkaUris: "kafka.fake.invalid:9098"
+mskRole: "arn:aws:iam::000000000000:role/fake"
+mskRegion: "us-east-1"
+kafkaWithCaUris: "kafka.fake.invalid:9094"
+kafkaWithCaScramUser: "user"
+kafkaWithCaScramPass: "pass"
+kafkaWithCaCert: "ZmFrZSBjZXJ0aWZpY2F0ZQ=="
+snowflakeUris: "https://fake.snowflakecomputing.invalid"
+snowflakeAccountId: "fake"
+snowflakeRoleName: "role"
+snowflakeUsername: "user"
+snowflakeWarehouseName: "warehouse"
+snowflakeCloudRegion: "AWS us-east-1"
+snowflakeClientKey: "ZmFrZSBrZXk="
+postgresUris: "postgresql://postgres.fake.invalid:5432/db"
+postgresUsername: "user"
+postgresPassword: "pass"
+postgresDatabase: "db"
+postgresCdcSlotName: "slot"
+`
+
+// readCredentials returns credentials.yaml, or fakeCredentials when the file does not exist.
+func readCredentials(t *testing.T) []byte {
 	t.Helper()
-
 	data, err := os.ReadFile("credentials.yaml")
+	if errors.Is(err, fs.ErrNotExist) {
+		return []byte(fakeCredentials)
+	}
 	require.NoError(t, err)
+	return data
+}
+
+// getCredentials returns the API key and server from credentials.yaml. Without the file it
+// starts an in-memory DeltaStream serving the v2 statement API for the duration of t, so the
+// examples run against the real driver without an account.
+func getCredentials(t *testing.T) (string, string) {
+	t.Helper()
+
+	if _, err := os.Stat("credentials.yaml"); errors.Is(err, fs.ErrNotExist) {
+		const apiKey = "fake-api-key"
+		f := fakeds.New()
+		// New organizations come with a default database.
+		_, err := f.Exec(&fakeds.Session{Role: "sysadmin"}, `CREATE DATABASE "default_db"`)
+		require.NoError(t, err)
+		server, stop := f.Start(apiKey)
+		t.Cleanup(stop)
+		return apiKey, server
+	}
 
 	var creds struct {
 		APIKey string `yaml:"apiKey"`
 		Server string `yaml:"server"`
 	}
-	err = yaml.Unmarshal(data, &creds)
+	err := yaml.Unmarshal(readCredentials(t), &creds)
 	require.NoError(t, err)
 
 	if creds.APIKey == "" || creds.Server == "" {
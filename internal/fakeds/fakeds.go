@@ -0,0 +1,996 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fakeds is an in-memory stand-in for a DeltaStream organization. Server interprets
+// the statements the provider issues: DDL for databases, namespaces, stores, relations,
+// entities, compute pools, secrets, descriptor and function sources and functions, DESCRIBE
+// plans, query start and termination, and SELECTs over the deltastream.sys catalogs.
+//
+// Server.Handler serves it over the DeltaStream v2 statement API, so the provider binary and
+// the examples can run against it with the real driver and without credentials. Provider
+// unit tests call Server.Exec directly. Statements it does not recognise fail with an error.
+package fakeds
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SQL states returned for the errors the provider handles specially.
+const (
+	StateSuccess         = "00000"
+	StateInvalidDatabase = "3D018"
+	StateInvalidSchema   = "3F000"
+	StateInvalidRelation = "42P01"
+	StateInvalidStore    = "3D020"
+	StateInvalidQuery    = "3D021"
+	// StateError is returned for every other failure.
+	StateError = "42000"
+)
+
+// SQLError is a statement failure with its SQL state.
+type SQLError struct {
+	State   string
+	Message string
+}
+
+func (e SQLError) Error() string { return e.Message }
+
+// Server holds the state of one fake organization. The exported maps may be read by tests
+// between statements.
+type Server struct {
+	mu         sync.Mutex
+	clock      time.Time
+	nextQuery  int
+	Databases  map[string]*Entry
+	Schemas    map[string]*Entry // keyed by "<database>/<name>"
+	Stores     map[string]*Entry
+	Relations  map[string]*Relation // keyed by "<database>/<namespace>/<name>"
+	Queries    map[string]*Query
+	Entities   map[string]*Entry // keyed by "<store>/<name>"
+	Named      map[string]*Entry // compute pools, secrets, sources and functions, keyed by "<kind>/<name>"
+	statements []string
+	results    map[string]*resultSet // HTTP results by statement ID
+	nextResult int
+}
+
+// Entry is a catalog row for objects identified by name.
+type Entry struct {
+	Owner     string
+	Status    string
+	Props     map[string]string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Relation is a stream, changelog or table.
+type Relation struct {
+	Entry
+	Path  []string
+	Type  string
+	Store string
+	DDL   string
+}
+
+// Query is a running or terminated query or application.
+type Query struct {
+	Entry
+	ID    string
+	DSQL  string
+	State string
+	Plan  map[string]any
+	uses  []string // keys of relations read or written
+}
+
+// Session is the statement context: the role statements run as, the defaults for unqualified
+// names, and the attachments uploaded with the statement being run, by label.
+type Session struct {
+	Role, Database, Schema, Store string
+	Attachments                   map[string][]byte
+}
+
+// Result is a materialized result set. Values are string, int64, time.Time or nil.
+type Result struct {
+	Columns []string
+	Rows    [][]any
+}
+
+// New returns an empty organization.
+func New() *Server {
+	return &Server{
+		clock:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Databases: map[string]*Entry{},
+		Schemas:   map[string]*Entry{},
+		Stores:    map[string]*Entry{},
+		Relations: map[string]*Relation{},
+		Queries:   map[string]*Query{},
+		Entities:  map[string]*Entry{},
+		Named:     map[string]*Entry{},
+		results:   map[string]*resultSet{},
+	}
+}
+
+// Executed returns the statements run so far, in order.
+func (f *Server) Executed() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.statements)
+}
+
+const (
+	identPat = `("(?:[^"]|"")*"|[A-Za-z_][A-Za-z0-9_]*)`
+	namePat  = `((?:"(?:[^"]|"")*"|[A-Za-z_][A-Za-z0-9_]*)(?:\.(?:"(?:[^"]|"")*"|[A-Za-z_][A-Za-z0-9_]*))*)`
+)
+
+var (
+	createDatabaseRe  = regexp.MustCompile(`(?is)^CREATE DATABASE ` + identPat + `$`)
+	dropDatabaseRe    = regexp.MustCompile(`(?is)^DROP DATABASE ` + identPat + `$`)
+	createSchemaRe    = regexp.MustCompile(`(?is)^CREATE SCHEMA ` + identPat + ` IN DATABASE ` + identPat + `$`)
+	dropSchemaRe      = regexp.MustCompile(`(?is)^DROP SCHEMA ` + identPat + `\.` + identPat + `$`)
+	createStoreRe     = regexp.MustCompile(`(?is)^CREATE STORE ` + identPat + `\s+WITH\s*\((.*)\)$`)
+	updateStoreRe     = regexp.MustCompile(`(?is)^UPDATE STORE ` + identPat + `\s+WITH\s*\((.*)\)$`)
+	dropStoreRe       = regexp.MustCompile(`(?is)^DROP STORE ` + identPat + `$`)
+	describeStoreRe   = regexp.MustCompile(`(?is)^DESCRIBE STORE ` + identPat + `$`)
+	ownerRe           = regexp.MustCompile(`(?is)^ALTER (DATABASE|SCHEMA|STORE|STREAM|CHANGELOG|TABLE|QUERY) (\S+) OWNER TO ` + identPat + `$`)
+	createRelationRe  = regexp.MustCompile(`(?is)^CREATE (STREAM|CHANGELOG|TABLE) ` + namePat + `\s*(.*)$`)
+	alterRelationRe   = regexp.MustCompile(`(?is)^ALTER (STREAM|CHANGELOG|TABLE) ` + namePat + ` (ADD COLUMN|SET) .*$`)
+	dropRelationRe    = regexp.MustCompile(`(?is)^DROP RELATION ` + namePat + `$`)
+	terminateRe       = regexp.MustCompile(`(?is)^TERMINATE QUERY (\S+)$`)
+	namedCreateRe     = regexp.MustCompile(`(?is)^CREATE (COMPUTE_POOL|SECRET|DESCRIPTOR_SOURCE|FUNCTION_SOURCE) ` + identPat + `(?:\s+WITH\s*\((.*)\))?$`)
+	namedUpdateRe     = regexp.MustCompile(`(?is)^UPDATE (COMPUTE_POOL|SECRET) ` + identPat + `\s+WITH\s*\((.*)\)$`)
+	namedDropRe       = regexp.MustCompile(`(?is)^DROP (COMPUTE_POOL|SECRET|DESCRIPTOR_SOURCE|FUNCTION_SOURCE) ` + identPat + `$`)
+	createFunctionRe  = regexp.MustCompile(`(?is)^CREATE FUNCTION ` + identPat + `\s*\((.*)\)\s+RETURNS\s+(.+?)\s+LANGUAGE\s+(\w+)\s+WITH\s*\((.*)\)$`)
+	dropFunctionRe    = regexp.MustCompile(`(?is)^DROP FUNCTION ` + identPat + `\s*\((.*)\)$`)
+	createEntityRe    = regexp.MustCompile(`(?is)^CREATE ENTITY ` + identPat + ` IN STORE ` + identPat + `(?:\s+WITH\s*\((.*)\))?$`)
+	updateEntityRe    = regexp.MustCompile(`(?is)^UPDATE ENTITY ` + identPat + ` IN STORE ` + identPat + `\s+WITH\s*\((.*)\)$`)
+	dropEntityRe      = regexp.MustCompile(`(?is)^DROP ENTITY ` + identPat + ` IN STORE ` + identPat + `$`)
+	listEntitiesRe    = regexp.MustCompile(`(?is)^LIST ENTITIES IN STORE ` + identPat + `$`)
+	poolRunRe         = regexp.MustCompile(`(?is)^(START|STOP|USE) COMPUTE_POOL ` + identPat + `$`)
+	selectRe          = regexp.MustCompile(`(?is)^SELECT\s+(.+?)\s+FROM\s+deltastream\.sys\."?(\w+)"?(?:\s+WHERE\s+(.+?))?(?:\s+ORDER\s+BY\s+(.+?))?(?:\s+LIMIT\s+(\d+))?$`)
+	conditionRe       = regexp.MustCompile(`(?is)^"?(\w+)"?\s*=\s*'((?:[^']|'')*)'$`)
+	andRe             = regexp.MustCompile(`(?i)\s+AND\s+`)
+	insertSinkRe      = regexp.MustCompile(`(?is)\bINSERT INTO\s+` + namePat)
+	sourceRe          = regexp.MustCompile(`(?is)\b(?:FROM|JOIN)\s+` + namePat)
+	virtualRe         = regexp.MustCompile(`(?is)\bCREATE VIRTUAL (?:STREAM|CHANGELOG)\s+` + namePat)
+	applicationBodyRe = regexp.MustCompile(`(?is)^BEGIN APPLICATION \S+\s+(.*)\s+END APPLICATION$`)
+)
+
+// Exec runs one statement in the context of session c and returns its result set.
+func (f *Server) Exec(c *Session, query string) (*Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stmt := strings.TrimSuffix(strings.TrimSpace(query), ";")
+	stmt = strings.TrimSpace(stmt)
+	f.statements = append(f.statements, stmt)
+	f.clock = f.clock.Add(time.Second)
+
+	if m := selectRe.FindStringSubmatch(stmt); m != nil {
+		return f.selectCatalog(m[1], m[2], m[3], m[4], m[5])
+	}
+	if m := describeStoreRe.FindStringSubmatch(stmt); m != nil {
+		return f.describeStore(unquoteIdent(m[1]))
+	}
+	if rest, ok := cutPrefixFold(stmt, "DESCRIBE "); ok {
+		kind, plan, _, err := f.plan(c, strings.TrimSpace(rest))
+		if err != nil {
+			return nil, err
+		}
+		js, _ := json.Marshal(plan)
+		return &Result{Columns: []string{"kind", "plan"}, Rows: [][]any{{kind, string(js)}}}, nil
+	}
+	if m := createDatabaseRe.FindStringSubmatch(stmt); m != nil {
+		name := unquoteIdent(m[1])
+		if f.Databases[name] != nil {
+			return nil, fmt.Errorf("database %s already exists", name)
+		}
+		f.Databases[name] = f.entry(c, nil)
+		// New databases come with a public namespace, as on the server.
+		f.Schemas[name+"/public"] = f.entry(c, nil)
+		return &Result{}, nil
+	}
+	if m := dropDatabaseRe.FindStringSubmatch(stmt); m != nil {
+		name := unquoteIdent(m[1])
+		if f.Databases[name] == nil {
+			return nil, SQLError{State: StateInvalidDatabase, Message: "database not found"}
+		}
+		if rel := f.firstRelation(func(r *Relation) bool { return r.Path[0] == name }); rel != "" {
+			return nil, fmt.Errorf("database %s is not empty: relation %s exists", name, rel)
+		}
+		delete(f.Databases, name)
+		for k := range f.Schemas {
+			if strings.HasPrefix(k, name+"/") {
+				delete(f.Schemas, k)
+			}
+		}
+		return &Result{}, nil
+	}
+	if m := createSchemaRe.FindStringSubmatch(stmt); m != nil {
+		name, db := unquoteIdent(m[1]), unquoteIdent(m[2])
+		if f.Databases[db] == nil {
+			return nil, SQLError{State: StateInvalidDatabase, Message: "database not found"}
+		}
+		if f.Schemas[db+"/"+name] != nil {
+			return nil, fmt.Errorf("schema %s.%s already exists", db, name)
+		}
+		f.Schemas[db+"/"+name] = f.entry(c, nil)
+		return &Result{}, nil
+	}
+	if m := dropSchemaRe.FindStringSubmatch(stmt); m != nil {
+		db, name := unquoteIdent(m[1]), unquoteIdent(m[2])
+		if f.Schemas[db+"/"+name] == nil {
+			return nil, SQLError{State: StateInvalidSchema, Message: "schema not found"}
+		}
+		if rel := f.firstRelation(func(r *Relation) bool { return r.Path[0] == db && r.Path[1] == name }); rel != "" {
+			return nil, fmt.Errorf("schema %s.%s is not empty: relation %s exists", db, name, rel)
+		}
+		delete(f.Schemas, db+"/"+name)
+		return &Result{}, nil
+	}
+	if m := createStoreRe.FindStringSubmatch(stmt); m != nil {
+		name := unquoteIdent(m[1])
+		if f.Stores[name] != nil {
+			return nil, fmt.Errorf("store %s already exists", name)
+		}
+		props := parseProps(m[2])
+		if err := f.checkSecretRefs(props); err != nil {
+			return nil, err
+		}
+		e := f.entry(c, props)
+		e.Status = "ready"
+		f.Stores[name] = e
+		return &Result{}, nil
+	}
+	if m := updateStoreRe.FindStringSubmatch(stmt); m != nil {
+		e := f.Stores[unquoteIdent(m[1])]
+		if e == nil {
+			return nil, SQLError{State: StateInvalidStore, Message: "store not found"}
+		}
+		props := parseProps(m[2])
+		if err := f.checkSecretRefs(props); err != nil {
+			return nil, err
+		}
+		for k, v := range props {
+			e.Props[k] = v
+		}
+		e.UpdatedAt = f.clock
+		return &Result{}, nil
+	}
+	if m := dropStoreRe.FindStringSubmatch(stmt); m != nil {
+		name := unquoteIdent(m[1])
+		if f.Stores[name] == nil {
+			return nil, SQLError{State: StateInvalidStore, Message: "store not found"}
+		}
+		if rel := f.firstRelation(func(r *Relation) bool { return r.Store == name }); rel != "" {
+			return nil, fmt.Errorf("store %s is in use by relation %s", name, rel)
+		}
+		delete(f.Stores, name)
+		return &Result{}, nil
+	}
+	if m := ownerRe.FindStringSubmatch(stmt); m != nil {
+		return f.alterOwner(c, strings.ToUpper(m[1]), m[2], unquoteIdent(m[3]))
+	}
+	if m := createRelationRe.FindStringSubmatch(stmt); m != nil {
+		return f.createRelation(c, stmt)
+	}
+	if m := alterRelationRe.FindStringSubmatch(stmt); m != nil {
+		rel, err := f.relation(c, m[2])
+		if err != nil {
+			return nil, err
+		}
+		rel.UpdatedAt = f.clock
+		return &Result{}, nil
+	}
+	if m := dropRelationRe.FindStringSubmatch(stmt); m != nil {
+		rel, err := f.relation(c, m[1])
+		if err != nil {
+			return nil, err
+		}
+		key := strings.Join(rel.Path, "/")
+		for _, q := range f.Queries {
+			if !terminal(q.State) && slices.Contains(q.uses, key) {
+				return nil, fmt.Errorf("relation %s is in use by query %s", fqn(rel.Path), q.ID)
+			}
+		}
+		delete(f.Relations, key)
+		return &Result{}, nil
+	}
+	if hasPrefixFold(stmt, "INSERT INTO ") || hasPrefixFold(stmt, "BEGIN APPLICATION ") {
+		return f.startQuery(c, stmt)
+	}
+	if m := terminateRe.FindStringSubmatch(stmt); m != nil {
+		q := f.Queries[m[1]]
+		if q == nil {
+			return nil, SQLError{State: StateInvalidQuery, Message: "query not found"}
+		}
+		q.State = "terminated"
+		q.UpdatedAt = f.clock
+		return &Result{}, nil
+	}
+	if m := namedCreateRe.FindStringSubmatch(stmt); m != nil {
+		key := strings.ToUpper(m[1]) + "/" + unquoteIdent(m[2])
+		if f.Named[key] != nil {
+			return nil, fmt.Errorf("%s already exists", key)
+		}
+		e := f.entry(c, parseProps(m[3]))
+		if strings.EqualFold(m[1], "COMPUTE_POOL") {
+			e.Status = "stopped"
+		}
+		if label, ok := e.Props["file"]; ok {
+			b, uploaded := c.Attachments[label]
+			if !uploaded {
+				return nil, fmt.Errorf("attachment %s was not uploaded", label)
+			}
+			e.Props["file"] = string(b)
+		}
+		f.Named[key] = e
+		return &Result{}, nil
+	}
+	if m := namedUpdateRe.FindStringSubmatch(stmt); m != nil {
+		e := f.Named[strings.ToUpper(m[1])+"/"+unquoteIdent(m[2])]
+		if e == nil {
+			return nil, fmt.Errorf("%s %s not found", strings.ToLower(m[1]), unquoteIdent(m[2]))
+		}
+		for k, v := range parseProps(m[3]) {
+			if v == "NULL" {
+				delete(e.Props, k)
+				continue
+			}
+			e.Props[k] = v
+		}
+		e.UpdatedAt = f.clock
+		return &Result{}, nil
+	}
+	if m := namedDropRe.FindStringSubmatch(stmt); m != nil {
+		key := strings.ToUpper(m[1]) + "/" + unquoteIdent(m[2])
+		if f.Named[key] == nil {
+			return nil, fmt.Errorf("%s %s not found", strings.ToLower(m[1]), unquoteIdent(m[2]))
+		}
+		if strings.HasPrefix(key, "FUNCTION_SOURCE/") {
+			for fn, e := range f.Named {
+				if strings.HasPrefix(fn, "FUNCTION/") && "FUNCTION_SOURCE/"+e.Props["source.name"] == key {
+					return nil, fmt.Errorf("function source %s is in use by function %s", unquoteIdent(m[2]), strings.TrimPrefix(fn, "FUNCTION/"))
+				}
+			}
+		}
+		for store, e := range f.Stores {
+			for _, v := range e.Props {
+				if name, ok := secretRefName(v); ok && "SECRET/"+name == key {
+					return nil, fmt.Errorf("secret %s is in use by store %s", name, store)
+				}
+			}
+		}
+		delete(f.Named, key)
+		return &Result{}, nil
+	}
+	if m := createFunctionRe.FindStringSubmatch(stmt); m != nil {
+		name := unquoteIdent(m[1])
+		if f.Named["FUNCTION/"+name] != nil {
+			return nil, fmt.Errorf("function %s already exists", name)
+		}
+		props := parseProps(m[5])
+		if f.Named["FUNCTION_SOURCE/"+props["source.name"]] == nil {
+			return nil, fmt.Errorf("function source %s not found", props["source.name"])
+		}
+		props["parameters"], props["return_type"], props["language"] = strings.TrimSpace(m[2]), strings.TrimSpace(m[3]), strings.ToUpper(m[4])
+		f.Named["FUNCTION/"+name] = f.entry(c, props)
+		return &Result{}, nil
+	}
+	if m := dropFunctionRe.FindStringSubmatch(stmt); m != nil {
+		name := unquoteIdent(m[1])
+		if f.Named["FUNCTION/"+name] == nil {
+			return nil, fmt.Errorf("function %s not found", name)
+		}
+		delete(f.Named, "FUNCTION/"+name)
+		return &Result{}, nil
+	}
+	if m := createEntityRe.FindStringSubmatch(stmt); m != nil {
+		name, store := unquoteIdent(m[1]), unquoteIdent(m[2])
+		props := parseProps(m[3])
+		if err := f.checkEntity(store, props); err != nil {
+			return nil, err
+		}
+		if f.Entities[store+"/"+name] != nil {
+			return nil, fmt.Errorf("entity %s already exists in store %s", name, store)
+		}
+		f.Entities[store+"/"+name] = f.entry(c, props)
+		return &Result{}, nil
+	}
+	if m := updateEntityRe.FindStringSubmatch(stmt); m != nil {
+		name, store := unquoteIdent(m[1]), unquoteIdent(m[2])
+		props := parseProps(m[3])
+		if err := f.checkEntity(store, props); err != nil {
+			return nil, err
+		}
+		e := f.Entities[store+"/"+name]
+		if e == nil {
+			return nil, fmt.Errorf("entity %s not found in store %s", name, store)
+		}
+		for k, v := range props {
+			if v == "NULL" {
+				delete(e.Props, k)
+				continue
+			}
+			e.Props[k] = v
+		}
+		e.UpdatedAt = f.clock
+		return &Result{}, nil
+	}
+	if m := dropEntityRe.FindStringSubmatch(stmt); m != nil {
+		name, store := unquoteIdent(m[1]), unquoteIdent(m[2])
+		if f.Stores[store] == nil {
+			return nil, SQLError{State: StateInvalidStore, Message: "store not found"}
+		}
+		if f.Entities[store+"/"+name] == nil {
+			return nil, fmt.Errorf("entity %s not found in store %s", name, store)
+		}
+		delete(f.Entities, store+"/"+name)
+		return &Result{}, nil
+	}
+	if m := listEntitiesRe.FindStringSubmatch(stmt); m != nil {
+		store := unquoteIdent(m[1])
+		if f.Stores[store] == nil {
+			return nil, SQLError{State: StateInvalidStore, Message: "store not found"}
+		}
+		out := &Result{Columns: []string{"name", "is_leaf"}}
+		for _, key := range slices.Sorted(maps.Keys(f.Entities)) {
+			if s, name, _ := strings.Cut(key, "/"); s == store {
+				out.Rows = append(out.Rows, []any{name, "true"})
+			}
+		}
+		return out, nil
+	}
+	if m := poolRunRe.FindStringSubmatch(stmt); m != nil {
+		e := f.Named["COMPUTE_POOL/"+unquoteIdent(m[2])]
+		if e == nil {
+			return nil, fmt.Errorf("compute pool %s not found", unquoteIdent(m[2]))
+		}
+		switch strings.ToUpper(m[1]) {
+		case "START":
+			e.Status = "running"
+		case "STOP":
+			e.Status = "stopped"
+		}
+		return &Result{}, nil
+	}
+	return nil, fmt.Errorf("fake DeltaStream: unsupported statement: %s", stmt)
+}
+
+// checkEntity validates the store of an entity and the descriptor sources its properties name.
+func (f *Server) checkEntity(store string, props map[string]string) error {
+	if f.Stores[store] == nil {
+		return SQLError{State: StateInvalidStore, Message: "store not found"}
+	}
+	for _, k := range []string{"kafka.topic.descriptor.key", "kafka.topic.descriptor.value"} {
+		if v, ok := props[k]; ok && v != "NULL" {
+			if source := splitName(v)[0]; f.Named["DESCRIPTOR_SOURCE/"+source] == nil {
+				return fmt.Errorf("descriptor source %s not found", source)
+			}
+		}
+	}
+	return nil
+}
+
+// checkSecretRefs fails when a store property references a secret that does not exist.
+func (f *Server) checkSecretRefs(props map[string]string) error {
+	for k, v := range props {
+		if name, ok := secretRefName(v); ok && f.Named["SECRET/"+name] == nil {
+			return fmt.Errorf("secret %s referenced by %s not found", name, k)
+		}
+	}
+	return nil
+}
+
+func (f *Server) entry(c *Session, props map[string]string) *Entry {
+	owner := c.Role
+	if owner == "" {
+		owner = "sysadmin"
+	}
+	if props == nil {
+		props = map[string]string{}
+	}
+	return &Entry{Owner: owner, Props: props, CreatedAt: f.clock, UpdatedAt: f.clock}
+}
+
+func (f *Server) firstRelation(match func(*Relation) bool) string {
+	keys := make([]string, 0, len(f.Relations))
+	for k := range f.Relations {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		if match(f.Relations[k]) {
+			return fqn(f.Relations[k].Path)
+		}
+	}
+	return ""
+}
+
+// resolve turns a possibly partial relation name into a full path using the connection context.
+func (f *Server) resolve(c *Session, name string) ([]string, error) {
+	parts := splitName(name)
+	switch len(parts) {
+	case 1:
+		parts = []string{c.Database, c.Schema, parts[0]}
+	case 2:
+		parts = []string{c.Database, parts[0], parts[1]}
+	}
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("cannot resolve relation name %s without a database and namespace", name)
+	}
+	return parts, nil
+}
+
+func (f *Server) relation(c *Session, name string) (*Relation, error) {
+	path, err := f.resolve(c, name)
+	if err != nil {
+		return nil, err
+	}
+	rel := f.Relations[strings.Join(path, "/")]
+	if rel == nil {
+		return nil, SQLError{State: StateInvalidRelation, Message: fmt.Sprintf("relation %s not found", fqn(path))}
+	}
+	return rel, nil
+}
+
+func relationPlan(path []string, typ, store string) map[string]any {
+	return map[string]any{"fqn": fqn(path), "type": typ, "db_name": path[0], "schema_name": path[1], "name": path[2], "store_name": store}
+}
+
+// plan returns the DESCRIBE kind and plan for a statement and the relations it uses.
+func (f *Server) plan(c *Session, stmt string) (string, map[string]any, []string, error) {
+	if m := createRelationRe.FindStringSubmatch(stmt); m != nil {
+		path, err := f.resolve(c, m[2])
+		if err != nil {
+			return "", nil, nil, err
+		}
+		if f.Schemas[path[0]+"/"+path[1]] == nil {
+			return "", nil, nil, SQLError{State: StateInvalidSchema, Message: fmt.Sprintf("namespace %s.%s not found", path[0], path[1])}
+		}
+		store := c.Store
+		if s, ok := parseProps(withClause(m[3]))["store"]; ok {
+			store = s
+		}
+		if store == "" || f.Stores[store] == nil {
+			return "", nil, nil, SQLError{State: StateInvalidStore, Message: fmt.Sprintf("store %q not found", store)}
+		}
+		typ := strings.ToLower(m[1])
+		return "CREATE_" + strings.ToUpper(m[1]), map[string]any{"ddl": relationPlan(path, typ, store)}, nil, nil
+	}
+	if hasPrefixFold(stmt, "INSERT INTO ") {
+		sink, sources, err := f.queryRelations(c, stmt, nil)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		plan := map[string]any{"sink": f.planFor(sink[0]), "sources": f.plansFor(sources)}
+		return "INSERT_INTO", plan, append(sink, sources...), nil
+	}
+	if m := applicationBodyRe.FindStringSubmatch(stmt); m != nil {
+		virtual := map[string]bool{}
+		var ddls []map[string]any
+		for _, v := range virtualRe.FindAllStringSubmatch(m[1], -1) {
+			path, err := f.resolve(c, v[1])
+			if err != nil {
+				return "", nil, nil, err
+			}
+			virtual[strings.Join(path, "/")] = true
+			p := relationPlan(path, "stream", "")
+			p["is_virtual"] = true
+			ddls = append(ddls, p)
+		}
+		sinks, sources, err := f.queryRelations(c, m[1], virtual)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		plan := map[string]any{"ddls": ddls, "sinks": f.plansFor(sinks), "sources": f.plansFor(sources)}
+		return "APPLICATION", plan, append(sinks, sources...), nil
+	}
+	return "", nil, nil, fmt.Errorf("fake DeltaStream: cannot describe statement: %s", stmt)
+}
+
+// queryRelations returns the keys of the physical sinks and sources of a statement. Relations
+// named in skip (virtual relations) are left out.
+func (f *Server) queryRelations(c *Session, stmt string, skip map[string]bool) (sinks, sources []string, err error) {
+	collect := func(re *regexp.Regexp, out *[]string) error {
+		for _, m := range re.FindAllStringSubmatch(stmt, -1) {
+			path, err := f.resolve(c, m[1])
+			if err != nil {
+				return err
+			}
+			key := strings.Join(path, "/")
+			if skip[key] || slices.Contains(*out, key) {
+				continue
+			}
+			if f.Relations[key] == nil {
+				return SQLError{State: StateInvalidRelation, Message: fmt.Sprintf("relation %s not found", fqn(path))}
+			}
+			*out = append(*out, key)
+		}
+		return nil
+	}
+	if err := collect(insertSinkRe, &sinks); err != nil {
+		return nil, nil, err
+	}
+	if err := collect(sourceRe, &sources); err != nil {
+		return nil, nil, err
+	}
+	if len(sinks) == 0 {
+		return nil, nil, fmt.Errorf("statement has no sink")
+	}
+	return sinks, sources, nil
+}
+
+func (f *Server) planFor(key string) map[string]any {
+	rel := f.Relations[key]
+	return relationPlan(rel.Path, rel.Type, rel.Store)
+}
+
+func (f *Server) plansFor(keys []string) []map[string]any {
+	out := make([]map[string]any, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, f.planFor(k))
+	}
+	return out
+}
+
+func (f *Server) createRelation(c *Session, stmt string) (*Result, error) {
+	kind, plan, _, err := f.plan(c, stmt)
+	if err != nil {
+		return nil, err
+	}
+	ddl := plan["ddl"].(map[string]any)
+	path := []string{ddl["db_name"].(string), ddl["schema_name"].(string), ddl["name"].(string)}
+	key := strings.Join(path, "/")
+	if f.Relations[key] != nil {
+		return nil, fmt.Errorf("relation %s already exists", fqn(path))
+	}
+	rel := &Relation{Entry: *f.entry(c, nil), Path: path, Type: ddl["type"].(string), Store: ddl["store_name"].(string), DDL: stmt + ";"}
+	rel.Status = "created"
+	f.Relations[key] = rel
+	js, _ := json.Marshal(path)
+	return &Result{
+		Columns: []string{"type", "name", "command", "summary", "path"},
+		Rows:    [][]any{{strings.ToLower(strings.TrimPrefix(kind, "CREATE_")), path[2], kind, "", string(js)}},
+	}, nil
+}
+
+func (f *Server) startQuery(c *Session, stmt string) (*Result, error) {
+	kind, plan, uses, err := f.plan(c, stmt)
+	if err != nil {
+		return nil, err
+	}
+	f.nextQuery++
+	id := fmt.Sprintf("00000000-0000-4000-8000-%012d", f.nextQuery)
+	q := &Query{Entry: *f.entry(c, nil), ID: id, DSQL: stmt + ";", State: "running", Plan: plan, uses: uses}
+	f.Queries[id] = q
+	return &Result{
+		Columns: []string{"type", "name", "command", "summary", "path"},
+		Rows:    [][]any{{"query", id, kind, "", "[]"}},
+	}, nil
+}
+
+func (f *Server) alterOwner(c *Session, kind, name, owner string) (*Result, error) {
+	var e *Entry
+	switch kind {
+	case "DATABASE":
+		e = f.Databases[unquoteIdent(name)]
+	case "SCHEMA":
+		if parts := splitName(name); len(parts) == 2 {
+			e = f.Schemas[parts[0]+"/"+parts[1]]
+		}
+	case "STORE":
+		e = f.Stores[unquoteIdent(name)]
+	case "QUERY":
+		if q := f.Queries[name]; q != nil {
+			e = &q.Entry
+		}
+	default:
+		if rel, err := f.relation(c, name); err == nil {
+			e = &rel.Entry
+		}
+	}
+	if e == nil {
+		return nil, fmt.Errorf("%s %s not found", strings.ToLower(kind), name)
+	}
+	if c.Role != "" && c.Role != e.Owner {
+		return nil, fmt.Errorf("role %s cannot transfer %s %s owned by %s", c.Role, strings.ToLower(kind), name, e.Owner)
+	}
+	e.Owner = owner
+	e.UpdatedAt = f.clock
+	return &Result{}, nil
+}
+
+func (f *Server) describeStore(name string) (*Result, error) {
+	e := f.Stores[name]
+	if e == nil {
+		return nil, SQLError{State: StateInvalidStore, Message: "store not found"}
+	}
+	cols := []string{"Name", "Type"}
+	row := []any{name, e.Props["type"]}
+	keys := make([]string, 0, len(e.Props))
+	for k := range e.Props {
+		if k != "type" {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		cols = append(cols, k)
+		row = append(row, e.Props[k])
+	}
+	return &Result{Columns: cols, Rows: [][]any{row}}, nil
+}
+
+// catalog materializes a deltastream.sys table.
+func (f *Server) catalog(table string) ([]map[string]any, error) {
+	var out []map[string]any
+	switch table {
+	case "databases":
+		for name, e := range f.Databases {
+			out = append(out, map[string]any{"name": name, "owner": e.Owner, "created_at": e.CreatedAt})
+		}
+	case "schemas":
+		for key, e := range f.Schemas {
+			db, name, _ := strings.Cut(key, "/")
+			out = append(out, map[string]any{"database_name": db, "name": name, "owner": e.Owner, "created_at": e.CreatedAt})
+		}
+	case "stores":
+		for name, e := range f.Stores {
+			out = append(out, map[string]any{"name": name, "type": strings.ToUpper(e.Props["type"]), "status": e.Status, "status_message": "",
+				"owner": e.Owner, "created_at": e.CreatedAt, "updated_at": e.UpdatedAt})
+		}
+	case "relations":
+		for _, r := range f.Relations {
+			out = append(out, map[string]any{"database_name": r.Path[0], "schema_name": r.Path[1], "name": r.Path[2], "fqn": fqn(r.Path),
+				"relation_type": r.Type, "owner": r.Owner, "state": r.Status, "created_at": r.CreatedAt, "updated_at": r.UpdatedAt,
+				"dsql": r.DDL, "store_name": r.Store})
+		}
+	case "queries":
+		for _, q := range f.Queries {
+			out = append(out, map[string]any{"id": q.ID, "name": nil, "version": int64(1), "current_state": q.State, "owner": q.Owner,
+				"created_at": q.CreatedAt, "updated_at": q.UpdatedAt, "dsql": q.DSQL})
+		}
+	case "compute_pools", "secrets", "descriptor_sources", "function_sources", "functions":
+		kind := map[string]string{"compute_pools": "COMPUTE_POOL", "secrets": "SECRET", "descriptor_sources": "DESCRIPTOR_SOURCE",
+			"function_sources": "FUNCTION_SOURCE", "functions": "FUNCTION"}[table]
+		for key, e := range f.Named {
+			k, name, _ := strings.Cut(key, "/")
+			if k != kind {
+				continue
+			}
+			row := map[string]any{"name": name, "status": e.Status, "owner": e.Owner, "created_at": e.CreatedAt, "updated_at": e.UpdatedAt}
+			if kind == "COMPUTE_POOL" {
+				row["size"] = strings.ToUpper(strings.Trim(propOr(e.Props, "compute_pool.size", "small"), "'"))
+				timeout, _ := strconv.ParseInt(propOr(e.Props, "compute_pool.timeout_min", "0"), 10, 64)
+				row["timeout_min"] = timeout
+			}
+			if kind == "FUNCTION" {
+				row["source_name"], row["class_name"] = e.Props["source.name"], e.Props["class.name"]
+				row["language"], row["return_type"], row["parameters"] = e.Props["language"], e.Props["return_type"], e.Props["parameters"]
+			}
+			out = append(out, row)
+		}
+	default:
+		return nil, fmt.Errorf("fake DeltaStream: unknown catalog %s", table)
+	}
+	return out, nil
+}
+
+func (f *Server) selectCatalog(cols, table, where, orderBy, limit string) (*Result, error) {
+	rows, err := f.catalog(strings.ToLower(table))
+	if err != nil {
+		return nil, err
+	}
+	if where != "" {
+		for _, cond := range andRe.Split(strings.TrimSpace(where), -1) {
+			m := conditionRe.FindStringSubmatch(strings.TrimSpace(cond))
+			if m == nil {
+				return nil, fmt.Errorf("fake DeltaStream: unsupported condition %q", cond)
+			}
+			col, val := strings.ToLower(m[1]), strings.ReplaceAll(m[2], "''", "'")
+			rows = slices.DeleteFunc(rows, func(r map[string]any) bool { return fmt.Sprint(r[col]) != val })
+		}
+	}
+	var order []string
+	for _, c := range strings.Split(orderBy, ",") {
+		if c = strings.Trim(strings.TrimSpace(c), `"`); c != "" {
+			order = append(order, strings.ToLower(c))
+		}
+	}
+	if len(order) == 0 {
+		order = []string{"name", "id"}
+	}
+	slices.SortStableFunc(rows, func(a, b map[string]any) int {
+		for _, c := range order {
+			if d := compareValues(a[c], b[c]); d != 0 {
+				return d
+			}
+		}
+		return 0
+	})
+	if limit != "" {
+		n, _ := strconv.Atoi(limit)
+		if len(rows) > n {
+			rows = rows[:n]
+		}
+	}
+	out := &Result{}
+	for _, c := range strings.Split(cols, ",") {
+		out.Columns = append(out.Columns, strings.ToLower(strings.Trim(strings.TrimSpace(c), `"`)))
+	}
+	for _, r := range rows {
+		vals := make([]any, len(out.Columns))
+		for i, c := range out.Columns {
+			if c == "1" {
+				vals[i] = int64(1)
+				continue
+			}
+			v, ok := r[c]
+			if !ok {
+				return nil, fmt.Errorf("fake DeltaStream: unknown column %s in %s", c, table)
+			}
+			vals[i] = v
+		}
+		out.Rows = append(out.Rows, vals)
+	}
+	return out, nil
+}
+
+func compareValues(a, b any) int {
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Compare(bt)
+		}
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func propOr(props map[string]string, key, def string) string {
+	if v, ok := props[key]; ok {
+		return v
+	}
+	return def
+}
+
+// withClause returns the text inside the first WITH ( ... ) of a statement tail.
+func withClause(tail string) string {
+	i := strings.Index(strings.ToUpper(tail), "WITH")
+	if i < 0 {
+		return ""
+	}
+	rest := strings.TrimSpace(tail[i+4:])
+	if !strings.HasPrefix(rest, "(") {
+		return ""
+	}
+	depth, quoted := 0, false
+	for j, r := range rest {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth == 0 {
+				return rest[1:j]
+			}
+		}
+	}
+	return ""
+}
+
+// parseProps parses 'key' = value pairs. String values are unquoted; other values are
+// kept verbatim.
+func parseProps(s string) map[string]string {
+	props := map[string]string{}
+	var parts []string
+	var cur strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+			cur.WriteRune(r)
+		case r == ',' && !quoted:
+			parts = append(parts, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	parts = append(parts, cur.String())
+	for _, part := range parts {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		k = strings.Trim(strings.TrimSpace(k), `'"`)
+		v = strings.TrimSpace(v)
+		if len(v) >= 2 && v[0] == '\'' && v[len(v)-1] == '\'' {
+			v = strings.ReplaceAll(v[1:len(v)-1], "''", "'")
+		}
+		props[strings.ToLower(k)] = v
+	}
+	return props
+}
+
+// splitName splits a dotted name with optionally quoted parts.
+func splitName(name string) []string {
+	var parts []string
+	var cur strings.Builder
+	quoted := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '"' && quoted && i+1 < len(name) && name[i+1] == '"':
+			cur.WriteByte('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == '.' && !quoted:
+			parts = append(parts, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	return append(parts, cur.String())
+}
+
+// secretRefName returns the secret named by a SECRET "<name>" property value.
+func secretRefName(v string) (string, bool) {
+	rest, ok := cutPrefixFold(v, "SECRET ")
+	if !ok {
+		return "", false
+	}
+	return unquoteIdent(strings.TrimSpace(rest)), true
+}
+
+func unquoteIdent(s string) string {
+	return splitName(s)[0]
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if !hasPrefixFold(s, prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+func fqn(path []string) string {
+	quoted := make([]string, len(path))
+	for i, part := range path {
+		quoted[i] = `"` + strings.ReplaceAll(part, `"`, `""`) + `"`
+	}
+	return strings.Join(quoted, ".")
+}
+
+func terminal(state string) bool {
+	switch strings.ToLower(state) {
+	case "terminated", "terminate_requested", "errored":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,243 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// statementRequest is the body of POST /v2/statements, sent as JSON or as the "request" part
+// of a multipart upload whose other parts are the statement's attachments.
+type statementRequest struct {
+	Statement    string `json:"statement"`
+	Organization string `json:"organization,omitempty"`
+	Role         string `json:"role,omitempty"`
+	Database     string `json:"database,omitempty"`
+	Schema       string `json:"schema,omitempty"`
+	Store        string `json:"store,omitempty"`
+	ComputePool  string `json:"computePool,omitempty"`
+}
+
+// resultSet is the v2 response for a completed or failed statement.
+type resultSet struct {
+	SQLState    string            `json:"sqlState"`
+	Message     string            `json:"message,omitempty"`
+	StatementID string            `json:"statementID"`
+	CreatedOn   int64             `json:"createdOn"`
+	Metadata    resultSetMetadata `json:"metadata"`
+	Data        [][]*string       `json:"data,omitempty"`
+}
+
+type resultSetMetadata struct {
+	Encoding      string          `json:"encoding"`
+	PartitionInfo []partitionInfo `json:"partitionInfo,omitempty"`
+	Columns       []column        `json:"columns,omitempty"`
+	Context       *resultContext  `json:"context,omitempty"`
+}
+
+type partitionInfo struct {
+	RowCount int `json:"rowCount"`
+}
+
+type column struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+}
+
+type resultContext struct {
+	OrganizationID string `json:"organizationID,omitempty"`
+	RoleName       string `json:"roleName,omitempty"`
+	DatabaseName   string `json:"databaseName,omitempty"`
+	SchemaName     string `json:"schemaName,omitempty"`
+	StoreName      string `json:"storeName,omitempty"`
+}
+
+type apiError struct {
+	Message string `json:"message"`
+}
+
+// Handler serves the v2 statement API under /v2: POST /v2/statements runs a statement and
+// GET /v2/statements/{id} returns its result again. Requests must carry token as a bearer
+// token. Every statement completes synchronously, so results never report pending states.
+func (f *Server) Handler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v2/statements", f.postStatement)
+	mux.HandleFunc("GET /v2/statements/{id}", f.getStatement)
+	mux.HandleFunc("GET /v2/version", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"version": map[string]int{"major": 2, "minor": 0, "patch": 0}})
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			writeJSON(w, http.StatusUnauthorized, apiError{Message: "missing or invalid API token"})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// Start serves f on a local listener and returns the server setting that points the provider
+// at it, ending in /v2, and a function that stops the listener.
+func (f *Server) Start(token string) (server string, stop func()) {
+	ts := httptest.NewServer(f.Handler(token))
+	return ts.URL + "/v2", ts.Close
+}
+
+func (f *Server) postStatement(w http.ResponseWriter, r *http.Request) {
+	req, attachments, err := readStatementRequest(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, apiError{Message: err.Error()})
+		return
+	}
+	if strings.TrimSpace(req.Statement) == "" {
+		writeJSON(w, http.StatusBadRequest, apiError{Message: "statement is required"})
+		return
+	}
+
+	c := &Session{Role: req.Role, Database: req.Database, Schema: req.Schema, Store: req.Store, Attachments: attachments}
+	res, err := f.Exec(c, req.Statement)
+	out := &resultSet{
+		SQLState:  StateSuccess,
+		CreatedOn: time.Now().Unix(),
+		Metadata: resultSetMetadata{
+			Encoding: "json",
+			Context: &resultContext{
+				OrganizationID: req.Organization,
+				RoleName:       req.Role,
+				DatabaseName:   req.Database,
+				SchemaName:     req.Schema,
+				StoreName:      req.Store,
+			},
+		},
+	}
+	var sqlErr SQLError
+	switch {
+	case errors.As(err, &sqlErr):
+		out.SQLState, out.Message = sqlErr.State, sqlErr.Message
+	case err != nil:
+		out.SQLState, out.Message = StateError, err.Error()
+	default:
+		out.Metadata.Columns, out.Data = encodeResult(res)
+		out.Metadata.PartitionInfo = []partitionInfo{{RowCount: len(out.Data)}}
+	}
+
+	f.mu.Lock()
+	f.nextResult++
+	out.StatementID = fmt.Sprintf("00000000-0000-4000-8000-%012d", f.nextResult)
+	f.results[out.StatementID] = out
+	f.mu.Unlock()
+	writeJSON(w, http.StatusOK, out)
+}
+
+func (f *Server) getStatement(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	out, ok := f.results[r.PathValue("id")]
+	f.mu.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusNotFound, apiError{Message: "statement not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+// readStatementRequest decodes a JSON body, or a multipart body whose "request" part is the
+// JSON and whose remaining parts are attachments labelled by file name.
+func readStatementRequest(r *http.Request) (statementRequest, map[string][]byte, error) {
+	var req statementRequest
+	mediaType, params, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		err := json.NewDecoder(r.Body).Decode(&req)
+		return req, nil, err
+	}
+
+	attachments := map[string][]byte{}
+	parts := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return req, nil, err
+		}
+		b, err := io.ReadAll(part)
+		if err != nil {
+			return req, nil, err
+		}
+		if part.FormName() == "request" {
+			if err := json.Unmarshal(b, &req); err != nil {
+				return req, nil, fmt.Errorf("request part: %w", err)
+			}
+			continue
+		}
+		label := part.FileName()
+		if label == "" {
+			label = part.FormName()
+		}
+		attachments[label] = b
+	}
+	return req, attachments, nil
+}
+
+// encodeResult renders res in the v2 JSON encoding: every value as a string or null, with
+// column types taken from the first non-null value.
+func encodeResult(res *Result) ([]column, [][]*string) {
+	if res == nil {
+		return nil, nil
+	}
+	cols := make([]column, len(res.Columns))
+	for i, name := range res.Columns {
+		cols[i] = column{Name: name, Type: "VARCHAR", Nullable: true}
+	}
+	data := make([][]*string, len(res.Rows))
+	for r, row := range res.Rows {
+		data[r] = make([]*string, len(row))
+		for i, v := range row {
+			var s string
+			switch v := v.(type) {
+			case nil:
+				continue
+			case string:
+				s = v
+			case int64:
+				s = strconv.FormatInt(v, 10)
+				cols[i].Type = "BIGINT"
+			case time.Time:
+				s = v.UTC().Format(time.RFC3339Nano)
+				cols[i].Type = "TIMESTAMP_TZ(9)"
+			default:
+				s = fmt.Sprint(v)
+			}
+			data[r][i] = &s
+		}
+	}
+	return cols, data
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
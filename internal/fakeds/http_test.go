@@ -0,0 +1,153 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakeds
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func post(t *testing.T, server, token, contentType string, body []byte) (int, resultSet) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, server+"/statements", bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var out resultSet
+	if resp.StatusCode == http.StatusOK {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&out))
+	}
+	return resp.StatusCode, out
+}
+
+func postJSON(t *testing.T, server string, req statementRequest) resultSet {
+	t.Helper()
+	b, err := json.Marshal(req)
+	require.NoError(t, err)
+	status, out := post(t, server, "token", "application/json", b)
+	require.Equal(t, http.StatusOK, status)
+	return out
+}
+
+func TestStatementRoundTrip(t *testing.T) {
+	t.Parallel()
+	f := New()
+	server, stop := f.Start("token")
+	defer stop()
+
+	out := postJSON(t, server, statementRequest{Statement: `CREATE DATABASE "db";`, Role: "sysadmin"})
+	require.Equal(t, StateSuccess, out.SQLState, out.Message)
+	assert.NotEmpty(t, out.StatementID)
+
+	out = postJSON(t, server, statementRequest{Statement: `SELECT name, owner, created_at FROM deltastream.sys."databases" WHERE name = 'db'`})
+	require.Equal(t, StateSuccess, out.SQLState, out.Message)
+	require.Len(t, out.Data, 1)
+	assert.Equal(t, []column{
+		{Name: "name", Type: "VARCHAR", Nullable: true},
+		{Name: "owner", Type: "VARCHAR", Nullable: true},
+		{Name: "created_at", Type: "TIMESTAMP_TZ(9)", Nullable: true},
+	}, out.Metadata.Columns)
+	assert.Equal(t, "db", *out.Data[0][0])
+	assert.Equal(t, "sysadmin", *out.Data[0][1])
+	assert.Equal(t, []partitionInfo{{RowCount: 1}}, out.Metadata.PartitionInfo)
+
+	resp, err := http.Get(server + "/statements/" + out.StatementID)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodGet, server+"/statements/"+out.StatementID, nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var again resultSet
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&again))
+	assert.Equal(t, out, again)
+}
+
+func TestStatementErrorsCarrySQLState(t *testing.T) {
+	t.Parallel()
+	f := New()
+	server, stop := f.Start("token")
+	defer stop()
+
+	out := postJSON(t, server, statementRequest{Statement: `DROP DATABASE "missing"`})
+	assert.Equal(t, StateInvalidDatabase, out.SQLState)
+	assert.NotEmpty(t, out.Message)
+	assert.Empty(t, out.Data)
+
+	out = postJSON(t, server, statementRequest{Statement: `FROBNICATE EVERYTHING`})
+	assert.Equal(t, StateError, out.SQLState)
+	assert.Contains(t, out.Message, "FROBNICATE")
+}
+
+func TestStatementRequiresToken(t *testing.T) {
+	t.Parallel()
+	f := New()
+	server, stop := f.Start("token")
+	defer stop()
+
+	status, _ := post(t, server, "", "application/json", []byte(`{"statement":"CREATE DATABASE \"db\""}`))
+	assert.Equal(t, http.StatusUnauthorized, status)
+	status, _ = post(t, server, "other", "application/json", []byte(`{"statement":"CREATE DATABASE \"db\""}`))
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Empty(t, f.Executed())
+}
+
+func TestStatementMultipartAttachments(t *testing.T) {
+	t.Parallel()
+	f := New()
+	server, stop := f.Start("token")
+	defer stop()
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormField("request")
+	require.NoError(t, err)
+	require.NoError(t, json.NewEncoder(part).Encode(statementRequest{
+		Statement: `CREATE DESCRIPTOR_SOURCE "pb" WITH ( 'file' = 'pb.desc' )`,
+		Role:      "sysadmin",
+	}))
+	part, err = w.CreateFormFile("attachments", "pb.desc")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("descriptor bytes"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	status, out := post(t, server, "token", w.FormDataContentType(), body.Bytes())
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, StateSuccess, out.SQLState, out.Message)
+	require.Contains(t, f.Named, "DESCRIPTOR_SOURCE/pb")
+	assert.Equal(t, "descriptor bytes", f.Named["DESCRIPTOR_SOURCE/pb"].Props["file"])
+
+	out = postJSON(t, server, statementRequest{Statement: `CREATE DESCRIPTOR_SOURCE "other" WITH ( 'file' = 'other.desc' )`})
+	assert.Equal(t, StateError, out.SQLState)
+	assert.True(t, strings.Contains(out.Message, "not uploaded"), out.Message)
+}
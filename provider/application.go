@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -46,6 +47,10 @@ type ApplicationArgs struct {
 	SinkRelationFqns   []string `pulumi:"sinkRelationFqns"`
 	SQL                string   `pulumi:"sql"`
 	Owner              *string  `pulumi:"owner,optional"`
+	// Compute pool the application runs on; applied when it starts (optional)
+	ComputePool *string `pulumi:"computePool,optional"`
+	// Values for typed {{type:name}} placeholders in SQL (optional)
+	Parameters map[string]string `pulumi:"parameters,optional"`
 }
 
 // ApplicationState captures runtime attributes of an APPLICATION after creation.
@@ -58,11 +63,13 @@ type ApplicationState struct {
 	CreatedAt     string  `pulumi:"createdAt"`
 	UpdatedAt     string  `pulumi:"updatedAt"`
 	OwnerOut      *string `pulumi:"owner"`
+	RenderedSQL   string  `pulumi:"renderedSql"`
 }
 
 // Annotate sets descriptions on ApplicationState fields for schema generation.
 func (s *ApplicationState) Annotate(a infer.Annotator) {
 	a.Describe(&s.State, "Lifecycle state of the application (starting|running|terminate_requested|terminated|errored)")
+	a.Describe(&s.RenderedSQL, "SQL statement after substituting parameters; used for change detection")
 	a.Describe(&s.ApplicationID, "System-generated application identifier")
 }
 
@@ -101,13 +108,20 @@ func (Application) Check(ctx context.Context, req infer.CheckRequest) (infer.Che
 		return infer.CheckResponse[ApplicationArgs]{Inputs: args, Failures: failures}, nil
 	}
 
+	sqlText, rerr := renderSQLTemplate(args.SQL, args.Parameters)
+	if rerr != nil {
+		failures = append(failures, p.CheckFailure{Property: "parameters", Reason: rerr.Error()})
+		return infer.CheckResponse[ApplicationArgs]{Inputs: args, Failures: failures}, nil
+	}
+
 	// Skip DESCRIBE when all relevant inputs (sql, sinks, sources) are unchanged from the
 	// prior deploy. This prevents re-validating stale RESUME FROM QUERY ID references that
 	// may have been garbage-collected while the application is still running correctly.
 	// We decode OldInputs to include sinkRelationFqns and sourceRelationFqns in the
-	// comparison so that user changes to those fields always trigger re-validation.
+	// comparison so that user changes to those fields always trigger re-validation. SQL is
+	// compared after normalizeSQL so formatting and comment edits do not re-validate either.
 	if oldArgs, _, oerr := infer.DefaultCheck[ApplicationArgs](ctx, req.OldInputs); oerr == nil &&
-		oldArgs.SQL == args.SQL &&
+		sqlEqual(renderSQLOrRaw(oldArgs.SQL, oldArgs.Parameters), renderSQLOrRaw(args.SQL, args.Parameters)) &&
 		stringSlicesEqual(oldArgs.SinkRelationFqns, args.SinkRelationFqns) &&
 		stringSlicesEqual(oldArgs.SourceRelationFqns, args.SourceRelationFqns) {
 		return infer.CheckResponse[ApplicationArgs]{Inputs: args, Failures: failures}, nil
@@ -128,9 +142,15 @@ func (Application) Check(ctx context.Context, req infer.CheckRequest) (infer.Che
 	}
 	defer conn.Close() //nolint:errcheck
 
-	kind, plan, derr := describeApplication(ctx2, conn, args.SQL)
+	kind, plan, derr := describeApplication(ctx2, conn, sqlText)
 	if derr != nil {
-		failures = append(failures, p.CheckFailure{Property: "sql", Reason: fmt.Sprintf("describe failed: %v", derr)})
+		// A declared relation created in the same update only exists in the preview; Create
+		// plans the statement again once it does.
+		if fqn, ok := pendingRelation(ctx2, conn, derr, append(slices.Clone(args.SinkRelationFqns), args.SourceRelationFqns...)); ok {
+			getLogger(ctx).Warning(fmt.Sprintf("Skipping application validation until relation %s exists: %v", fqn, derr))
+			return infer.CheckResponse[ApplicationArgs]{Inputs: args, Failures: failures}, nil
+		}
+		failures = append(failures, describeCheckFailure("sql", sqlText, derr))
 		return infer.CheckResponse[ApplicationArgs]{Inputs: args, Failures: failures}, nil
 	}
 
@@ -216,10 +236,12 @@ func (Application) Check(ctx context.Context, req infer.CheckRequest) (infer.Che
 }
 
 // Diff computes property differences; all fields trigger replacement except owner (in-place update).
+// SQL is compared after normalizeSQL, so formatting, comment and keyword case edits are not changes.
 func (Application) Diff(ctx context.Context, req infer.DiffRequest[ApplicationArgs, ApplicationState]) (infer.DiffResponse, error) {
 	diff := map[string]p.PropertyDiff{}
 
-	if req.State.SQL != "" && req.State.SQL != req.Inputs.SQL {
+	stateSQL := renderedStateSQL(req.State.RenderedSQL, req.State.SQL, req.State.Parameters)
+	if req.State.SQL != "" && !sqlEqual(stateSQL, renderSQLOrRaw(req.Inputs.SQL, req.Inputs.Parameters)) {
 		diff["sql"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
 
@@ -231,6 +253,10 @@ func (Application) Diff(ctx context.Context, req infer.DiffRequest[ApplicationAr
 		diff["sourceRelationFqns"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
 
+	if ptr.Deref(req.State.ComputePool, "") != ptr.Deref(req.Inputs.ComputePool, "") {
+		diff["computePool"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+
 	if (req.State.Owner == nil && req.Inputs.Owner != nil) || (req.State.Owner != nil && req.Inputs.Owner != nil && *req.State.Owner != *req.Inputs.Owner) {
 		diff["owner"] = p.PropertyDiff{Kind: p.Update}
 	}
@@ -241,11 +267,11 @@ func (Application) Diff(ctx context.Context, req infer.DiffRequest[ApplicationAr
 // Create launches the application and waits until running
 func (Application) Create(ctx context.Context, req infer.CreateRequest[ApplicationArgs]) (infer.CreateResponse[ApplicationState], error) {
 	in := req.Inputs
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 
 	if req.DryRun {
 		now := time.Now().UTC().Format(time.RFC3339)
-		st := ApplicationState{ApplicationArgs: in, CreatedAt: now, UpdatedAt: now, State: "starting"}
+		st := ApplicationState{ApplicationArgs: in, CreatedAt: now, UpdatedAt: now, State: "starting", RenderedSQL: renderSQLOrRaw(in.SQL, in.Parameters)}
 		return infer.CreateResponse[ApplicationState]{ID: provisionalApplicationID(&in), Output: st}, nil
 	}
 
@@ -264,7 +290,11 @@ func (Application) Create(ctx context.Context, req infer.CreateRequest[Applicati
 	}
 	defer conn.Close() //nolint:errcheck
 
-	kind, plan, derr := describeApplication(ctx2, conn, in.SQL)
+	sqlText, err := renderSQLTemplate(in.SQL, in.Parameters)
+	if err != nil {
+		return infer.CreateResponse[ApplicationState]{}, fmt.Errorf("invalid parameters: %w", err)
+	}
+	kind, plan, derr := describeApplication(ctx2, conn, sqlText)
 	if derr != nil {
 		return infer.CreateResponse[ApplicationState]{}, derr
 	}
@@ -347,7 +377,12 @@ func (Application) Create(ctx context.Context, req infer.CreateRequest[Applicati
 	}
 
 	// Execute the APPLICATION SQL
-	art, aerr := executeQuerySQL(ctx2, conn, in.SQL)
+	if in.ComputePool != nil {
+		if err := setComputePool(ctx2, conn, *in.ComputePool); err != nil {
+			return infer.CreateResponse[ApplicationState]{}, err
+		}
+	}
+	art, aerr := executeQuerySQL(ctx2, conn, sqlText)
 	if aerr != nil {
 		return infer.CreateResponse[ApplicationState]{}, aerr
 	}
@@ -375,6 +410,7 @@ func (Application) Create(ctx context.Context, req infer.CreateRequest[Applicati
 		CreatedAt:       qrow.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:       qrow.UpdatedAt.Format(time.RFC3339),
 		OwnerOut:        &ownerOut,
+		RenderedSQL:     sqlText,
 	}
 
 	logger.Info(fmt.Sprintf("Application created: %s", appID))
@@ -414,8 +450,23 @@ func (Application) Read(ctx context.Context, req infer.ReadRequest[ApplicationAr
 		return infer.ReadResponse[ApplicationArgs, ApplicationState]{}, err
 	}
 
-	ownerOut := qrow.Owner
 	st := req.State
+	if st.SQL == "" {
+		// Import: recover the statement from the catalog and its relations from the plan.
+		sqlText, serr := lookupQuerySQL(ctx2, conn, req.ID)
+		if serr != nil {
+			return infer.ReadResponse[ApplicationArgs, ApplicationState]{}, fmt.Errorf("failed to read application statement: %w", serr)
+		}
+		_, plan, derr := describeApplication(ctx2, conn, sqlText)
+		if derr != nil {
+			return infer.ReadResponse[ApplicationArgs, ApplicationState]{}, fmt.Errorf("failed to describe application statement: %w", derr)
+		}
+		st.ApplicationArgs = applicationArgsFromPlan(sqlText, plan)
+		st.ApplicationID = req.ID
+		st.RenderedSQL = sqlText
+	}
+	st.RenderedSQL = renderedStateSQL(st.RenderedSQL, st.SQL, st.Parameters)
+	ownerOut := qrow.Owner
 	st.QueryName = qrow.Name
 	st.QueryVersion = qrow.Version
 	st.State = qrow.State
@@ -462,7 +513,11 @@ func (Application) Update(ctx context.Context, req infer.UpdateRequest[Applicati
 	}
 	defer conn.Close() //nolint:errcheck
 
-	stmt := fmt.Sprintf("ALTER QUERY %s OWNER TO %s;", st.ApplicationID, *req.Inputs.Owner)
+	qid, err := queryIDToken(st.ApplicationID)
+	if err != nil {
+		return infer.UpdateResponse[ApplicationState]{}, err
+	}
+	stmt := fmt.Sprintf("ALTER QUERY %s OWNER TO %s;", qid, quoteIdent(*req.Inputs.Owner))
 	if _, err := conn.ExecContext(ctx2, stmt); err != nil {
 		return infer.UpdateResponse[ApplicationState]{}, fmt.Errorf("failed altering owner: %w", err)
 	}
@@ -502,7 +557,11 @@ func (Application) Delete(ctx context.Context, req infer.DeleteRequest[Applicati
 	defer conn.Close() //nolint:errcheck
 
 	if req.State.State != "terminated" && req.State.State != "terminate_requested" {
-		term := fmt.Sprintf("TERMINATE QUERY %s;", req.ID)
+		qid, err := queryIDToken(req.ID)
+		if err != nil {
+			return infer.DeleteResponse{}, err
+		}
+		term := fmt.Sprintf("TERMINATE QUERY %s;", qid)
 		if _, err := conn.ExecContext(ctx2, term); err != nil {
 			var sqlErr ds.ErrSQLError
 			if !errors.As(err, &sqlErr) || sqlErr.SQLCode != ds.SqlStateInvalidQuery {
@@ -511,7 +570,9 @@ func (Application) Delete(ctx context.Context, req infer.DeleteRequest[Applicati
 		}
 	}
 
-	_ = waitForQueryTerminated(ctx2, conn, req.ID, time.Minute*5)
+	if err := waitForQueryTerminated(ctx2, conn, req.ID, time.Minute*5); err != nil {
+		return infer.DeleteResponse{}, lenientDeleteErr(ctx, &cfg, fmt.Errorf("application %s did not terminate: %w; check its state with LIST QUERIES and re-run the delete", req.ID, err))
+	}
 	return infer.DeleteResponse{}, nil
 }
 
@@ -535,10 +596,23 @@ func describeApplication(ctx context.Context, conn *sql.Conn, sqlText string) (s
 	return kind, plan, nil
 }
 
+// applicationArgsFromPlan rebuilds application inputs from its statement and DESCRIBE plan.
+func applicationArgsFromPlan(sqlText string, plan applicationStatementPlan) ApplicationArgs {
+	in := ApplicationArgs{SQL: sqlText, SinkRelationFqns: []string{}, SourceRelationFqns: []string{}}
+	for _, sink := range plan.Sinks {
+		in.SinkRelationFqns = append(in.SinkRelationFqns, getFQN([]string{sink.DbName, sink.SchemaName, sink.Name}))
+	}
+	for _, src := range plan.Sources {
+		in.SourceRelationFqns = append(in.SourceRelationFqns, getFQN([]string{src.DbName, src.SchemaName, src.Name}))
+	}
+	return in
+}
+
 // WireDependencies associates dynamic outputs with input fields for Pulumi diff graph
 func (Application) WireDependencies(f infer.FieldSelector, args *ApplicationArgs, state *ApplicationState) {
 	f.OutputField(&state.State).DependsOn(f.InputField(&args.SQL))
 	f.OutputField(&state.ApplicationID).DependsOn(f.InputField(&args.SQL))
+	f.OutputField(&state.RenderedSQL).DependsOn(f.InputField(&args.SQL), f.InputField(&args.Parameters))
 }
 
 // provisionalApplicationID derives a stable preview ID for planning phases
@@ -16,6 +16,7 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -79,10 +80,16 @@ END APPLICATION WITH (resume.from.query.id = '0fe1f533-0d9d-4250-8841-8ffc47af4d
 		},
 		{
 			name:      "changed SQL does not skip",
-			oldInputs: buildInputs(sql+" -- modified", []string{src}, []string{sink}),
+			oldInputs: buildInputs(strings.Replace(sql, "SELECT *", "SELECT id", 1), []string{src}, []string{sink}),
 			newInputs: buildInputs(sql, []string{src}, []string{sink}),
 			wantSkip:  false,
 		},
+		{
+			name:      "comment-only SQL change skips",
+			oldInputs: buildInputs(sql+" -- modified", []string{src}, []string{sink}),
+			newInputs: buildInputs(sql, []string{src}, []string{sink}),
+			wantSkip:  true,
+		},
 		{
 			name:      "SQL same but sinks changed does not skip",
 			oldInputs: buildInputs(sql, []string{src}, []string{`"db"."schema"."old_sink"`}),
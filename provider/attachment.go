@@ -0,0 +1,204 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"k8s.io/utils/ptr"
+
+	godeltastream "github.com/deltastreaminc/go-deltastream"
+)
+
+// validateAttachmentSource checks that exactly one of file (a local path) or content (base64)
+// is set and that the chosen source is readable.
+func validateAttachmentSource(file, content *string) []p.CheckFailure {
+	failures := []p.CheckFailure{}
+	hasFile := file != nil && *file != ""
+	hasContent := content != nil && *content != ""
+	switch {
+	case hasFile && hasContent:
+		failures = append(failures, p.CheckFailure{Property: "content", Reason: "file and content are mutually exclusive"})
+	case !hasFile && !hasContent:
+		failures = append(failures, p.CheckFailure{Property: "file", Reason: "one of file or content required"})
+	case hasFile:
+		if _, err := os.Stat(*file); err != nil {
+			failures = append(failures, p.CheckFailure{Property: "file", Reason: fmt.Sprintf("cannot read file: %v", err)})
+		}
+	default:
+		if _, err := base64.StdEncoding.DecodeString(*content); err != nil {
+			failures = append(failures, p.CheckFailure{Property: "content", Reason: "content must be valid base64"})
+		}
+	}
+	return failures
+}
+
+// readAttachment returns the attachment bytes from a local file path or base64 content.
+func readAttachment(file, content *string) ([]byte, error) {
+	if file != nil && *file != "" {
+		b, err := os.ReadFile(*file)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading %s: %w", *file, err)
+		}
+		return b, nil
+	}
+	if content != nil && *content != "" {
+		b, err := base64.StdEncoding.DecodeString(*content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 content: %w", err)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("no attachment source provided")
+}
+
+// attachmentHash returns the hex encoded sha256 of attachment bytes; it is stored in state
+// so content changes are detected even when the file path is unchanged.
+func attachmentHash(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// attachFile registers a named attachment for the next statement on ctx. Tests replace it to
+// capture the uploaded bytes in the in-memory driver.
+var attachFile = godeltastream.WithAttachment
+
+// withAttachment registers b as an in-memory attachment referenced in SQL by label (e.g. '@descfile').
+func withAttachment(ctx context.Context, label string, b []byte) context.Context {
+	return attachFile(ctx, label, io.NopCloser(bytes.NewReader(b)))
+}
+
+// attachmentSource is a catalog object created from an uploaded file: a descriptor source or
+// a function source. Both are created with CREATE <keyword> ... WITH ( 'file' = '<label>' ),
+// listed in a deltastream.sys catalog and replaced on any change.
+type attachmentSource struct {
+	keyword string // statement keyword, e.g. DESCRIPTOR_SOURCE
+	catalog string // deltastream.sys table listing the objects
+	label   string // attachment label the upload is sent under
+	noun    string // name used in messages
+}
+
+var (
+	descriptorSources = attachmentSource{keyword: "DESCRIPTOR_SOURCE", catalog: "descriptor_sources", label: "@descfile", noun: "descriptor source"}
+	functionSources   = attachmentSource{keyword: "FUNCTION_SOURCE", catalog: "function_sources", label: "@jarfile", noun: "function source"}
+)
+
+// attachmentSourceRow is the catalog metadata of an attachment source.
+type attachmentSourceRow struct {
+	Owner     string
+	CreatedAt time.Time
+}
+
+// check validates the name and that exactly one source of the upload is set.
+func (a attachmentSource) check(name string, file, content *string) []p.CheckFailure {
+	var failures []p.CheckFailure
+	if name == "" {
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name required"})
+	}
+	return append(failures, validateAttachmentSource(file, content)...)
+}
+
+// diff replaces the source when its name, owner or uploaded bytes change. Content is compared
+// rather than the file path so moving an identical file is not a change.
+func (a attachmentSource) diff(stateName, name string, stateOwner, owner, file, content *string, contentHash string) map[string]p.PropertyDiff {
+	diff := map[string]p.PropertyDiff{}
+	if stateName != name {
+		diff["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if owner != nil && ptr.Deref(stateOwner, "") != *owner {
+		diff["owner"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	b, err := readAttachment(file, content)
+	if err != nil || attachmentHash(b) != contentHash {
+		if file != nil {
+			diff["file"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		} else {
+			diff["content"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		}
+	}
+	return diff
+}
+
+// create uploads b and creates the source with any extra WITH properties, returning its
+// catalog row.
+func (a attachmentSource) create(ctx context.Context, name string, owner *string, b []byte, props ...string) (attachmentSourceRow, error) {
+	var row attachmentSourceRow
+	err := withScopedConn(ctx, owner, nil, nil, nil, func(ctx context.Context, conn *sql.Conn) error {
+		ctx = withAttachment(ctx, a.label, b)
+		props = append([]string{withProperty("file", quoteString(a.label))}, props...)
+		stmt := fmt.Sprintf("CREATE %s %s WITH ( %s );", a.keyword, quoteIdent(name), strings.Join(props, ", "))
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create %s: %w", a.noun, err)
+		}
+		var err error
+		if row, err = a.lookup(ctx, conn, name); err != nil {
+			return fmt.Errorf("failed to verify %s creation: %w", a.noun, err)
+		}
+		return nil
+	})
+	return row, err
+}
+
+// read returns the catalog row of the source and whether it still exists.
+func (a attachmentSource) read(ctx context.Context, name string, owner *string) (attachmentSourceRow, bool, error) {
+	var row attachmentSourceRow
+	found := true
+	err := withScopedConn(ctx, owner, nil, nil, nil, func(ctx context.Context, conn *sql.Conn) error {
+		var err error
+		row, err = a.lookup(ctx, conn, name)
+		if errors.Is(err, sql.ErrNoRows) {
+			found = false
+			return nil
+		}
+		return err
+	})
+	return row, found, err
+}
+
+// drop drops the source. A source that is already gone counts as dropped.
+func (a attachmentSource) drop(ctx context.Context, name string, owner *string) error {
+	return withScopedConn(ctx, owner, nil, nil, nil, func(ctx context.Context, conn *sql.Conn) error {
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP %s %s;", a.keyword, quoteIdent(name))); err != nil {
+			// A missing source has no dedicated SQL state, so confirm it against the catalog.
+			if _, lerr := a.lookup(ctx, conn, name); errors.Is(lerr, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to delete %s: %w", a.noun, err)
+		}
+		return nil
+	})
+}
+
+// lookup queries owner and created_at for a source.
+func (a attachmentSource) lookup(ctx context.Context, conn *sql.Conn, name string) (attachmentSourceRow, error) {
+	q := fmt.Sprintf(`SELECT "owner", created_at FROM deltastream.sys.%s WHERE name = %s;`, quoteIdent(a.catalog), quoteString(name))
+	var row attachmentSourceRow
+	if err := conn.QueryRowContext(ctx, q).Scan(&row.Owner, &row.CreatedAt); err != nil {
+		return attachmentSourceRow{}, err
+	}
+	return row, nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	presource "github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// auditLogEnv names the environment variable used when auditLogPath is not configured.
+const auditLogEnv = "DELTASTREAM_AUDIT_LOG_PATH"
+
+// auditEntry is one line of the audit log.
+type auditEntry struct {
+	Time         time.Time `json:"time"`
+	URN          string    `json:"urn,omitempty"`
+	Operation    string    `json:"operation,omitempty"`
+	Organization string    `json:"organization,omitempty"`
+	Role         string    `json:"role,omitempty"`
+	Statement    string    `json:"statement"`
+	DurationMs   int64     `json:"durationMs"`
+	Outcome      string    `json:"outcome"`
+	Error        string    `json:"error,omitempty"`
+}
+
+type auditScopeKey struct{}
+
+// auditScope identifies the provider request a statement belongs to.
+type auditScope struct {
+	urn       presource.URN
+	operation string
+}
+
+func withAuditScope(ctx context.Context, urn presource.URN, operation string) context.Context {
+	return context.WithValue(ctx, auditScopeKey{}, auditScope{urn: urn, operation: operation})
+}
+
+// auditRequests wraps a provider so that statements run while serving a request are
+// attributed to its URN and operation in the audit log.
+func auditRequests(prov p.Provider) p.Provider {
+	if invoke := prov.Invoke; invoke != nil {
+		prov.Invoke = func(ctx context.Context, req p.InvokeRequest) (p.InvokeResponse, error) {
+			return invoke(withAuditScope(ctx, "", "invoke "+string(req.Token)), req)
+		}
+	}
+	if check := prov.Check; check != nil {
+		prov.Check = func(ctx context.Context, req p.CheckRequest) (p.CheckResponse, error) {
+			return check(withAuditScope(ctx, req.Urn, "check"), req)
+		}
+	}
+	if diff := prov.Diff; diff != nil {
+		prov.Diff = func(ctx context.Context, req p.DiffRequest) (p.DiffResponse, error) {
+			return diff(withAuditScope(ctx, req.Urn, "diff"), req)
+		}
+	}
+	if create := prov.Create; create != nil {
+		prov.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			return create(withAuditScope(ctx, req.Urn, previewOp("create", req.DryRun)), req)
+		}
+	}
+	if read := prov.Read; read != nil {
+		prov.Read = func(ctx context.Context, req p.ReadRequest) (p.ReadResponse, error) {
+			return read(withAuditScope(ctx, req.Urn, "read"), req)
+		}
+	}
+	if update := prov.Update; update != nil {
+		prov.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			return update(withAuditScope(ctx, req.Urn, previewOp("update", req.DryRun)), req)
+		}
+	}
+	if del := prov.Delete; del != nil {
+		prov.Delete = func(ctx context.Context, req p.DeleteRequest) error {
+			return del(withAuditScope(ctx, req.Urn, "delete"), req)
+		}
+	}
+	return prov
+}
+
+func previewOp(op string, dryRun bool) string {
+	if dryRun {
+		return op + " (preview)"
+	}
+	return op
+}
+
+// auditLog appends JSON lines to a file. Writes from concurrent requests are serialized.
+type auditLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+var (
+	auditLogsMu sync.Mutex
+	auditLogs   = map[string]*auditLog{}
+)
+
+// openAuditLog returns the shared log for path.
+func openAuditLog(path string) *auditLog {
+	auditLogsMu.Lock()
+	defer auditLogsMu.Unlock()
+	if l, ok := auditLogs[path]; ok {
+		return l
+	}
+	l := &auditLog{path: path}
+	auditLogs[path] = l
+	return l
+}
+
+func (l *auditLog) write(ctx context.Context, e auditEntry) {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err == nil {
+		_, err = f.Write(append(line, '\n'))
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		getLogger(ctx).Warningf("cannot write audit log %s: %v", l.path, err)
+	}
+}
+
+// record writes an entry for a statement that ran from start and ended with err.
+func (l *auditLog) record(ctx context.Context, org, role, stmt string, start time.Time, err error) {
+	scope, _ := ctx.Value(auditScopeKey{}).(auditScope)
+	e := auditEntry{
+		Time:         start.UTC(),
+		URN:          string(scope.urn),
+		Operation:    scope.operation,
+		Organization: org,
+		Role:         role,
+		Statement:    redact(ctx, stmt),
+		DurationMs:   time.Since(start).Milliseconds(),
+		Outcome:      "ok",
+	}
+	if err != nil {
+		e.Outcome = "error"
+		e.Error = redact(ctx, err.Error())
+	}
+	l.write(ctx, e)
+}
+
+// auditLogPath returns the configured audit log path, falling back to the environment.
+func auditLogPath(cfg *Config) string {
+	if cfg.AuditLogPath != nil && *cfg.AuditLogPath != "" {
+		return *cfg.AuditLogPath
+	}
+	return os.Getenv(auditLogEnv)
+}
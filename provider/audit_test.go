@@ -0,0 +1,112 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAuditLog(t *testing.T, path string) []auditEntry {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close() //nolint:errcheck
+	var entries []auditEntry
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var e auditEntry
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &e), sc.Text())
+		entries = append(entries, e)
+	}
+	require.NoError(t, sc.Err())
+	return entries
+}
+
+func TestAuditLog(t *testing.T) {
+	t.Parallel()
+	_, url := newFakeDS(t)
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	server, err := integration.NewServer(context.Background(), Name, semver.MustParse("1.0.0"), integration.WithProvider(Provider()))
+	require.NoError(t, err)
+	require.NoError(t, server.Configure(p.ConfigureRequest{Args: property.NewMap(map[string]property.Value{
+		"apiKey":       property.New("test-key"),
+		"server":       property.New(url),
+		"role":         property.New("deployer"),
+		"auditLogPath": property.New(path),
+	})}))
+
+	urn := resource.NewURN("test", "provider", "", resourceType("Store"), "events")
+	inputs := property.NewMap(map[string]property.Value{
+		"name": property.New("events"),
+		"kafka": property.New(strMap(map[string]string{
+			"uris":             "kafka-1:9092",
+			"saslHashFunction": "PLAIN",
+			"saslUsername":     "svc-reader",
+			"saslPassword":     "pa55-w0rd",
+		})),
+	})
+	resp, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	_, err = server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.Error(t, err, "creating the store twice should fail")
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: resp.ID, Urn: urn, Properties: resp.Properties}))
+
+	entries := readAuditLog(t, path)
+	var creates, failed, deletes []auditEntry
+	for _, e := range entries {
+		assert.Equal(t, string(urn), e.URN)
+		assert.Equal(t, "deployer", e.Role)
+		assert.NotContains(t, e.Statement, "pa55-w0rd")
+		assert.NotContains(t, e.Statement, "svc-reader")
+		switch {
+		case e.Operation == "create" && e.Outcome == "ok" && len(e.Statement) > 12 && e.Statement[:12] == "CREATE STORE":
+			creates = append(creates, e)
+		case e.Outcome == "error":
+			failed = append(failed, e)
+		case e.Operation == "delete" && e.Statement == `DROP STORE "events";`:
+			deletes = append(deletes, e)
+		}
+	}
+	require.Len(t, creates, 1)
+	assert.Contains(t, creates[0].Statement, "'kafka.sasl.password' = '[secret]'")
+	require.NotEmpty(t, failed)
+	assert.Contains(t, failed[0].Error, "already exists")
+	assert.Len(t, deletes, 1)
+}
+
+func TestAuditLogDisabled(t *testing.T) {
+	t.Parallel()
+	f, _ := newFakeDS(t)
+	c, ok := instrumentConnector(&Config{}, f).(statementConnector)
+	require.True(t, ok)
+	assert.Nil(t, c.log)
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	c, ok = instrumentConnector(&Config{AuditLogPath: &path}, f).(statementConnector)
+	require.True(t, ok)
+	assert.NotNil(t, c.log)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main exports an existing DeltaStream organization as a Pulumi program.
+//
+// It writes index.ts, main.go and import.json to the output directory. Connection settings
+// are read from the same environment variables as the provider configuration.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/deltastreaminc/pulumi-deltastream/provider"
+)
+
+func main() {
+	out := flag.String("out", ".", "directory to write index.ts, main.go and import.json to")
+	databases := flag.String("databases", "", "comma-separated databases to export (default: all)")
+	flag.Parse()
+
+	if err := runTraced(*out, *databases); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+// runTraced runs the export with tracing set up from the OTEL_* environment variables.
+func runTraced(out, databases string) error {
+	shutdown, err := provider.SetupTracing(context.Background())
+	if err != nil {
+		return err
+	}
+	defer shutdown(context.Background()) //nolint:errcheck
+	return run(out, databases)
+}
+
+func run(out, databases string) error {
+	cfg := provider.Config{
+		APIKey:       envPtr("DELTASTREAM_API_KEY"),
+		Server:       envPtr("DELTASTREAM_SERVER"),
+		Organization: envPtr("DELTASTREAM_ORGANIZATION"),
+		Role:         envPtr("DELTASTREAM_ROLE"),
+		SessionID:    envPtr("DELTASTREAM_SESSION_ID"),
+	}
+	if v := os.Getenv("DELTASTREAM_INSECURE_SKIP_VERIFY"); v == "true" || v == "1" {
+		skip := true
+		cfg.InsecureSkipVerify = &skip
+	}
+	var opts provider.ExportOptions
+	if databases != "" {
+		opts.Databases = strings.Split(databases, ",")
+	}
+
+	inv, err := provider.Export(context.Background(), cfg, opts)
+	if err != nil {
+		return err
+	}
+	goSrc, err := inv.Go()
+	if err != nil {
+		return fmt.Errorf("failed to render Go program: %w", err)
+	}
+	importFile, err := inv.ImportFile()
+	if err != nil {
+		return fmt.Errorf("failed to render import file: %w", err)
+	}
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+	files := map[string][]byte{
+		"index.ts":    []byte(inv.TypeScript()),
+		"main.go":     goSrc,
+		"import.json": importFile,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(out, name), content, 0o644); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Exported %d resources to %s\n", len(inv.Resources), out)
+	return nil
+}
+
+func envPtr(name string) *string {
+	if v := os.Getenv(name); v != "" {
+		return &v
+	}
+	return nil
+}
@@ -25,9 +25,17 @@ import (
 
 // Serve the provider against Pulumi's Provider protocol.
 func main() {
-	err := provider.Provider().Run(context.Background(), provider.Name, provider.Version)
-	if err != nil {
+	if err := run(context.Background()); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %s", err.Error())
 		os.Exit(1)
 	}
 }
+
+func run(ctx context.Context) error {
+	shutdown, err := provider.SetupTracing(ctx)
+	if err != nil {
+		return err
+	}
+	defer shutdown(context.Background()) //nolint:errcheck
+	return provider.Provider().Run(ctx, provider.Name, provider.Version)
+}
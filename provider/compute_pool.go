@@ -0,0 +1,324 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+)
+
+// ComputePool resource providing dedicated compute for queries and applications.
+type ComputePool struct{}
+
+// Annotate sets descriptions on ComputePool for schema generation.
+func (c *ComputePool) Annotate(a infer.Annotator) {
+	a.Describe(c, "Compute pool resource providing dedicated compute on which queries and applications run")
+}
+
+// ComputePoolArgs defines user inputs for a compute pool.
+type ComputePoolArgs struct {
+	Name           string  `pulumi:"name"`
+	Size           *string `pulumi:"size,optional"`
+	TimeoutMinutes *int    `pulumi:"timeoutMinutes,optional"`
+	Running        *bool   `pulumi:"running,optional"`
+	Owner          *string `pulumi:"owner,optional"`
+}
+
+// Annotate sets descriptions on ComputePoolArgs fields for schema generation.
+func (a *ComputePoolArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Name, "Name of the compute pool")
+	an.Describe(&a.Size, "Pool size (small, medium or large). Resized in place.")
+	an.Describe(&a.TimeoutMinutes, "Idle minutes before the pool stops automatically. Updated in place.")
+	an.Describe(&a.Running, "Desired run state. true starts the pool, false stops it; unset leaves it as is.")
+	an.Describe(&a.Owner, "Optional owning role. When set, statements execute as this role.")
+}
+
+// ComputePoolState extends inputs with computed fields.
+type ComputePoolState struct {
+	ComputePoolArgs
+	State     string `pulumi:"state"`
+	OwnerOut  string `pulumi:"owner"`
+	CreatedAt string `pulumi:"createdAt"`
+	UpdatedAt string `pulumi:"updatedAt"`
+}
+
+// Annotate sets descriptions on ComputePoolState fields for schema generation.
+func (s *ComputePoolState) Annotate(a infer.Annotator) {
+	a.Describe(&s.State, "Status of the compute pool as reported by the catalog")
+}
+
+var computePoolSizes = []string{"small", "medium", "large"}
+
+// Check validates compute pool inputs.
+func (ComputePool) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[ComputePoolArgs], error) {
+	args, failures, err := infer.DefaultCheck[ComputePoolArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[ComputePoolArgs]{}, err
+	}
+	if args.Name == "" {
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name required"})
+	}
+	if args.Size != nil {
+		valid := false
+		for _, s := range computePoolSizes {
+			if strings.EqualFold(*args.Size, s) {
+				valid = true
+			}
+		}
+		if !valid {
+			failures = append(failures, p.CheckFailure{Property: "size", Reason: fmt.Sprintf("size must be one of %s", strings.Join(computePoolSizes, ", "))})
+		}
+	}
+	if args.TimeoutMinutes != nil && *args.TimeoutMinutes < 1 {
+		failures = append(failures, p.CheckFailure{Property: "timeoutMinutes", Reason: "timeoutMinutes must be at least 1"})
+	}
+	return infer.CheckResponse[ComputePoolArgs]{Inputs: args, Failures: failures}, nil
+}
+
+// Diff replaces the pool when renamed or re-owned; size, timeout and run state update in place.
+func (ComputePool) Diff(ctx context.Context, req infer.DiffRequest[ComputePoolArgs, ComputePoolState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	if req.State.Name != req.Inputs.Name {
+		diff["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Owner != nil && ptr.Deref(req.State.Owner, "") != *req.Inputs.Owner {
+		diff["owner"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if !strings.EqualFold(ptr.Deref(req.State.Size, ""), ptr.Deref(req.Inputs.Size, "")) {
+		diff["size"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if ptr.Deref(req.State.TimeoutMinutes, 0) != ptr.Deref(req.Inputs.TimeoutMinutes, 0) {
+		diff["timeoutMinutes"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if req.Inputs.Running != nil && (req.State.Running == nil || *req.State.Running != *req.Inputs.Running) {
+		diff["running"] = p.PropertyDiff{Kind: p.Update}
+	}
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff, DeleteBeforeReplace: true}, nil
+}
+
+// Create issues CREATE COMPUTE_POOL and applies the desired run state.
+func (ComputePool) Create(ctx context.Context, req infer.CreateRequest[ComputePoolArgs]) (infer.CreateResponse[ComputePoolState], error) {
+	in := req.Inputs
+	if req.DryRun {
+		now := time.Now().UTC().Format(time.RFC3339)
+		return infer.CreateResponse[ComputePoolState]{ID: in.Name, Output: ComputePoolState{ComputePoolArgs: in, CreatedAt: now, UpdatedAt: now}}, nil
+	}
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.CreateResponse[ComputePoolState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(in.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.CreateResponse[ComputePoolState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	stmt := fmt.Sprintf("CREATE COMPUTE_POOL %s;", quoteIdent(in.Name))
+	if props := computePoolProperties(&ComputePoolArgs{}, &in); len(props) > 0 {
+		stmt = fmt.Sprintf("CREATE COMPUTE_POOL %s WITH ( %s );", quoteIdent(in.Name), strings.Join(props, ", "))
+	}
+	if _, err := conn.ExecContext(ctx, stmt); err != nil {
+		return infer.CreateResponse[ComputePoolState]{}, fmt.Errorf("failed to create compute pool: %w", err)
+	}
+	row, err := lookupComputePool(ctx, conn, in.Name)
+	if err != nil {
+		return infer.CreateResponse[ComputePoolState]{}, fmt.Errorf("failed to verify compute pool creation: %w", err)
+	}
+	if in.Running != nil && *in.Running != computePoolRunning(row.State) {
+		if row, err = setComputePoolRunning(ctx, conn, in.Name, *in.Running); err != nil {
+			return infer.CreateResponse[ComputePoolState]{}, err
+		}
+	}
+	getLogger(ctx).Info(fmt.Sprintf("Compute pool created: %s", in.Name))
+	return infer.CreateResponse[ComputePoolState]{ID: in.Name, Output: computePoolState(in, row)}, nil
+}
+
+// Read refreshes compute pool metadata from the system catalog.
+func (ComputePool) Read(ctx context.Context, req infer.ReadRequest[ComputePoolArgs, ComputePoolState]) (infer.ReadResponse[ComputePoolArgs, ComputePoolState], error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.ReadResponse[ComputePoolArgs, ComputePoolState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.ReadResponse[ComputePoolArgs, ComputePoolState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	row, err := lookupComputePool(ctx, conn, req.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return infer.ReadResponse[ComputePoolArgs, ComputePoolState]{}, nil
+		}
+		return infer.ReadResponse[ComputePoolArgs, ComputePoolState]{}, err
+	}
+	in := req.State.ComputePoolArgs
+	imported := in.Name == ""
+	in.Name = req.ID
+	if (in.Size != nil || imported) && row.Size != "" {
+		in.Size = &row.Size
+	}
+	if (in.TimeoutMinutes != nil || imported) && row.TimeoutMinutes.Valid {
+		timeout := int(row.TimeoutMinutes.Int64)
+		in.TimeoutMinutes = &timeout
+	}
+	st := computePoolState(in, row)
+	return infer.ReadResponse[ComputePoolArgs, ComputePoolState]{ID: req.ID, Inputs: st.ComputePoolArgs, State: st}, nil
+}
+
+// Update resizes the pool and starts or stops it without recreating dependent queries.
+func (ComputePool) Update(ctx context.Context, req infer.UpdateRequest[ComputePoolArgs, ComputePoolState]) (infer.UpdateResponse[ComputePoolState], error) {
+	in := req.Inputs
+	if req.DryRun {
+		st := req.State
+		st.ComputePoolArgs = in
+		return infer.UpdateResponse[ComputePoolState]{Output: st}, nil
+	}
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.UpdateResponse[ComputePoolState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.UpdateResponse[ComputePoolState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if props := computePoolProperties(&req.State.ComputePoolArgs, &in); len(props) > 0 {
+		stmt := fmt.Sprintf("UPDATE COMPUTE_POOL %s WITH ( %s );", quoteIdent(req.ID), strings.Join(props, ", "))
+		if _, err := conn.ExecContext(ctx, stmt); err != nil {
+			return infer.UpdateResponse[ComputePoolState]{}, fmt.Errorf("failed updating compute pool: %w", err)
+		}
+	}
+	row, err := lookupComputePool(ctx, conn, req.ID)
+	if err != nil {
+		return infer.UpdateResponse[ComputePoolState]{}, err
+	}
+	if in.Running != nil && *in.Running != computePoolRunning(row.State) {
+		if row, err = setComputePoolRunning(ctx, conn, req.ID, *in.Running); err != nil {
+			return infer.UpdateResponse[ComputePoolState]{}, err
+		}
+	}
+	return infer.UpdateResponse[ComputePoolState]{Output: computePoolState(in, row)}, nil
+}
+
+// Delete drops the compute pool.
+func (ComputePool) Delete(ctx context.Context, req infer.DeleteRequest[ComputePoolState]) (infer.DeleteResponse, error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP COMPUTE_POOL %s;", quoteIdent(req.ID))); err != nil {
+		// A missing compute pool has no dedicated SQL state, so confirm it against the catalog.
+		if _, lerr := lookupComputePool(ctx, conn, req.ID); errors.Is(lerr, sql.ErrNoRows) {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete compute pool: %w", err)
+	}
+	return infer.DeleteResponse{}, nil
+}
+
+// WireDependencies declares resource graph dependencies for Pulumi.
+func (ComputePool) WireDependencies(f infer.FieldSelector, args *ComputePoolArgs, state *ComputePoolState) {
+	f.OutputField(&state.State).DependsOn(f.InputField(&args.Running))
+	f.OutputField(&state.State).DependsOn(f.InputField(&args.Size))
+}
+
+// computePoolRow is a helper struct for querying compute pool metadata.
+type computePoolRow struct {
+	Size, State, Owner   string
+	TimeoutMinutes       sql.NullInt64
+	CreatedAt, UpdatedAt time.Time
+}
+
+// lookupComputePool fetches compute pool metadata from the catalog.
+func lookupComputePool(ctx context.Context, conn *sql.Conn, name string) (computePoolRow, error) {
+	q := fmt.Sprintf(`SELECT "size", timeout_min, status, "owner", created_at, updated_at FROM deltastream.sys."compute_pools" WHERE name = %s;`, quoteString(name))
+	var r computePoolRow
+	err := conn.QueryRowContext(ctx, q).Scan(&r.Size, &r.TimeoutMinutes, &r.State, &r.Owner, &r.CreatedAt, &r.UpdatedAt)
+	return r, err
+}
+
+// computePoolProperties renders WITH properties that differ between old and curr.
+func computePoolProperties(old, curr *ComputePoolArgs) []string {
+	props := []string{}
+	if curr.Size != nil && !strings.EqualFold(ptr.Deref(old.Size, ""), *curr.Size) {
+		props = append(props, fmt.Sprintf("'compute_pool.size' = %s", quoteString(strings.ToLower(*curr.Size))))
+	}
+	if curr.TimeoutMinutes != nil && ptr.Deref(old.TimeoutMinutes, 0) != *curr.TimeoutMinutes {
+		props = append(props, fmt.Sprintf("'compute_pool.timeout_min' = %d", *curr.TimeoutMinutes))
+	}
+	return props
+}
+
+// computePoolRunning reports whether a catalog status means the pool is (becoming) available.
+func computePoolRunning(status string) bool {
+	switch strings.ToLower(status) {
+	case "running", "starting", "provisioning", "ready":
+		return true
+	default:
+		return false
+	}
+}
+
+// setComputePoolRunning starts or stops a pool and returns its refreshed catalog row.
+func setComputePoolRunning(ctx context.Context, conn *sql.Conn, name string, running bool) (computePoolRow, error) {
+	verb := "STOP"
+	if running {
+		verb = "START"
+	}
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("%s COMPUTE_POOL %s;", verb, quoteIdent(name))); err != nil {
+		return computePoolRow{}, fmt.Errorf("failed to %s compute pool: %w", strings.ToLower(verb), err)
+	}
+	return lookupComputePool(ctx, conn, name)
+}
+
+func computePoolState(in ComputePoolArgs, row computePoolRow) ComputePoolState {
+	return ComputePoolState{ComputePoolArgs: in, State: row.State, OwnerOut: row.Owner, CreatedAt: row.CreatedAt.Format(time.RFC3339), UpdatedAt: row.UpdatedAt.Format(time.RFC3339)}
+}
+
+// setComputePool selects the compute pool on which subsequently started queries run.
+func setComputePool(ctx context.Context, conn *sql.Conn, pool string) error {
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("USE COMPUTE_POOL %s;", quoteIdent(pool))); err != nil {
+		return fmt.Errorf("failed to use compute pool %s: %w", pool, err)
+	}
+	return nil
+}
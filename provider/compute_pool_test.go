@@ -0,0 +1,82 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestComputePoolResizeIsInPlace(t *testing.T) {
+	t.Parallel()
+
+	state := ComputePoolState{ComputePoolArgs: ComputePoolArgs{Name: "pool", Size: ptr.To("small"), TimeoutMinutes: ptr.To(30)}}
+	inputs := ComputePoolArgs{Name: "pool", Size: ptr.To("LARGE"), TimeoutMinutes: ptr.To(30), Running: ptr.To(false)}
+
+	resp, err := ComputePool{}.Diff(context.Background(), infer.DiffRequest[ComputePoolArgs, ComputePoolState]{State: state, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]p.DiffKind{"size": p.Update, "running": p.Update}
+	if len(resp.DetailedDiff) != len(want) {
+		t.Fatalf("got diff %v, want %v", resp.DetailedDiff, want)
+	}
+	for k, kind := range want {
+		if resp.DetailedDiff[k].Kind != kind {
+			t.Errorf("diff[%s] = %v, want %v", k, resp.DetailedDiff[k].Kind, kind)
+		}
+	}
+
+	props := computePoolProperties(&state.ComputePoolArgs, &inputs)
+	if len(props) != 1 || props[0] != "'compute_pool.size' = 'large'" {
+		t.Errorf("unexpected update properties %v", props)
+	}
+}
+
+func TestQueryComputePoolChangeReplaces(t *testing.T) {
+	t.Parallel()
+
+	sql := `INSERT INTO "db"."s"."sink" SELECT * FROM "db"."s"."src";`
+	state := QueryState{QueryArgs: QueryArgs{SQL: sql, SinkRelationFqn: `"db"."s"."sink"`, SourceRelationFqns: []string{`"db"."s"."src"`}}}
+	inputs := state.QueryArgs
+	inputs.ComputePool = ptr.To("pool")
+
+	resp, err := Query{}.Diff(context.Background(), infer.DiffRequest[QueryArgs, QueryState]{State: state, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.DetailedDiff) != 1 || resp.DetailedDiff["computePool"].Kind != p.UpdateReplace {
+		t.Errorf("expected computePool replacement, got %v", resp.DetailedDiff)
+	}
+}
+
+func TestComputePoolDeleteAlreadyGone(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("ComputePool"), "pool")
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: strMap(map[string]string{"name": "pool", "size": "small"})})
+	require.NoError(t, err)
+	f.seed(t, `DROP COMPUTE_POOL "pool"`)
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn, Properties: created.Properties}),
+		"deleting a compute pool that no longer exists should succeed")
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/tls"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"net"
 	"net/http"
@@ -25,9 +26,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
-	p "github.com/pulumi/pulumi-go-provider"
 
 	ds "github.com/deltastreaminc/go-deltastream"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 // buildHTTPClient builds an HTTP client with TLS and timeouts similar to Terraform provider.
@@ -64,12 +66,28 @@ func (d roundTripperWithUA) RoundTrip(h *http.Request) (*http.Response, error) {
 		ua += " session/" + *d.sessionID
 	}
 	h.Header.Set("User-Agent", ua)
+	otel.GetTextMapPropagator().Inject(h.Context(), propagation.HeaderCarrier(h.Header))
 	return d.r.RoundTrip(h)
 }
 
+// newConnector builds the driver connector for a server. Tests replace it to run the
+// provider against an in-memory driver instead of the DeltaStream API.
+var newConnector = func(ctx context.Context, server string, opts ...ds.ConnectionOption) (driver.Connector, error) {
+	return ds.ConnectorWithOptions(ctx, opts...)
+}
+
+// statementContexter is implemented by driver connections other than *ds.Conn that accept
+// the organization, role and default database, namespace and store for later statements.
+// Empty values leave the current setting unchanged.
+type statementContexter interface {
+	setStatementContext(org, role, database, schema, store string)
+}
+
 // openDB returns an sql.DB configured with server, api key, and HTTP client.
-func openDB(ctx context.Context, cfg *Config) (*sql.DB, error) {
-	logger := p.GetLogger(ctx)
+func openDB(ctx context.Context, cfg *Config) (db *sql.DB, err error) {
+	ctx, span := tracer().Start(ctx, "deltastream connect")
+	defer func() { endSpan(ctx, span, err) }()
+	logger := getLogger(ctx)
 
 	if cfg.APIKey == nil || *cfg.APIKey == "" {
 		return nil, fmt.Errorf("apiKey is required")
@@ -86,11 +104,11 @@ func openDB(ctx context.Context, cfg *Config) (*sql.DB, error) {
 		opts = append(opts, ds.WithSessionID(*cfg.SessionID))
 	}
 
-	connector, err := ds.ConnectorWithOptions(ctx, opts...)
+	connector, err := newConnector(ctx, server, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connector: %w", err)
 	}
-	db := sql.OpenDB(connector)
+	db = sql.OpenDB(instrumentConnector(cfg, connector))
 	if err := db.PingContext(ctx); err != nil {
 		_ = db.Close()
 		return nil, fmt.Errorf("failed to ping server: %w", err)
@@ -107,6 +125,7 @@ func withOrgRole(ctx context.Context, db *sql.DB, org, role string) (context.Con
 	}
 
 	if err := conn.Raw(func(driverConn interface{}) error {
+		driverConn = rawConn(driverConn, org, role)
 		if c, ok := driverConn.(*ds.Conn); ok {
 			rsctx := c.GetContext()
 			if org != "" {
@@ -118,6 +137,8 @@ func withOrgRole(ctx context.Context, db *sql.DB, org, role string) (context.Con
 				rsctx.RoleName = &role
 			}
 			c.SetContext(rsctx)
+		} else if c, ok := driverConn.(statementContexter); ok {
+			c.setStatementContext(org, role, "", "", "")
 		}
 		return nil
 	}); err != nil {
@@ -131,12 +152,34 @@ func withOrgRole(ctx context.Context, db *sql.DB, org, role string) (context.Con
 	return ctx, conn, nil
 }
 
-// quoteIdent returns a double-quoted SQL identifier with embedded quotes escaped.
-// It does not attempt full normalization beyond doubling internal quotes.
-func quoteIdent(in string) string {
-	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(in, "\"", "\"\""))
-}
-
-func quoteString(in string) string {
-	return fmt.Sprintf("'%s'", strings.ReplaceAll(in, "'", "''"))
+// scanRowMaps reads every remaining row into a map keyed by column name. Column names are
+// lower-cased with spaces replaced by underscores so "Is Leaf" becomes "is_leaf"; NULL values
+// are returned as empty strings. It is intended for statements (LIST/DESCRIBE) whose result
+// shape is not fixed.
+func scanRowMaps(rows *sql.Rows) ([]map[string]string, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(cols))
+	for i, c := range cols {
+		keys[i] = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(c)), " ", "_")
+	}
+	var out []map[string]string
+	for rows.Next() {
+		vals := make([]sql.NullString, len(cols))
+		dest := make([]any, len(cols))
+		for i := range vals {
+			dest[i] = &vals[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		m := make(map[string]string, len(cols))
+		for i, v := range vals {
+			m[keys[i]] = v.String
+		}
+		out = append(out, m)
+	}
+	return out, rows.Err()
 }
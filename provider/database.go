@@ -42,12 +42,15 @@ type DatabaseArgs struct {
 	Name string `pulumi:"name"`
 	// Owning role; overrides provider role for creation (optional)
 	Owner *string `pulumi:"owner,optional"`
+	// Terminate queries and drop relations in the database on delete (optional)
+	ForceDestroy *bool `pulumi:"forceDestroy,optional"`
 }
 
 // Annotate sets descriptions on DatabaseArgs fields for schema generation.
 func (d *DatabaseArgs) Annotate(a infer.Annotator) {
 	a.Describe(&d.Name, "The name of the database to create. If the name is case sensitive, wrap it in quotes.")
-	a.Describe(&d.Owner, "Optional owning role. When set, statements execute as this role during create.")
+	a.Describe(&d.Owner, "Optional owning role. When set, statements execute as this role during create; changing it transfers ownership in place.")
+	a.Describe(&d.ForceDestroy, "When true, deleting the database first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.")
 }
 
 // DatabaseState is what's persisted in state.
@@ -67,7 +70,7 @@ func (d *DatabaseState) Annotate(a infer.Annotator) {
 // Create creates a new instance of the database resource.
 func (Database) Create(ctx context.Context, req infer.CreateRequest[DatabaseArgs]) (infer.CreateResponse[DatabaseState], error) {
 	input := req.Inputs
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(fmt.Sprintf("Creating database %s", input.Name))
 
 	if req.DryRun {
@@ -108,8 +111,9 @@ func (Database) Create(ctx context.Context, req infer.CreateRequest[DatabaseArgs
 
 	state := DatabaseState{
 		DatabaseArgs: DatabaseArgs{
-			Name:  input.Name,
-			Owner: &owner,
+			Name:         input.Name,
+			Owner:        &owner,
+			ForceDestroy: input.ForceDestroy,
 		},
 		CreatedAt: createdAt.Format(time.RFC3339),
 	}
@@ -123,7 +127,7 @@ func (Database) Read(
 	ctx context.Context,
 	req infer.ReadRequest[DatabaseArgs, DatabaseState],
 ) (infer.ReadResponse[DatabaseArgs, DatabaseState], error) {
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(fmt.Sprintf("Reading database with ID: %s", req.ID))
 
 	// Open connection using provider config
@@ -152,31 +156,70 @@ func (Database) Read(
 		return infer.ReadResponse[DatabaseArgs, DatabaseState]{}, fmt.Errorf("failed to read database: %w", err)
 	}
 
+	// State carries the server-side owner so an out-of-band ownership change shows up as a
+	// diff against the declared owner; inputs keep what was declared.
 	state := DatabaseState{
 		DatabaseArgs: DatabaseArgs{
-			Name:  req.ID,
-			Owner: &owner,
+			Name:         req.ID,
+			Owner:        &owner,
+			ForceDestroy: req.State.ForceDestroy,
 		},
 		CreatedAt: createdAt.Format(time.RFC3339),
 	}
+	in := req.Inputs
+	in.Name = req.ID
 
-	return infer.ReadResponse[DatabaseArgs, DatabaseState]{ID: req.ID, Inputs: state.DatabaseArgs, State: state}, nil
+	return infer.ReadResponse[DatabaseArgs, DatabaseState]{ID: req.ID, Inputs: in, State: state}, nil
 }
 
 // Update updates an existing database.
 func (Database) Update(ctx context.Context, req infer.UpdateRequest[DatabaseArgs, DatabaseState]) (infer.UpdateResponse[DatabaseState], error) {
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(fmt.Sprintf("Updating database with ID: %s", req.ID))
 
+	// forceDestroy only affects Delete; record it without touching the server.
+	st := req.State
+	st.ForceDestroy = req.Inputs.ForceDestroy
+	if req.Inputs.Owner == nil || ptr.Equal(req.State.Owner, req.Inputs.Owner) {
+		return infer.UpdateResponse[DatabaseState]{Output: st}, nil
+	}
 	if req.DryRun {
-		return infer.UpdateResponse[DatabaseState]{}, nil
+		st.Owner = req.Inputs.Owner
+		return infer.UpdateResponse[DatabaseState]{Output: st}, nil
+	}
+
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.UpdateResponse[DatabaseState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+
+	// The transfer has to be issued by the current owner.
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.UpdateResponse[DatabaseState]{}, err
 	}
-	return infer.UpdateResponse[DatabaseState]{}, fmt.Errorf("database updates not supported")
+	defer conn.Close() //nolint:errcheck
+
+	stmt := fmt.Sprintf("ALTER DATABASE %s OWNER TO %s;", quoteIdent(req.ID), quoteIdent(*req.Inputs.Owner))
+	if _, err := conn.ExecContext(ctx, stmt); err != nil {
+		return infer.UpdateResponse[DatabaseState]{}, fmt.Errorf("failed altering owner: %w", err)
+	}
+	owner, _, err := lookupDatabase(ctx, conn, req.ID)
+	if err != nil {
+		return infer.UpdateResponse[DatabaseState]{}, err
+	}
+	st.Owner = &owner
+	logger.Info(fmt.Sprintf("Database %s owner changed to %s", req.ID, owner))
+	return infer.UpdateResponse[DatabaseState]{Output: st}, nil
 }
 
 // Delete deletes an existing database.
 func (Database) Delete(ctx context.Context, req infer.DeleteRequest[DatabaseState]) (infer.DeleteResponse, error) {
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(fmt.Sprintf("Deleting database with ID: %s", req.ID))
 
 	// Open connection using provider config
@@ -196,8 +239,18 @@ func (Database) Delete(ctx context.Context, req infer.DeleteRequest[DatabaseStat
 	}
 	defer conn.Close() //nolint:errcheck
 
+	rels, err := listRelationPaths(ctx, conn, "database_name = "+quoteString(req.ID))
+	if err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("failed to list relations of database %s: %w", req.ID, err)
+	}
+	if err := clearDependents(ctx, conn, "database "+req.ID, rels, true, req.State.ForceDestroy); err != nil {
+		return infer.DeleteResponse{}, err
+	}
 	if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP DATABASE %s;", quoteIdent(req.ID))); err != nil {
-		return infer.DeleteResponse{}, fmt.Errorf("failed to delete database: %w", err)
+		var sqlErr ds.ErrSQLError
+		if !errors.As(err, &sqlErr) || sqlErr.SQLCode != ds.SqlStateInvalidDatabase {
+			return infer.DeleteResponse{}, fmt.Errorf("failed to delete database: %w", err)
+		}
 	}
 
 	logger.Info(fmt.Sprintf("Database deleted successfully: %s", req.ID))
@@ -213,6 +266,9 @@ func (Database) Diff(ctx context.Context, req infer.DiffRequest[DatabaseArgs, Da
 	if (req.State.Owner == nil && req.Inputs.Owner != nil) || (req.State.Owner != nil && req.Inputs.Owner != nil && *req.State.Owner != *req.Inputs.Owner) {
 		diff["owner"] = p.PropertyDiff{Kind: p.Update}
 	}
+	if forceDestroyChanged(req.State.ForceDestroy, req.Inputs.ForceDestroy) {
+		diff["forceDestroy"] = p.PropertyDiff{Kind: p.Update}
+	}
 	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff}, nil
 }
 
@@ -222,6 +278,9 @@ func (Database) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckR
 	if err != nil {
 		return infer.CheckResponse[DatabaseArgs]{}, err
 	}
+	if old, _, oerr := infer.DefaultCheck[DatabaseArgs](ctx, req.OldInputs); oerr == nil && old.Name != "" && old.Name != args.Name {
+		checkRename(ctx, old.Owner, "database "+old.Name, args.Name, "database_name = "+quoteString(old.Name))
+	}
 	return infer.CheckResponse[DatabaseArgs]{
 		Inputs:   args,
 		Failures: failures,
@@ -237,7 +296,7 @@ func (Database) WireDependencies(f infer.FieldSelector, args *DatabaseArgs, stat
 // lookupDatabase queries owner and created_at for a database
 func lookupDatabase(ctx context.Context, conn *sql.Conn, name string) (owner string, createdAt time.Time, err error) {
 	q := fmt.Sprintf(`SELECT "owner", created_at FROM deltastream.sys."databases" WHERE name = %s;`, quoteString(name))
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(q)
 	row := conn.QueryRowContext(ctx, q)
 	if err := row.Err(); err != nil {
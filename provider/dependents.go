@@ -0,0 +1,234 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+
+	ds "github.com/deltastreaminc/go-deltastream"
+)
+
+// deleteBlockers lists the server-side objects that keep a resource from being dropped.
+type deleteBlockers struct {
+	// Queries are IDs of running queries or applications reading or writing an affected relation.
+	Queries []string
+	// Relations are paths of relations that live in (or are backed by) the resource.
+	Relations [][]string
+}
+
+func (b deleteBlockers) empty() bool {
+	return len(b.Queries) == 0 && len(b.Relations) == 0
+}
+
+func (b deleteBlockers) String() string {
+	var parts []string
+	for _, id := range b.Queries {
+		parts = append(parts, "query "+id)
+	}
+	for _, path := range b.Relations {
+		parts = append(parts, "relation "+getFQN(path))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// queryTerminal reports whether a query state no longer holds on to its relations.
+func queryTerminal(state string) bool {
+	switch strings.ToLower(state) {
+	case "terminated", "terminate_requested", "errored":
+		return true
+	}
+	return false
+}
+
+// listRelationPaths returns the paths of relations matching a catalog filter.
+func listRelationPaths(ctx context.Context, conn *sql.Conn, where string) ([][]string, error) {
+	return exportRows(ctx, conn, fmt.Sprintf(`SELECT database_name, schema_name, name FROM deltastream.sys."relations" WHERE %s ORDER BY database_name, schema_name, name;`, where))
+}
+
+// queriesUsing returns the IDs of running queries whose plan reads or writes any of the
+// given relations. Queries that can no longer be described are skipped.
+func queriesUsing(ctx context.Context, conn *sql.Conn, relations [][]string) ([]string, error) {
+	if len(relations) == 0 {
+		return nil, nil
+	}
+	wanted := make([]string, len(relations))
+	for i, path := range relations {
+		wanted[i] = getFQN(path)
+	}
+	rows, err := exportRows(ctx, conn, `SELECT id, current_state FROM deltastream.sys."queries" ORDER BY created_at;`)
+	if err != nil {
+		return nil, err
+	}
+	logger := getLogger(ctx)
+	var ids []string
+	for _, r := range rows {
+		if queryTerminal(r[1]) {
+			continue
+		}
+		sqlText, err := lookupQuerySQL(ctx, conn, r[0])
+		if err != nil {
+			return nil, err
+		}
+		kind, plan, err := describeQuery(ctx, conn, sqlText)
+		if err != nil {
+			logger.Warningf("cannot determine relations used by query %s: %v", r[0], err)
+			continue
+		}
+		var used []string
+		if kind == "APPLICATION" {
+			_, appPlan, err := describeApplication(ctx, conn, sqlText)
+			if err != nil {
+				logger.Warningf("cannot determine relations used by application %s: %v", r[0], err)
+				continue
+			}
+			in := applicationArgsFromPlan(sqlText, appPlan)
+			used = append(in.SinkRelationFqns, in.SourceRelationFqns...)
+		} else {
+			in := queryArgsFromPlan(sqlText, plan)
+			used = append(in.SourceRelationFqns, in.SinkRelationFqn)
+		}
+		if slices.ContainsFunc(used, func(fqn string) bool { return slices.Contains(wanted, fqn) }) {
+			ids = append(ids, r[0])
+		}
+	}
+	return ids, nil
+}
+
+// findBlockers collects the running queries using relations and, when includeRelations is
+// set, the relations themselves.
+func findBlockers(ctx context.Context, conn *sql.Conn, relations [][]string, includeRelations bool) (deleteBlockers, error) {
+	var b deleteBlockers
+	ids, err := queriesUsing(ctx, conn, relations)
+	if err != nil {
+		return b, err
+	}
+	b.Queries = ids
+	if includeRelations {
+		b.Relations = relations
+	}
+	return b, nil
+}
+
+// removeBlockers terminates blocking queries and then drops blocking relations.
+func removeBlockers(ctx context.Context, conn *sql.Conn, b deleteBlockers) error {
+	logger := getLogger(ctx)
+	for _, id := range b.Queries {
+		logger.Info(fmt.Sprintf("forceDestroy: terminating query %s", id))
+		qid, err := queryIDToken(id)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("TERMINATE QUERY %s;", qid)); err != nil {
+			var sqlErr ds.ErrSQLError
+			if !errors.As(err, &sqlErr) || sqlErr.SQLCode != ds.SqlStateInvalidQuery {
+				return fmt.Errorf("failed to terminate query %s: %w", id, err)
+			}
+		}
+		if err := waitForQueryTerminated(ctx, conn, id, 5*time.Minute); err != nil {
+			return fmt.Errorf("query %s did not terminate: %w", id, err)
+		}
+	}
+	for _, path := range b.Relations {
+		logger.Info(fmt.Sprintf("forceDestroy: dropping relation %s", getFQN(path)))
+		if err := dropRelation(ctx, conn, getFQN(path)); err != nil {
+			return fmt.Errorf("failed to drop relation %s: %w", getFQN(path), err)
+		}
+		if err := waitForRelationGone(ctx, conn, path, time.Minute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clearDependents fails with the list of blockers for what, or removes them when force is set.
+func clearDependents(ctx context.Context, conn *sql.Conn, what string, relations [][]string, includeRelations bool, force *bool) error {
+	b, err := findBlockers(ctx, conn, relations, includeRelations)
+	if err != nil {
+		return fmt.Errorf("failed to check dependents of %s: %w", what, err)
+	}
+	if b.empty() {
+		return nil
+	}
+	if !ptr.Deref(force, false) {
+		return fmt.Errorf("cannot delete %s, it is still used by: %s; remove them first or set forceDestroy to terminate and drop them", what, b)
+	}
+	return removeBlockers(ctx, conn, b)
+}
+
+// lenientDeleteErr returns err unless the provider is configured with lenientDelete, in which
+// case the failure is logged and the resource is removed from state anyway.
+func lenientDeleteErr(ctx context.Context, cfg *Config, err error) error {
+	if err == nil || !ptr.Deref(cfg.LenientDelete, false) {
+		return err
+	}
+	getLogger(ctx).Warningf("lenientDelete: ignoring delete failure: %v", err)
+	return nil
+}
+
+// forceDestroyChanged reports whether the forceDestroy input changed, treating unset as false.
+func forceDestroyChanged(old, curr *bool) bool {
+	return ptr.Deref(old, false) != ptr.Deref(curr, false)
+}
+
+// warnRenameDependents logs the server-side dependents that a rename of what will replace.
+// DeltaStream cannot rename databases, namespaces, stores or relations in place, so a new
+// name always means create-then-drop; the warning surfaces the fallout during preview.
+func warnRenameDependents(ctx context.Context, conn *sql.Conn, what, newName string, relations [][]string, includeRelations bool) {
+	logger := getLogger(ctx)
+	b, err := findBlockers(ctx, conn, relations, includeRelations)
+	if err != nil {
+		logger.Debugf("cannot list dependents of %s: %v", what, err)
+		return
+	}
+	if b.empty() {
+		logger.Warningf("renaming %s to %s replaces it; DeltaStream cannot rename it in place", what, newName)
+		return
+	}
+	logger.Warningf("renaming %s to %s replaces it; DeltaStream cannot rename it in place. These dependents will be replaced, or block the delete unless forceDestroy is set: %s", what, newName, b)
+}
+
+// checkRename warns about dependents when a Check sees a database, namespace or store name
+// change. where is the catalog filter selecting the relations of the old resource. Connection
+// failures are tolerated, as in other Check methods.
+func checkRename(ctx context.Context, owner *string, what, newName, where string) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return
+	}
+	defer conn.Close() //nolint:errcheck
+	rels, err := listRelationPaths(ctx, conn, where)
+	if err != nil {
+		getLogger(ctx).Debugf("cannot list relations of %s: %v", what, err)
+		return
+	}
+	warnRenameDependents(ctx, conn, what, newName, rels, true)
+}
@@ -0,0 +1,88 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+)
+
+func TestDeleteBlockersString(t *testing.T) {
+	t.Parallel()
+
+	b := deleteBlockers{
+		Queries:   []string{"7d1e"},
+		Relations: [][]string{{"db", "ns", "orders"}, {"db", "ns", "totals"}},
+	}
+	want := `query 7d1e, relation "db"."ns"."orders", relation "db"."ns"."totals"`
+	if got := b.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if b.empty() || !(deleteBlockers{}).empty() {
+		t.Error("empty() mismatch")
+	}
+}
+
+func TestForceDestroyDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		old, new *bool
+		want     bool
+	}{
+		{name: "unset to false is not a change", old: nil, new: ptr.To(false), want: false},
+		{name: "unset to true", old: nil, new: ptr.To(true), want: true},
+		{name: "true to unset", old: ptr.To(true), new: nil, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp, err := Namespace{}.Diff(context.Background(), infer.DiffRequest[NamespaceArgs, NamespaceState]{
+				State:  NamespaceState{NamespaceArgs: NamespaceArgs{Database: "db", Name: "ns", ForceDestroy: tt.old}},
+				Inputs: NamespaceArgs{Database: "db", Name: "ns", ForceDestroy: tt.new},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.HasChanges != tt.want {
+				t.Fatalf("HasChanges = %v, want %v", resp.HasChanges, tt.want)
+			}
+			if tt.want && resp.DetailedDiff["forceDestroy"].Kind != p.Update {
+				t.Errorf("forceDestroy should update in place, got %v", resp.DetailedDiff["forceDestroy"].Kind)
+			}
+		})
+	}
+}
+
+func TestLenientDeleteErr(t *testing.T) {
+	t.Parallel()
+
+	failure := errors.New("relation still exists")
+	if err := lenientDeleteErr(context.Background(), &Config{}, failure); !errors.Is(err, failure) {
+		t.Errorf("default config should return the failure, got %v", err)
+	}
+	if err := lenientDeleteErr(context.Background(), &Config{LenientDelete: ptr.To(false)}, failure); !errors.Is(err, failure) {
+		t.Errorf("lenientDelete=false should return the failure, got %v", err)
+	}
+	if err := lenientDeleteErr(context.Background(), &Config{LenientDelete: ptr.To(true)}, failure); err != nil {
+		t.Errorf("lenientDelete=true should swallow the failure, got %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// DescriptorSource resource uploading a compiled protobuf descriptor set.
+type DescriptorSource struct{}
+
+// Annotate sets descriptions on DescriptorSource for schema generation.
+func (d *DescriptorSource) Annotate(a infer.Annotator) {
+	a.Describe(d, "Descriptor source resource uploading a compiled protobuf descriptor set (.desc) for use with value.format = protobuf")
+}
+
+// DescriptorSourceArgs defines user inputs; exactly one of File or Content must be set.
+type DescriptorSourceArgs struct {
+	Name    string  `pulumi:"name"`
+	File    *string `pulumi:"file,optional"`
+	Content *string `pulumi:"content,optional"`
+	Owner   *string `pulumi:"owner,optional"`
+}
+
+// Annotate sets descriptions on DescriptorSourceArgs fields for schema generation.
+func (a *DescriptorSourceArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Name, "Name of the descriptor source")
+	an.Describe(&a.File, "Path to a compiled descriptor set (protoc --descriptor_set_out). Mutually exclusive with content.")
+	an.Describe(&a.Content, "Base64 encoded descriptor set bytes. Mutually exclusive with file.")
+	an.Describe(&a.Owner, "Optional owning role. When set, statements execute as this role.")
+}
+
+// DescriptorSourceState extends inputs with computed fields.
+type DescriptorSourceState struct {
+	DescriptorSourceArgs
+	ContentHash string `pulumi:"contentHash"`
+	OwnerOut    string `pulumi:"owner"`
+	CreatedAt   string `pulumi:"createdAt"`
+}
+
+// Annotate sets descriptions on DescriptorSourceState fields for schema generation.
+func (s *DescriptorSourceState) Annotate(a infer.Annotator) {
+	a.Describe(&s.ContentHash, "SHA-256 of the uploaded descriptor set; a change replaces the descriptor source")
+}
+
+// Check validates that exactly one descriptor set source is provided.
+func (DescriptorSource) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[DescriptorSourceArgs], error) {
+	args, failures, err := infer.DefaultCheck[DescriptorSourceArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[DescriptorSourceArgs]{}, err
+	}
+	failures = append(failures, descriptorSources.check(args.Name, args.File, args.Content)...)
+	return infer.CheckResponse[DescriptorSourceArgs]{Inputs: args, Failures: failures}, nil
+}
+
+// Diff replaces the descriptor source when its name, owner or uploaded bytes change.
+func (DescriptorSource) Diff(ctx context.Context, req infer.DiffRequest[DescriptorSourceArgs, DescriptorSourceState]) (infer.DiffResponse, error) {
+	s, n := req.State, req.Inputs
+	diff := descriptorSources.diff(s.Name, n.Name, s.Owner, n.Owner, n.File, n.Content, s.ContentHash)
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff, DeleteBeforeReplace: true}, nil
+}
+
+// Create uploads the descriptor set as an attachment and creates the descriptor source.
+func (DescriptorSource) Create(ctx context.Context, req infer.CreateRequest[DescriptorSourceArgs]) (infer.CreateResponse[DescriptorSourceState], error) {
+	in := req.Inputs
+	b, err := readAttachment(in.File, in.Content)
+	if err != nil {
+		return infer.CreateResponse[DescriptorSourceState]{}, err
+	}
+	if req.DryRun {
+		st := DescriptorSourceState{DescriptorSourceArgs: in, ContentHash: attachmentHash(b), CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+		return infer.CreateResponse[DescriptorSourceState]{ID: in.Name, Output: st}, nil
+	}
+	row, err := descriptorSources.create(ctx, in.Name, in.Owner, b)
+	if err != nil {
+		return infer.CreateResponse[DescriptorSourceState]{}, err
+	}
+	st := DescriptorSourceState{DescriptorSourceArgs: in, ContentHash: attachmentHash(b), OwnerOut: row.Owner, CreatedAt: row.CreatedAt.Format(time.RFC3339)}
+	getLogger(ctx).Info(fmt.Sprintf("Descriptor source created: %s", in.Name))
+	return infer.CreateResponse[DescriptorSourceState]{ID: in.Name, Output: st}, nil
+}
+
+// Read refreshes descriptor source metadata from the system catalog.
+func (DescriptorSource) Read(ctx context.Context, req infer.ReadRequest[DescriptorSourceArgs, DescriptorSourceState]) (infer.ReadResponse[DescriptorSourceArgs, DescriptorSourceState], error) {
+	row, found, err := descriptorSources.read(ctx, req.ID, req.State.Owner)
+	if err != nil || !found {
+		return infer.ReadResponse[DescriptorSourceArgs, DescriptorSourceState]{}, err
+	}
+	st := req.State
+	st.Name = req.ID
+	st.OwnerOut = row.Owner
+	st.CreatedAt = row.CreatedAt.Format(time.RFC3339)
+	return infer.ReadResponse[DescriptorSourceArgs, DescriptorSourceState]{ID: req.ID, Inputs: st.DescriptorSourceArgs, State: st}, nil
+}
+
+// Update is not supported; every change replaces the descriptor source.
+func (DescriptorSource) Update(ctx context.Context, req infer.UpdateRequest[DescriptorSourceArgs, DescriptorSourceState]) (infer.UpdateResponse[DescriptorSourceState], error) {
+	if req.DryRun {
+		return infer.UpdateResponse[DescriptorSourceState]{}, nil
+	}
+	return infer.UpdateResponse[DescriptorSourceState]{}, fmt.Errorf("descriptor source updates not supported; requires replacement")
+}
+
+// Delete drops the descriptor source.
+func (DescriptorSource) Delete(ctx context.Context, req infer.DeleteRequest[DescriptorSourceState]) (infer.DeleteResponse, error) {
+	return infer.DeleteResponse{}, descriptorSources.drop(ctx, req.ID, req.State.Owner)
+}
+
+// WireDependencies declares resource graph dependencies for Pulumi.
+func (DescriptorSource) WireDependencies(f infer.FieldSelector, args *DescriptorSourceArgs, state *DescriptorSourceState) {
+	f.OutputField(&state.ContentHash).DependsOn(f.InputField(&args.File))
+	f.OutputField(&state.ContentHash).DependsOn(f.InputField(&args.Content))
+	f.OutputField(&state.CreatedAt).DependsOn(f.InputField(&args.Name))
+}
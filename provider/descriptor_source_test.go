@@ -0,0 +1,146 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestDescriptorSourceCheck(t *testing.T) {
+	t.Parallel()
+	server, _ := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("DescriptorSource"), "pb")
+	desc := filepath.Join(t.TempDir(), "pageviews.desc")
+	require.NoError(t, os.WriteFile(desc, []byte("descriptor-v1"), 0o600))
+
+	for name, tc := range map[string]struct {
+		inputs map[string]string
+		want   []string
+	}{
+		"file":         {inputs: map[string]string{"name": "pb", "file": desc}},
+		"content":      {inputs: map[string]string{"name": "pb", "content": base64.StdEncoding.EncodeToString([]byte("descriptor-v1"))}},
+		"no name":      {inputs: map[string]string{"name": "", "file": desc}, want: []string{"name"}},
+		"neither":      {inputs: map[string]string{"name": "pb"}, want: []string{"file"}},
+		"both":         {inputs: map[string]string{"name": "pb", "file": desc, "content": "ZGVzYw=="}, want: []string{"content"}},
+		"missing file": {inputs: map[string]string{"name": "pb", "file": desc + ".gone"}, want: []string{"file"}},
+		"invalid b64":  {inputs: map[string]string{"name": "pb", "content": "not base64!"}, want: []string{"content"}},
+	} {
+		resp, err := server.Check(p.CheckRequest{Urn: urn, Inputs: strMap(tc.inputs)})
+		require.NoError(t, err, name)
+		var got []string
+		for _, f := range resp.Failures {
+			got = append(got, f.Property)
+		}
+		assert.Equal(t, tc.want, got, name)
+	}
+}
+
+func TestDescriptorSourceDiff(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	desc := filepath.Join(dir, "pageviews.desc")
+	require.NoError(t, os.WriteFile(desc, []byte("descriptor-v1"), 0o600))
+	moved := filepath.Join(dir, "moved.desc")
+	require.NoError(t, os.WriteFile(moved, []byte("descriptor-v1"), 0o600))
+	recompiled := filepath.Join(dir, "recompiled.desc")
+	require.NoError(t, os.WriteFile(recompiled, []byte("descriptor-v2"), 0o600))
+
+	args := DescriptorSourceArgs{Name: "pb", File: ptr.To(desc), Owner: ptr.To("sysadmin")}
+	state := DescriptorSourceState{DescriptorSourceArgs: args, ContentHash: attachmentHash([]byte("descriptor-v1"))}
+	for name, tc := range map[string]struct {
+		edit func(*DescriptorSourceArgs)
+		want []string
+	}{
+		"unchanged":     {edit: func(*DescriptorSourceArgs) {}},
+		"moved file":    {edit: func(a *DescriptorSourceArgs) { a.File = ptr.To(moved) }},
+		"owner dropped": {edit: func(a *DescriptorSourceArgs) { a.Owner = nil }},
+		"same content": {edit: func(a *DescriptorSourceArgs) {
+			a.File, a.Content = nil, ptr.To(base64.StdEncoding.EncodeToString([]byte("descriptor-v1")))
+		}},
+		"recompiled": {edit: func(a *DescriptorSourceArgs) { a.File = ptr.To(recompiled) }, want: []string{"file"}},
+		"unreadable": {edit: func(a *DescriptorSourceArgs) { a.File = ptr.To(desc + ".gone") }, want: []string{"file"}},
+		"new content": {edit: func(a *DescriptorSourceArgs) {
+			a.File, a.Content = nil, ptr.To(base64.StdEncoding.EncodeToString([]byte("descriptor-v2")))
+		}, want: []string{"content"}},
+		"owner": {edit: func(a *DescriptorSourceArgs) { a.Owner = ptr.To("analyst") }, want: []string{"owner"}},
+		"name":  {edit: func(a *DescriptorSourceArgs) { a.Name = "pb2" }, want: []string{"name"}},
+	} {
+		inputs := args
+		tc.edit(&inputs)
+		resp, err := DescriptorSource{}.Diff(context.Background(), infer.DiffRequest[DescriptorSourceArgs, DescriptorSourceState]{State: state, Inputs: inputs})
+		require.NoError(t, err, name)
+		assert.Equal(t, len(tc.want) > 0, resp.HasChanges, name)
+		assert.Len(t, resp.DetailedDiff, len(tc.want), name)
+		for _, k := range tc.want {
+			assert.Equal(t, p.UpdateReplace, resp.DetailedDiff[k].Kind, "%s: %s", name, k)
+		}
+	}
+}
+
+func TestDescriptorSourceUploadsAttachment(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("DescriptorSource"), "pb")
+	desc := filepath.Join(t.TempDir(), "pageviews.desc")
+	require.NoError(t, os.WriteFile(desc, []byte("descriptor-v1"), 0o600))
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: strMap(map[string]string{"name": "pb", "file": desc})})
+	require.NoError(t, err)
+	assert.Equal(t, "pb", created.ID)
+	require.NotNil(t, f.Named["DESCRIPTOR_SOURCE/pb"])
+	assert.Equal(t, "descriptor-v1", f.Named["DESCRIPTOR_SOURCE/pb"].Props["file"], "the file bytes are uploaded under the statement's label")
+	assert.Equal(t, attachmentHash([]byte("descriptor-v1")), created.Properties.Get("contentHash").AsString())
+	assert.Equal(t, "sysadmin", created.Properties.Get("owner").AsString())
+
+	// Changing the file on disk after creation does not change what was uploaded.
+	require.NoError(t, os.WriteFile(desc, []byte("descriptor-v2"), 0o600))
+	read, err := server.Read(p.ReadRequest{ID: created.ID, Urn: urn, Properties: created.Properties})
+	require.NoError(t, err)
+	assert.Equal(t, "pb", read.ID)
+	assert.Equal(t, created.Properties.Get("contentHash"), read.Properties.Get("contentHash"))
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn, Properties: read.Properties}))
+	assert.Nil(t, f.Named["DESCRIPTOR_SOURCE/pb"])
+	gone, err := server.Read(p.ReadRequest{ID: created.ID, Urn: urn, Properties: read.Properties})
+	require.NoError(t, err)
+	assert.Empty(t, gone.ID, "reading a dropped descriptor source should clear the ID")
+}
+
+func TestDescriptorSourceDeleteAlreadyGone(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("DescriptorSource"), "pb")
+	desc := filepath.Join(t.TempDir(), "pageviews.desc")
+	require.NoError(t, os.WriteFile(desc, []byte("descriptor-v1"), 0o600))
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: strMap(map[string]string{"name": "pb", "file": desc})})
+	require.NoError(t, err)
+	f.seed(t, `DROP DESCRIPTOR_SOURCE "pb"`)
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn, Properties: created.Properties}),
+		"deleting a descriptor source that no longer exists should succeed")
+}
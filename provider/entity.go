@@ -0,0 +1,395 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+
+	ds "github.com/deltastreaminc/go-deltastream"
+)
+
+// Entity resource representing a store-native entity (e.g. a Kafka topic) inside a Store.
+type Entity struct{}
+
+// Annotate sets descriptions on Entity for schema generation.
+func (e *Entity) Annotate(a infer.Annotator) {
+	a.Describe(e, "Entity resource creating and dropping a store-native entity, such as a Kafka topic, within a Store")
+}
+
+// EntityArgs defines user inputs for an entity.
+type EntityArgs struct {
+	Store      string            `pulumi:"store"`
+	Name       string            `pulumi:"name"`
+	Partitions *int              `pulumi:"partitions,optional"`
+	Replicas   *int              `pulumi:"replicas,optional"`
+	Configs    map[string]string `pulumi:"configs,optional"`
+	// Protobuf descriptors used to decode keys and values of the entity
+	KeyDescriptor   *EntityDescriptor `pulumi:"keyDescriptor,optional"`
+	ValueDescriptor *EntityDescriptor `pulumi:"valueDescriptor,optional"`
+}
+
+// EntityDescriptor references a protobuf message within a DescriptorSource.
+type EntityDescriptor struct {
+	Source  string `pulumi:"source"`
+	Message string `pulumi:"message"`
+}
+
+// Annotate sets descriptions on EntityDescriptor fields for schema generation.
+func (d *EntityDescriptor) Annotate(a infer.Annotator) {
+	a.Describe(&d.Source, "Name of the descriptor source")
+	a.Describe(&d.Message, "Fully qualified protobuf message name, e.g. com.example.PageView")
+}
+
+// Annotate sets descriptions on EntityArgs fields for schema generation.
+func (a *EntityArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Store, "Name of the store containing the entity")
+	an.Describe(&a.Name, "Entity name (topic name for Kafka stores)")
+	an.Describe(&a.Partitions, "Number of partitions (Kafka). Changing it replaces the entity.")
+	an.Describe(&a.Replicas, "Replication factor (Kafka). Changing it replaces the entity.")
+	an.Describe(&a.Configs, "Topic configuration, e.g. {\"retention.ms\": \"86400000\"}. Keys are sent as 'kafka.topic.<key>' and are updated in place.")
+	an.Describe(&a.KeyDescriptor, "Protobuf descriptor for record keys. Updated in place.")
+	an.Describe(&a.ValueDescriptor, "Protobuf descriptor for record values, allowing relations to use value.format = protobuf. Updated in place.")
+}
+
+// EntityState extends inputs with computed fields.
+type EntityState struct {
+	EntityArgs
+	IsLeaf bool `pulumi:"isLeaf"`
+}
+
+// Annotate sets descriptions on EntityState fields for schema generation.
+func (s *EntityState) Annotate(a infer.Annotator) {
+	a.Describe(&s.IsLeaf, "Whether the entity is a leaf (holds data) rather than a container of other entities")
+}
+
+// Check validates entity inputs.
+func (Entity) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[EntityArgs], error) {
+	args, failures, err := infer.DefaultCheck[EntityArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[EntityArgs]{}, err
+	}
+	if args.Store == "" {
+		failures = append(failures, p.CheckFailure{Property: "store", Reason: "store required"})
+	}
+	if args.Name == "" {
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name required"})
+	}
+	if args.Partitions != nil && *args.Partitions < 1 {
+		failures = append(failures, p.CheckFailure{Property: "partitions", Reason: "partitions must be at least 1"})
+	}
+	if args.Replicas != nil && *args.Replicas < 1 {
+		failures = append(failures, p.CheckFailure{Property: "replicas", Reason: "replicas must be at least 1"})
+	}
+	return infer.CheckResponse[EntityArgs]{Inputs: args, Failures: failures}, nil
+}
+
+// Diff replaces the entity when its location, partitions or replicas change; topic configs
+// and descriptors update in place.
+func (Entity) Diff(ctx context.Context, req infer.DiffRequest[EntityArgs, EntityState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	if req.State.Store != req.Inputs.Store {
+		diff["store"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.State.Name != req.Inputs.Name {
+		diff["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if ptr.Deref(req.State.Partitions, 0) != ptr.Deref(req.Inputs.Partitions, 0) {
+		diff["partitions"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if ptr.Deref(req.State.Replicas, 0) != ptr.Deref(req.Inputs.Replicas, 0) {
+		diff["replicas"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	for k, v := range req.Inputs.Configs {
+		if ov, ok := req.State.Configs[k]; !ok {
+			diff[fmt.Sprintf("configs[%q]", k)] = p.PropertyDiff{Kind: p.Add}
+		} else if ov != v {
+			diff[fmt.Sprintf("configs[%q]", k)] = p.PropertyDiff{Kind: p.Update}
+		}
+	}
+	for k := range req.State.Configs {
+		if _, ok := req.Inputs.Configs[k]; !ok {
+			diff[fmt.Sprintf("configs[%q]", k)] = p.PropertyDiff{Kind: p.Delete}
+		}
+	}
+	if ptr.Deref(req.State.KeyDescriptor, EntityDescriptor{}) != ptr.Deref(req.Inputs.KeyDescriptor, EntityDescriptor{}) {
+		diff["keyDescriptor"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if ptr.Deref(req.State.ValueDescriptor, EntityDescriptor{}) != ptr.Deref(req.Inputs.ValueDescriptor, EntityDescriptor{}) {
+		diff["valueDescriptor"] = p.PropertyDiff{Kind: p.Update}
+	}
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff, DeleteBeforeReplace: true}, nil
+}
+
+// Create issues CREATE ENTITY ... IN STORE and verifies the entity is listed.
+func (Entity) Create(ctx context.Context, req infer.CreateRequest[EntityArgs]) (infer.CreateResponse[EntityState], error) {
+	in := req.Inputs
+	id := entityID(in.Store, in.Name)
+	if req.DryRun {
+		return infer.CreateResponse[EntityState]{ID: id, Output: EntityState{EntityArgs: in, IsLeaf: true}}, nil
+	}
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.CreateResponse[EntityState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(cfg.Role, "")
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.CreateResponse[EntityState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if _, err := conn.ExecContext(ctx, renderEntityCreate(&in)); err != nil {
+		return infer.CreateResponse[EntityState]{}, fmt.Errorf("failed to create entity: %w", err)
+	}
+	row, err := lookupEntity(ctx, conn, in.Store, in.Name)
+	if err != nil {
+		return infer.CreateResponse[EntityState]{}, err
+	}
+	if row == nil {
+		return infer.CreateResponse[EntityState]{}, fmt.Errorf("entity %s not listed in store %s after creation", in.Name, in.Store)
+	}
+	getLogger(ctx).Info(fmt.Sprintf("Entity created: %s in store %s", in.Name, in.Store))
+	return infer.CreateResponse[EntityState]{ID: id, Output: EntityState{EntityArgs: in, IsLeaf: entityIsLeaf(row)}}, nil
+}
+
+// Read checks that the entity is still listed in its store.
+func (Entity) Read(ctx context.Context, req infer.ReadRequest[EntityArgs, EntityState]) (infer.ReadResponse[EntityArgs, EntityState], error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.ReadResponse[EntityArgs, EntityState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(cfg.Role, "")
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.ReadResponse[EntityArgs, EntityState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	st := req.State
+	if st.Store == "" || st.Name == "" {
+		store, name, ok := strings.Cut(req.ID, "/")
+		if !ok {
+			return infer.ReadResponse[EntityArgs, EntityState]{}, fmt.Errorf("invalid entity id %q, expected <store>/<name>", req.ID)
+		}
+		st.Store, st.Name = store, name
+	}
+	row, err := lookupEntity(ctx, conn, st.Store, st.Name)
+	if err != nil {
+		var sqlErr ds.ErrSQLError
+		if errors.As(err, &sqlErr) && sqlErr.SQLCode == ds.SqlStateInvalidStore {
+			return infer.ReadResponse[EntityArgs, EntityState]{}, nil
+		}
+		return infer.ReadResponse[EntityArgs, EntityState]{}, err
+	}
+	if row == nil {
+		return infer.ReadResponse[EntityArgs, EntityState]{}, nil
+	}
+	st.IsLeaf = entityIsLeaf(row)
+	return infer.ReadResponse[EntityArgs, EntityState]{ID: req.ID, Inputs: st.EntityArgs, State: st}, nil
+}
+
+// Update applies topic configuration and descriptor changes with UPDATE ENTITY.
+func (Entity) Update(ctx context.Context, req infer.UpdateRequest[EntityArgs, EntityState]) (infer.UpdateResponse[EntityState], error) {
+	st := req.State
+	stmt := renderEntityUpdate(&st.EntityArgs, &req.Inputs)
+	if stmt == "" || req.DryRun {
+		st.EntityArgs = req.Inputs
+		return infer.UpdateResponse[EntityState]{Output: st}, nil
+	}
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.UpdateResponse[EntityState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(cfg.Role, "")
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.UpdateResponse[EntityState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if _, err := conn.ExecContext(ctx, stmt); err != nil {
+		return infer.UpdateResponse[EntityState]{}, fmt.Errorf("failed updating entity: %w", err)
+	}
+	st.EntityArgs = req.Inputs
+	return infer.UpdateResponse[EntityState]{Output: st}, nil
+}
+
+// Delete drops the entity from its store. An entity that is already gone, or whose store is,
+// counts as dropped.
+func (Entity) Delete(ctx context.Context, req infer.DeleteRequest[EntityState]) (infer.DeleteResponse, error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(cfg.Role, "")
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	stmt := fmt.Sprintf("DROP ENTITY %s IN STORE %s;", quoteIdent(req.State.Name), quoteIdent(req.State.Store))
+	if _, err := conn.ExecContext(ctx, stmt); err != nil {
+		var sqlErr ds.ErrSQLError
+		if errors.As(err, &sqlErr) && sqlErr.SQLCode == ds.SqlStateInvalidStore {
+			return infer.DeleteResponse{}, nil
+		}
+		// A missing entity has no dedicated SQL state, so confirm it against LIST ENTITIES.
+		if row, lerr := lookupEntity(ctx, conn, req.State.Store, req.State.Name); lerr == nil && row == nil {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, err
+	}
+	return infer.DeleteResponse{}, nil
+}
+
+// WireDependencies declares resource graph dependencies for Pulumi.
+func (Entity) WireDependencies(f infer.FieldSelector, args *EntityArgs, state *EntityState) {
+	f.OutputField(&state.IsLeaf).DependsOn(f.InputField(&args.Store))
+	f.OutputField(&state.IsLeaf).DependsOn(f.InputField(&args.Name))
+	f.OutputField(&state.KeyDescriptor).DependsOn(f.InputField(&args.KeyDescriptor))
+	f.OutputField(&state.ValueDescriptor).DependsOn(f.InputField(&args.ValueDescriptor))
+}
+
+// entityID builds the resource ID for an entity.
+func entityID(store, name string) string {
+	return store + "/" + name
+}
+
+// entityProperties renders the WITH properties for an entity definition.
+func entityProperties(in *EntityArgs) []string {
+	props := []string{}
+	if in.Partitions != nil {
+		props = append(props, fmt.Sprintf("'kafka.partitions' = %d", *in.Partitions))
+	}
+	if in.Replicas != nil {
+		props = append(props, fmt.Sprintf("'kafka.replicas' = %d", *in.Replicas))
+	}
+	keys := make([]string, 0, len(in.Configs))
+	for k := range in.Configs {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		props = append(props, fmt.Sprintf("%s = %s", quoteString("kafka.topic."+k), quoteString(in.Configs[k])))
+	}
+	if in.KeyDescriptor != nil {
+		props = append(props, fmt.Sprintf("'kafka.topic.descriptor.key' = %s", renderEntityDescriptor(in.KeyDescriptor)))
+	}
+	if in.ValueDescriptor != nil {
+		props = append(props, fmt.Sprintf("'kafka.topic.descriptor.value' = %s", renderEntityDescriptor(in.ValueDescriptor)))
+	}
+	return props
+}
+
+// renderEntityDescriptor renders a descriptor reference as "source"."message", or NULL when d is nil.
+func renderEntityDescriptor(d *EntityDescriptor) string {
+	if d == nil {
+		return "NULL"
+	}
+	return quoteIdent(d.Source) + "." + quoteIdent(d.Message)
+}
+
+// renderEntityCreate renders the CREATE ENTITY statement for the given inputs.
+func renderEntityCreate(in *EntityArgs) string {
+	stmt := fmt.Sprintf("CREATE ENTITY %s IN STORE %s", quoteIdent(in.Name), quoteIdent(in.Store))
+	if props := entityProperties(in); len(props) > 0 {
+		stmt += fmt.Sprintf(" WITH ( %s )", strings.Join(props, ", "))
+	}
+	return stmt + ";"
+}
+
+// renderEntityUpdate renders the UPDATE ENTITY statement applying config and descriptor changes
+// from old to curr; removed keys are reset with NULL. It returns "" when nothing changed.
+func renderEntityUpdate(old, curr *EntityArgs) string {
+	keys := []string{}
+	for k, v := range curr.Configs {
+		if ov, ok := old.Configs[k]; !ok || ov != v {
+			keys = append(keys, k)
+		}
+	}
+	for k := range old.Configs {
+		if _, ok := curr.Configs[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	parts := make([]string, 0, len(keys)+2)
+	for _, k := range keys {
+		v, ok := curr.Configs[k]
+		if ok {
+			parts = append(parts, fmt.Sprintf("%s = %s", quoteString("kafka.topic."+k), quoteString(v)))
+		} else {
+			parts = append(parts, fmt.Sprintf("%s = NULL", quoteString("kafka.topic."+k)))
+		}
+	}
+	if ptr.Deref(old.KeyDescriptor, EntityDescriptor{}) != ptr.Deref(curr.KeyDescriptor, EntityDescriptor{}) {
+		parts = append(parts, fmt.Sprintf("'kafka.topic.descriptor.key' = %s", renderEntityDescriptor(curr.KeyDescriptor)))
+	}
+	if ptr.Deref(old.ValueDescriptor, EntityDescriptor{}) != ptr.Deref(curr.ValueDescriptor, EntityDescriptor{}) {
+		parts = append(parts, fmt.Sprintf("'kafka.topic.descriptor.value' = %s", renderEntityDescriptor(curr.ValueDescriptor)))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("UPDATE ENTITY %s IN STORE %s WITH ( %s );", quoteIdent(curr.Name), quoteIdent(curr.Store), strings.Join(parts, ", "))
+}
+
+// listEntities returns the rows of LIST ENTITIES for a store.
+func listEntities(ctx context.Context, conn *sql.Conn, store string) ([]map[string]string, error) {
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("LIST ENTITIES IN STORE %s;", quoteIdent(store)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+	return scanRowMaps(rows)
+}
+
+// lookupEntity returns the LIST ENTITIES row for name, or nil when the store has no such entity.
+func lookupEntity(ctx context.Context, conn *sql.Conn, store, name string) (map[string]string, error) {
+	list, err := listEntities(ctx, conn, store)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range list {
+		if row["name"] == name {
+			return row, nil
+		}
+	}
+	return nil, nil
+}
+
+// entityIsLeaf interprets the is_leaf column of a LIST ENTITIES row, defaulting to true.
+func entityIsLeaf(row map[string]string) bool {
+	v, ok := row["is_leaf"]
+	return !ok || v == "" || strings.EqualFold(v, "true") || v == "1"
+}
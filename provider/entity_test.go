@@ -0,0 +1,111 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestRenderEntityStatements(t *testing.T) {
+	t.Parallel()
+
+	old := EntityArgs{
+		Store:      "kafka",
+		Name:       "pageviews",
+		Partitions: ptr.To(3),
+		Replicas:   ptr.To(2),
+		Configs:    map[string]string{"retention.ms": "86400000", "cleanup.policy": "delete"},
+	}
+	want := `CREATE ENTITY "pageviews" IN STORE "kafka" WITH ( 'kafka.partitions' = 3, 'kafka.replicas' = 2, 'kafka.topic.cleanup.policy' = 'delete', 'kafka.topic.retention.ms' = '86400000' );`
+	if got := renderEntityCreate(&old); got != want {
+		t.Errorf("create:\ngot  %s\nwant %s", got, want)
+	}
+	if got := renderEntityCreate(&EntityArgs{Store: "kafka", Name: "bare"}); got != `CREATE ENTITY "bare" IN STORE "kafka";` {
+		t.Errorf("create without properties: got %s", got)
+	}
+
+	curr := old
+	curr.Configs = map[string]string{"retention.ms": "3600000", "segment.ms": "600000"}
+	want = `UPDATE ENTITY "pageviews" IN STORE "kafka" WITH ( 'kafka.topic.cleanup.policy' = NULL, 'kafka.topic.retention.ms' = '3600000', 'kafka.topic.segment.ms' = '600000' );`
+	if got := renderEntityUpdate(&old, &curr); got != want {
+		t.Errorf("update:\ngot  %s\nwant %s", got, want)
+	}
+	if got := renderEntityUpdate(&old, &old); got != "" {
+		t.Errorf("expected no update statement for unchanged configs, got %s", got)
+	}
+
+	withDesc := old
+	withDesc.ValueDescriptor = &EntityDescriptor{Source: "pb", Message: "com.example.PageView"}
+	want = `UPDATE ENTITY "pageviews" IN STORE "kafka" WITH ( 'kafka.topic.descriptor.value' = "pb"."com.example.PageView" );`
+	if got := renderEntityUpdate(&old, &withDesc); got != want {
+		t.Errorf("descriptor update:\ngot  %s\nwant %s", got, want)
+	}
+	want = `UPDATE ENTITY "pageviews" IN STORE "kafka" WITH ( 'kafka.topic.descriptor.value' = NULL );`
+	if got := renderEntityUpdate(&withDesc, &old); got != want {
+		t.Errorf("descriptor removal:\ngot  %s\nwant %s", got, want)
+	}
+}
+
+func TestEntityDiff(t *testing.T) {
+	t.Parallel()
+
+	state := EntityState{EntityArgs: EntityArgs{Store: "kafka", Name: "pageviews", Partitions: ptr.To(3), Configs: map[string]string{"retention.ms": "1"}}}
+	inputs := EntityArgs{Store: "kafka", Name: "pageviews", Partitions: ptr.To(6), Configs: map[string]string{"retention.ms": "2"}}
+
+	resp, err := Entity{}.Diff(context.Background(), infer.DiffRequest[EntityArgs, EntityState]{State: state, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]p.DiffKind{
+		"partitions":              p.UpdateReplace,
+		`configs["retention.ms"]`: p.Update,
+	}
+	if len(resp.DetailedDiff) != len(want) {
+		t.Fatalf("got diff %v, want %v", resp.DetailedDiff, want)
+	}
+	for k, kind := range want {
+		if resp.DetailedDiff[k].Kind != kind {
+			t.Errorf("diff[%s] = %v, want %v", k, resp.DetailedDiff[k].Kind, kind)
+		}
+	}
+}
+
+func TestEntityDeleteAlreadyGone(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t, `CREATE STORE "events" WITH ('type' = KAFKA, 'uris' = 'kafka:9092')`)
+	urn := resource.NewURN("test", "provider", "", resourceType("Entity"), "pageviews")
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: property.NewMap(map[string]property.Value{
+		"store": property.New("events"),
+		"name":  property.New("pageviews"),
+	})})
+	require.NoError(t, err)
+	require.NotNil(t, f.Entities["events/pageviews"])
+	f.seed(t, `DROP ENTITY "pageviews" IN STORE "events"`)
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn, Properties: created.Properties}),
+		"deleting an entity that no longer exists should succeed")
+	assert.Empty(t, f.Entities)
+}
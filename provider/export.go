@@ -0,0 +1,516 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/utils/ptr"
+)
+
+// ExportOptions narrows what Export walks.
+type ExportOptions struct {
+	// Databases restricts namespaces and relations to these databases; empty exports all.
+	Databases []string
+}
+
+// ExportedResource is one resource discovered by Export.
+type ExportedResource struct {
+	// Type is the Pulumi type token, e.g. deltastream:index:Store.
+	Type string
+	// Name is the logical resource name, also used as the program variable name.
+	Name string
+	// ID is the import ID understood by the resource's Read.
+	ID string
+	// DependsOn lists logical names of exported resources this one must follow.
+	DependsOn []string
+
+	props []exportProp
+}
+
+// Inventory is the ordered result of Export: every resource appears after its dependencies.
+type Inventory struct {
+	Resources []ExportedResource
+}
+
+// exportProp is one input property. Value is a string, bool, []string or, for nested
+// blocks, []exportProp with GoType naming the SDK args struct.
+type exportProp struct {
+	Key    string
+	Value  any
+	GoType string
+}
+
+// Export walks databases, namespaces, stores, relations and running queries of the configured
+// organization and returns them in dependency order. Store credentials are never exported,
+// and the API key is scrubbed from returned errors.
+func Export(ctx context.Context, cfg Config, opts ExportOptions) (*Inventory, error) {
+	ctx = withSecrets(ctx, []string{ptr.Deref(cfg.APIKey, "")})
+	ctx = withAuditScope(ctx, "", "export")
+	inv, err := exportInventory(ctx, cfg, opts)
+	return inv, redactErr(ctx, err)
+}
+
+func exportInventory(ctx context.Context, cfg Config, opts ExportOptions) (*Inventory, error) {
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close() //nolint:errcheck
+	ctx, conn, err := withOrgRole(ctx, db, ptr.Deref(cfg.Organization, ""), ptr.Deref(cfg.Role, ""))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close() //nolint:errcheck
+
+	b := newInventoryBuilder()
+	stores, err := exportRows(ctx, conn, `SELECT name, type FROM deltastream.sys."stores" ORDER BY name;`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stores: %w", err)
+	}
+	for _, s := range stores {
+		props, err := describeStore(ctx, conn, s[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe store %s: %w", s[0], err)
+		}
+		b.addStore(storeArgsFromDescribe(s[0], s[1], props))
+	}
+
+	dbs, err := exportRows(ctx, conn, `SELECT name FROM deltastream.sys."databases" ORDER BY name;`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list databases: %w", err)
+	}
+	for _, d := range dbs {
+		if len(opts.Databases) > 0 && !slices.Contains(opts.Databases, d[0]) {
+			continue
+		}
+		b.addDatabase(d[0])
+		nss, err := exportRows(ctx, conn, fmt.Sprintf(`SELECT name FROM deltastream.sys."schemas" WHERE database_name = %s ORDER BY name;`, quoteString(d[0])))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces of %s: %w", d[0], err)
+		}
+		for _, ns := range nss {
+			b.addNamespace(d[0], ns[0])
+			rels, err := exportRows(ctx, conn, fmt.Sprintf(`SELECT name FROM deltastream.sys."relations" WHERE database_name = %s AND schema_name = %s ORDER BY name;`, quoteString(d[0]), quoteString(ns[0])))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list relations of %s.%s: %w", d[0], ns[0], err)
+			}
+			for _, r := range rels {
+				path := []string{d[0], ns[0], r[0]}
+				ddl, store, err := lookupRelationDDL(ctx, conn, path)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read definition of %s: %w", getFQN(path), err)
+				}
+				b.addObject(path, store, ddl)
+			}
+		}
+	}
+
+	logger := getLogger(ctx)
+	queries, err := exportRows(ctx, conn, `SELECT id, current_state FROM deltastream.sys."queries" ORDER BY created_at;`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queries: %w", err)
+	}
+	for _, q := range queries {
+		if queryTerminal(q[1]) {
+			continue
+		}
+		sqlText, err := lookupQuerySQL(ctx, conn, q[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read query %s: %w", q[0], err)
+		}
+		kind, plan, err := describeQuery(ctx, conn, sqlText)
+		if err != nil {
+			logger.Warningf("skipping query %s: describe failed: %v", q[0], err)
+			continue
+		}
+		if kind == "APPLICATION" {
+			_, appPlan, err := describeApplication(ctx, conn, sqlText)
+			if err != nil {
+				logger.Warningf("skipping application %s: describe failed: %v", q[0], err)
+				continue
+			}
+			b.addApplication(q[0], applicationArgsFromPlan(sqlText, appPlan))
+			continue
+		}
+		b.addQuery(q[0], queryArgsFromPlan(sqlText, plan))
+	}
+	return b.inv, nil
+}
+
+// exportRows runs a catalog query and returns every row as strings.
+func exportRows(ctx context.Context, conn *sql.Conn, q string) ([][]string, error) {
+	rows, err := conn.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var out [][]string
+	for rows.Next() {
+		vals := make([]sql.NullString, len(cols))
+		dest := make([]any, len(cols))
+		for i := range vals {
+			dest[i] = &vals[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row := make([]string, len(cols))
+		for i, v := range vals {
+			row[i] = v.String
+		}
+		out = append(out, row)
+	}
+	return out, rows.Err()
+}
+
+// inventoryBuilder assigns unique logical names and tracks which exported resource
+// provides each database, namespace, store and relation so dependents can refer to it.
+type inventoryBuilder struct {
+	inv   *Inventory
+	used  map[string]bool
+	index map[string]string
+}
+
+func newInventoryBuilder() *inventoryBuilder {
+	return &inventoryBuilder{inv: &Inventory{}, used: map[string]bool{}, index: map[string]string{}}
+}
+
+// exportName turns parts into a program identifier, adding a numeric suffix on collision.
+func (b *inventoryBuilder) exportName(prefix string, parts ...string) string {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	for _, part := range parts {
+		sb.WriteByte('_')
+		for _, r := range part {
+			if r < 128 && (r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')) {
+				sb.WriteRune(r)
+			} else {
+				sb.WriteByte('_')
+			}
+		}
+	}
+	name := sb.String()
+	for i := 2; b.used[name]; i++ {
+		name = fmt.Sprintf("%s_%d", sb.String(), i)
+	}
+	b.used[name] = true
+	return name
+}
+
+func (b *inventoryBuilder) add(res ExportedResource, key string) {
+	if key != "" {
+		b.index[key] = res.Name
+	}
+	b.inv.Resources = append(b.inv.Resources, res)
+}
+
+// deps resolves index keys to logical names, skipping resources that were not exported.
+func (b *inventoryBuilder) deps(keys ...string) []string {
+	var out []string
+	for _, k := range keys {
+		if name, ok := b.index[k]; ok && !slices.Contains(out, name) {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func (b *inventoryBuilder) addDatabase(name string) {
+	b.add(ExportedResource{
+		Type:  "deltastream:index:Database",
+		Name:  b.exportName("db", name),
+		ID:    name,
+		props: []exportProp{{Key: "name", Value: name}},
+	}, "db:"+name)
+}
+
+func (b *inventoryBuilder) addNamespace(database, name string) {
+	b.add(ExportedResource{
+		Type:      "deltastream:index:Namespace",
+		Name:      b.exportName("ns", database, name),
+		ID:        database + "/" + name,
+		DependsOn: b.deps("db:" + database),
+		props:     []exportProp{{Key: "database", Value: database}, {Key: "name", Value: name}},
+	}, "ns:"+database+"/"+name)
+}
+
+func (b *inventoryBuilder) addStore(in StoreArgs) {
+	props := []exportProp{{Key: "name", Value: in.Name}}
+	switch {
+	case in.Kafka != nil:
+		k := in.Kafka
+		props = append(props, exportProp{Key: "kafka", GoType: "KafkaInputsArgs", Value: compactProps(
+			exportProp{Key: "uris", Value: k.Uris},
+			exportProp{Key: "saslHashFunction", Value: k.SaslHashFunction},
+			exportProp{Key: "schemaRegistryName", Value: k.SchemaRegistryName},
+			exportProp{Key: "mskIamRoleArn", Value: k.MskIamRoleArn},
+			exportProp{Key: "mskAwsRegion", Value: k.MskAwsRegion},
+			exportProp{Key: "tlsDisabled", Value: k.TlsDisabled},
+			exportProp{Key: "tlsVerifyServerHostname", Value: k.TlsVerifyServerHostname},
+		)})
+	case in.Snowflake != nil:
+		s := in.Snowflake
+		props = append(props, exportProp{Key: "snowflake", GoType: "SnowflakeInputsArgs", Value: compactProps(
+			exportProp{Key: "uris", Value: s.Uris},
+			exportProp{Key: "accountId", Value: s.AccountId},
+			exportProp{Key: "roleName", Value: s.RoleName},
+			exportProp{Key: "username", Value: s.Username},
+			exportProp{Key: "warehouseName", Value: s.WarehouseName},
+			exportProp{Key: "cloudRegion", Value: s.CloudRegion},
+		)})
+	case in.Postgres != nil:
+		pg := in.Postgres
+		props = append(props, exportProp{Key: "postgres", GoType: "PostgresInputsArgs", Value: compactProps(
+			exportProp{Key: "uris", Value: pg.Uris},
+			exportProp{Key: "username", Value: pg.Username},
+			exportProp{Key: "tlsDisabled", Value: pg.TlsDisabled},
+			exportProp{Key: "tlsVerifyServerHostname", Value: pg.TlsVerifyServerHostname},
+		)})
+	}
+	b.add(ExportedResource{
+		Type:  "deltastream:index:Store",
+		Name:  b.exportName("store", in.Name),
+		ID:    in.Name,
+		props: props,
+	}, "store:"+in.Name)
+}
+
+func (b *inventoryBuilder) addObject(path []string, store, ddl string) {
+	fqn := getFQN(path)
+	b.add(ExportedResource{
+		Type:      "deltastream:index:DeltaStreamObject",
+		Name:      b.exportName("rel", path...),
+		ID:        fqn,
+		DependsOn: b.deps("ns:"+path[0]+"/"+path[1], "store:"+store),
+		props: []exportProp{
+			{Key: "database", Value: path[0]},
+			{Key: "namespace", Value: path[1]},
+			{Key: "store", Value: store},
+			{Key: "sql", Value: ddl},
+		},
+	}, "rel:"+fqn)
+}
+
+func (b *inventoryBuilder) addQuery(id string, in QueryArgs) {
+	keys := []string{"rel:" + in.SinkRelationFqn}
+	for _, src := range in.SourceRelationFqns {
+		keys = append(keys, "rel:"+src)
+	}
+	var name string
+	if path, err := parseFQN(in.SinkRelationFqn); err == nil {
+		name = b.exportName("query", path[2])
+	} else {
+		name = b.exportName("query", id)
+	}
+	b.add(ExportedResource{
+		Type:      "deltastream:index:Query",
+		Name:      name,
+		ID:        id,
+		DependsOn: b.deps(keys...),
+		props: []exportProp{
+			{Key: "sql", Value: in.SQL},
+			{Key: "sinkRelationFqn", Value: in.SinkRelationFqn},
+			{Key: "sourceRelationFqns", Value: in.SourceRelationFqns},
+		},
+	}, "")
+}
+
+func (b *inventoryBuilder) addApplication(id string, in ApplicationArgs) {
+	var keys []string
+	for _, fqn := range append(slices.Clone(in.SinkRelationFqns), in.SourceRelationFqns...) {
+		keys = append(keys, "rel:"+fqn)
+	}
+	b.add(ExportedResource{
+		Type:      "deltastream:index:Application",
+		Name:      b.exportName("app", id),
+		ID:        id,
+		DependsOn: b.deps(keys...),
+		props: []exportProp{
+			{Key: "sql", Value: in.SQL},
+			{Key: "sinkRelationFqns", Value: in.SinkRelationFqns},
+			{Key: "sourceRelationFqns", Value: in.SourceRelationFqns},
+		},
+	}, "")
+}
+
+// compactProps drops unset optional values and dereferences the rest.
+func compactProps(props ...exportProp) []exportProp {
+	var out []exportProp
+	for _, pr := range props {
+		switch v := pr.Value.(type) {
+		case *string:
+			if v == nil {
+				continue
+			}
+			pr.Value = *v
+		case *bool:
+			if v == nil {
+				continue
+			}
+			pr.Value = *v
+		case string:
+			if v == "" {
+				continue
+			}
+		}
+		out = append(out, pr)
+	}
+	return out
+}
+
+// ImportFile renders the inventory as a `pulumi import --file` document.
+func (inv *Inventory) ImportFile() ([]byte, error) {
+	type entry struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+		ID   string `json:"id"`
+	}
+	doc := struct {
+		Resources []entry `json:"resources"`
+	}{Resources: []entry{}}
+	for _, r := range inv.Resources {
+		doc.Resources = append(doc.Resources, entry{Type: r.Type, Name: r.Name, ID: r.ID})
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// exportTypeName is the resource type name used by the SDKs, e.g. Store.
+func exportTypeName(token string) string {
+	return token[strings.LastIndex(token, ":")+1:]
+}
+
+// TypeScript renders the inventory as a Pulumi TypeScript program using the default provider.
+func (inv *Inventory) TypeScript() string {
+	var sb strings.Builder
+	sb.WriteString("import * as deltastream from \"@deltastream/pulumi-deltastream\";\n")
+	for _, r := range inv.Resources {
+		sb.WriteString("\n")
+		if r.Type == "deltastream:index:Store" {
+			sb.WriteString("// Credentials are not exported; add them before running `pulumi up`.\n")
+		}
+		fmt.Fprintf(&sb, "const %s = new deltastream.%s(%s, {\n", r.Name, exportTypeName(r.Type), tsString(r.Name))
+		writeTSProps(&sb, r.props, "    ")
+		sb.WriteString("}")
+		if len(r.DependsOn) > 0 {
+			fmt.Fprintf(&sb, ", { dependsOn: [%s] }", strings.Join(r.DependsOn, ", "))
+		}
+		sb.WriteString(");\n")
+	}
+	return sb.String()
+}
+
+func writeTSProps(sb *strings.Builder, props []exportProp, indent string) {
+	for _, pr := range props {
+		fmt.Fprintf(sb, "%s%s: ", indent, pr.Key)
+		switch v := pr.Value.(type) {
+		case string:
+			sb.WriteString(tsString(v))
+		case bool:
+			sb.WriteString(strconv.FormatBool(v))
+		case []string:
+			quoted := make([]string, len(v))
+			for i, s := range v {
+				quoted[i] = tsString(s)
+			}
+			fmt.Fprintf(sb, "[%s]", strings.Join(quoted, ", "))
+		case []exportProp:
+			sb.WriteString("{\n")
+			writeTSProps(sb, v, indent+"    ")
+			sb.WriteString(indent + "}")
+		}
+		sb.WriteString(",\n")
+	}
+}
+
+// tsString quotes s as a JSON string, which is also a valid TypeScript literal.
+func tsString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// Go renders the inventory as a gofmt-formatted Pulumi Go program using the default provider.
+func (inv *Inventory) Go() ([]byte, error) {
+	referenced := map[string]bool{}
+	for _, r := range inv.Resources {
+		for _, d := range r.DependsOn {
+			referenced[d] = true
+		}
+	}
+	var sb strings.Builder
+	sb.WriteString("package main\n\nimport (\n")
+	sb.WriteString("\tds \"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream\"\n")
+	sb.WriteString("\t\"github.com/pulumi/pulumi/sdk/v3/go/pulumi\"\n)\n\n")
+	sb.WriteString("func main() {\n\tpulumi.Run(func(ctx *pulumi.Context) error {\n")
+	for _, r := range inv.Resources {
+		typ := exportTypeName(r.Type)
+		if r.Type == "deltastream:index:Store" {
+			sb.WriteString("// Credentials are not exported; add them before running `pulumi up`.\n")
+		}
+		var call strings.Builder
+		fmt.Fprintf(&call, "ds.New%s(ctx, %s, &ds.%sArgs{\n", typ, strconv.Quote(r.Name), typ)
+		writeGoProps(&call, r.props)
+		call.WriteString("}")
+		if len(r.DependsOn) > 0 {
+			fmt.Fprintf(&call, ", pulumi.DependsOn([]pulumi.Resource{%s})", strings.Join(r.DependsOn, ", "))
+		}
+		call.WriteString(")")
+		if referenced[r.Name] {
+			fmt.Fprintf(&sb, "%s, err := %s\nif err != nil {\nreturn err\n}\n", r.Name, call.String())
+		} else {
+			fmt.Fprintf(&sb, "if _, err := %s; err != nil {\nreturn err\n}\n", call.String())
+		}
+	}
+	sb.WriteString("return nil\n})\n}\n")
+	return format.Source([]byte(sb.String()))
+}
+
+func writeGoProps(sb *strings.Builder, props []exportProp) {
+	for _, pr := range props {
+		fmt.Fprintf(sb, "%s%s: ", strings.ToUpper(pr.Key[:1]), pr.Key[1:])
+		switch v := pr.Value.(type) {
+		case string:
+			fmt.Fprintf(sb, "pulumi.String(%s)", strconv.Quote(v))
+		case bool:
+			fmt.Fprintf(sb, "pulumi.Bool(%t)", v)
+		case []string:
+			quoted := make([]string, len(v))
+			for i, s := range v {
+				quoted[i] = fmt.Sprintf("pulumi.String(%s)", strconv.Quote(s))
+			}
+			fmt.Fprintf(sb, "pulumi.StringArray{%s}", strings.Join(quoted, ", "))
+		case []exportProp:
+			fmt.Fprintf(sb, "&ds.%s{\n", pr.GoType)
+			writeGoProps(sb, v)
+			sb.WriteString("}")
+		}
+		sb.WriteString(",\n")
+	}
+}
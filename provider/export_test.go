@@ -0,0 +1,124 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+)
+
+func testInventory() *Inventory {
+	b := newInventoryBuilder()
+	b.addStore(StoreArgs{Name: "kafka-1", Kafka: &KafkaInputs{Uris: "b:9092", SaslHashFunction: "PLAIN", TlsDisabled: ptr.To(true)}})
+	b.addDatabase("analytics")
+	b.addNamespace("analytics", "public")
+	b.addObject([]string{"analytics", "public", "pageviews"}, "kafka-1", `CREATE STREAM "pageviews" (id BIGINT) WITH ('topic' = 'pv');`)
+	b.addObject([]string{"analytics", "public", "counts"}, "kafka-1", `CREATE CHANGELOG "counts" (id BIGINT, n BIGINT, PRIMARY KEY(id)) WITH ('topic' = 'counts');`)
+	b.addQuery("3f1c", QueryArgs{
+		SQL:                `INSERT INTO "analytics"."public"."counts" SELECT id, count(*) AS n FROM "analytics"."public"."pageviews" GROUP BY id;`,
+		SinkRelationFqn:    `"analytics"."public"."counts"`,
+		SourceRelationFqns: []string{`"analytics"."public"."pageviews"`, `"other"."public"."clicks"`},
+	})
+	return b.inv
+}
+
+func TestExportInventoryOrder(t *testing.T) {
+	t.Parallel()
+
+	inv := testInventory()
+	var names []string
+	deps := map[string][]string{}
+	for _, r := range inv.Resources {
+		names = append(names, r.Name)
+		deps[r.Name] = r.DependsOn
+	}
+	wantNames := []string{"store_kafka_1", "db_analytics", "ns_analytics_public", "rel_analytics_public_pageviews", "rel_analytics_public_counts", "query_counts"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("names: got %v, want %v", names, wantNames)
+	}
+	wantDeps := map[string][]string{
+		"ns_analytics_public":            {"db_analytics"},
+		"rel_analytics_public_pageviews": {"ns_analytics_public", "store_kafka_1"},
+		// the source in a database that was not exported is not a dependency
+		"query_counts": {"rel_analytics_public_counts", "rel_analytics_public_pageviews"},
+	}
+	for name, want := range wantDeps {
+		if !reflect.DeepEqual(deps[name], want) {
+			t.Errorf("%s depends on %v, want %v", name, deps[name], want)
+		}
+	}
+}
+
+func TestExportNameCollision(t *testing.T) {
+	t.Parallel()
+
+	b := newInventoryBuilder()
+	b.addDatabase("a-b")
+	b.addDatabase("a_b")
+	if got := b.inv.Resources[1].Name; got != "db_a_b_2" {
+		t.Errorf("got %s, want db_a_b_2", got)
+	}
+}
+
+func TestExportRender(t *testing.T) {
+	t.Parallel()
+
+	inv := testInventory()
+	ts := inv.TypeScript()
+	for _, want := range []string{
+		`const store_kafka_1 = new deltastream.Store("store_kafka_1", {`,
+		"    kafka: {\n        uris: \"b:9092\",\n        saslHashFunction: \"PLAIN\",\n        tlsDisabled: true,\n    },",
+		`}, { dependsOn: [ns_analytics_public, store_kafka_1] });`,
+		`sourceRelationFqns: ["\"analytics\".\"public\".\"pageviews\"", "\"other\".\"public\".\"clicks\""],`,
+	} {
+		if !strings.Contains(ts, want) {
+			t.Errorf("TypeScript output missing %q:\n%s", want, ts)
+		}
+	}
+
+	goSrc, err := inv.Go()
+	if err != nil {
+		t.Fatalf("Go: %v", err)
+	}
+	for _, want := range []string{
+		`store_kafka_1, err := ds.NewStore(ctx, "store_kafka_1", &ds.StoreArgs{`,
+		`Kafka: &ds.KafkaInputsArgs{`,
+		`TlsDisabled:      pulumi.Bool(true),`,
+		`if _, err := ds.NewQuery(ctx, "query_counts", &ds.QueryArgs{`,
+		`pulumi.DependsOn([]pulumi.Resource{rel_analytics_public_counts, rel_analytics_public_pageviews})`,
+	} {
+		if !strings.Contains(string(goSrc), want) {
+			t.Errorf("Go output missing %q:\n%s", want, goSrc)
+		}
+	}
+
+	raw, err := inv.ImportFile()
+	if err != nil {
+		t.Fatalf("ImportFile: %v", err)
+	}
+	var doc struct {
+		Resources []struct{ Type, Name, ID string }
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(doc.Resources) != len(inv.Resources) || doc.Resources[3].ID != `"analytics"."public"."pageviews"` || doc.Resources[3].Type != "deltastream:index:DeltaStreamObject" {
+		t.Errorf("unexpected import file: %s", raw)
+	}
+}
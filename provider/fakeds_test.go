@@ -0,0 +1,163 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"sync"
+	"testing"
+
+	ds "github.com/deltastreaminc/go-deltastream"
+
+	"github.com/deltastreaminc/pulumi-deltastream/internal/fakeds"
+)
+
+// fakeDS adapts a fakeds.Server to driver.Connector so provider tests run statements against
+// it in process. Uploads made with withAttachment reach it through the statement context.
+//
+// It sits below database/sql, not at the HTTP layer, so go-deltastream itself is not
+// exercised here; the examples tests drive the same fake through fakeds.Server.Handler and
+// the real driver.
+type fakeDS struct {
+	*fakeds.Server
+}
+
+// fakeServers maps provider server URLs to fakes so parallel tests stay isolated.
+var fakeServers sync.Map
+
+// fakeAttachmentsKey holds the attachments registered on a statement context, by label.
+type fakeAttachmentsKey struct{}
+
+func init() {
+	real := newConnector
+	newConnector = func(ctx context.Context, server string, opts ...ds.ConnectionOption) (driver.Connector, error) {
+		if f, ok := fakeServers.Load(server); ok {
+			return f.(*fakeDS), nil
+		}
+		return real(ctx, server, opts...)
+	}
+	realAttach := attachFile
+	attachFile = func(ctx context.Context, name string, r io.ReadCloser) context.Context {
+		b, _ := io.ReadAll(r)
+		files := maps.Clone(fakeAttachments(ctx))
+		if files == nil {
+			files = map[string][]byte{}
+		}
+		files[name] = b
+		ctx = realAttach(ctx, name, io.NopCloser(bytes.NewReader(b)))
+		return context.WithValue(ctx, fakeAttachmentsKey{}, files)
+	}
+}
+
+func fakeAttachments(ctx context.Context) map[string][]byte {
+	files, _ := ctx.Value(fakeAttachmentsKey{}).(map[string][]byte)
+	return files
+}
+
+// newFakeDS registers a fake for the duration of t and returns it with the server URL that
+// routes provider connections to it.
+func newFakeDS(t *testing.T) (*fakeDS, string) {
+	t.Helper()
+	f := &fakeDS{Server: fakeds.New()}
+	server := "fake://" + t.Name()
+	fakeServers.Store(server, f)
+	t.Cleanup(func() { fakeServers.Delete(server) })
+	return f, server
+}
+
+func (f *fakeDS) Connect(context.Context) (driver.Conn, error) { return &fakeConn{ds: f}, nil }
+func (f *fakeDS) Driver() driver.Driver                        { return fakeDriver{} }
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, fmt.Errorf("fake driver is only usable through its connector")
+}
+
+// fakeConn carries the per-connection statement context set by withOrgRole and setSQLContext.
+type fakeConn struct {
+	ds      *fakeDS
+	session fakeds.Session
+}
+
+func (c *fakeConn) setStatementContext(_, role, database, schema, store string) {
+	if role != "" {
+		c.session.Role = role
+	}
+	if database != "" {
+		c.session.Database = database
+	}
+	if schema != "" {
+		c.session.Schema = schema
+	}
+	if store != "" {
+		c.session.Store = store
+	}
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("fake driver does not support prepared statements")
+}
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("fake driver does not support transactions")
+}
+func (c *fakeConn) Ping(context.Context) error {
+	return nil
+}
+
+func (c *fakeConn) ExecContext(ctx context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if _, err := c.QueryContext(ctx, query, nil); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	c.session.Attachments = fakeAttachments(ctx)
+	res, err := c.ds.Exec(&c.session, query)
+	var sqlErr fakeds.SQLError
+	if errors.As(err, &sqlErr) {
+		return nil, ds.ErrSQLError{SQLCode: ds.SqlState(sqlErr.State), Message: sqlErr.Message}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{res: res}, nil
+}
+
+// fakeRows exposes a fakeds.Result as driver.Rows.
+type fakeRows struct {
+	res *fakeds.Result
+}
+
+func (r *fakeRows) Columns() []string { return r.res.Columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.res.Rows) == 0 {
+		return io.EOF
+	}
+	for i, v := range r.res.Rows[0] {
+		dest[i] = v
+	}
+	r.res.Rows = r.res.Rows[1:]
+	return nil
+}
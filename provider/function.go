@@ -0,0 +1,408 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+)
+
+// Function resource implementing a user-defined function backed by a FunctionSource.
+type Function struct{}
+
+// Annotate sets descriptions on Function for schema generation.
+func (f *Function) Annotate(a infer.Annotator) {
+	a.Describe(f, "User-defined function resource (CREATE FUNCTION) implemented by a class in a FunctionSource")
+}
+
+// FunctionArgs defines user inputs for a user-defined function.
+type FunctionArgs struct {
+	Name       string              `pulumi:"name"`
+	Source     string              `pulumi:"source"`
+	ClassName  string              `pulumi:"className"`
+	Language   *string             `pulumi:"language,optional"`
+	Parameters []FunctionParameter `pulumi:"parameters,optional"`
+	ReturnType string              `pulumi:"returnType"`
+	Owner      *string             `pulumi:"owner,optional"`
+}
+
+// FunctionParameter declares a single function parameter.
+type FunctionParameter struct {
+	Name string `pulumi:"name"`
+	Type string `pulumi:"type"`
+}
+
+// Annotate sets descriptions on FunctionArgs fields for schema generation.
+func (a *FunctionArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Name, "Function name used in SQL")
+	an.Describe(&a.Source, "Name of the function source (JAR) implementing the function")
+	an.Describe(&a.ClassName, "Fully qualified implementing class name")
+	an.Describe(&a.Language, "Implementation language (default JAVA)")
+	an.Describe(&a.Parameters, "Ordered function parameters")
+	an.Describe(&a.ReturnType, "SQL return type, e.g. VARCHAR")
+	an.Describe(&a.Owner, "Optional owning role. When set, statements execute as this role.")
+}
+
+// Annotate sets descriptions on FunctionParameter fields for schema generation.
+func (a *FunctionParameter) Annotate(an infer.Annotator) {
+	an.Describe(&a.Name, "Parameter name")
+	an.Describe(&a.Type, "SQL type of the parameter, e.g. VARCHAR")
+}
+
+// FunctionState extends inputs with computed fields.
+type FunctionState struct {
+	FunctionArgs
+	Signature string `pulumi:"signature"`
+	OwnerOut  string `pulumi:"owner"`
+	CreatedAt string `pulumi:"createdAt"`
+}
+
+// Annotate sets descriptions on FunctionState fields for schema generation.
+func (s *FunctionState) Annotate(a infer.Annotator) {
+	a.Describe(&s.Signature, "Function signature, e.g. toUpper(VARCHAR)")
+}
+
+// Check validates function inputs.
+func (Function) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[FunctionArgs], error) {
+	args, failures, err := infer.DefaultCheck[FunctionArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[FunctionArgs]{}, err
+	}
+	if args.Name == "" {
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name required"})
+	}
+	if args.Source == "" {
+		failures = append(failures, p.CheckFailure{Property: "source", Reason: "source required"})
+	}
+	if args.ClassName == "" {
+		failures = append(failures, p.CheckFailure{Property: "className", Reason: "className required"})
+	}
+	if args.ReturnType == "" {
+		failures = append(failures, p.CheckFailure{Property: "returnType", Reason: "returnType required"})
+	} else if err := checkSQLType(args.ReturnType); err != nil {
+		failures = append(failures, p.CheckFailure{Property: "returnType", Reason: err.Error()})
+	}
+	if err := checkSQLKeyword(functionLanguage(&args)); err != nil {
+		failures = append(failures, p.CheckFailure{Property: "language", Reason: err.Error()})
+	}
+	seen := map[string]bool{}
+	for i, a := range args.Parameters {
+		if a.Name == "" || a.Type == "" {
+			failures = append(failures, p.CheckFailure{Property: fmt.Sprintf("parameters[%d]", i), Reason: "parameter name and type required"})
+			continue
+		}
+		if err := checkSQLType(a.Type); err != nil {
+			failures = append(failures, p.CheckFailure{Property: fmt.Sprintf("parameters[%d].type", i), Reason: err.Error()})
+		}
+		if seen[strings.ToLower(a.Name)] {
+			failures = append(failures, p.CheckFailure{Property: fmt.Sprintf("parameters[%d].name", i), Reason: fmt.Sprintf("duplicate parameter %s", a.Name)})
+		}
+		seen[strings.ToLower(a.Name)] = true
+	}
+	return infer.CheckResponse[FunctionArgs]{Inputs: args, Failures: failures}, nil
+}
+
+// Diff replaces the function on any change; functions cannot be altered in place.
+func (Function) Diff(ctx context.Context, req infer.DiffRequest[FunctionArgs, FunctionState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	s, n := req.State.FunctionArgs, req.Inputs
+	if s.Name != n.Name {
+		diff["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if s.Source != n.Source {
+		diff["source"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if s.ClassName != n.ClassName {
+		diff["className"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if !strings.EqualFold(functionLanguage(&s), functionLanguage(&n)) {
+		diff["language"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if !slices.EqualFunc(s.Parameters, n.Parameters, func(a, b FunctionParameter) bool {
+		return a.Name == b.Name && strings.EqualFold(a.Type, b.Type)
+	}) {
+		diff["parameters"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if !strings.EqualFold(s.ReturnType, n.ReturnType) {
+		diff["returnType"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if n.Owner != nil && ptr.Deref(s.Owner, "") != *n.Owner {
+		diff["owner"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff, DeleteBeforeReplace: true}, nil
+}
+
+// Create issues CREATE FUNCTION and verifies the function in the catalog.
+func (Function) Create(ctx context.Context, req infer.CreateRequest[FunctionArgs]) (infer.CreateResponse[FunctionState], error) {
+	in := req.Inputs
+	if req.DryRun {
+		st := FunctionState{FunctionArgs: in, Signature: functionSignature(&in), CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+		return infer.CreateResponse[FunctionState]{ID: functionSignature(&in), Output: st}, nil
+	}
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.CreateResponse[FunctionState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(in.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.CreateResponse[FunctionState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if _, err := conn.ExecContext(ctx, renderCreateFunction(&in)); err != nil {
+		return infer.CreateResponse[FunctionState]{}, fmt.Errorf("failed to create function: %w", err)
+	}
+	sig := functionSignature(&in)
+	row, err := lookupFunction(ctx, conn, in.Name, sig)
+	if err != nil {
+		return infer.CreateResponse[FunctionState]{}, fmt.Errorf("failed to verify function creation: %w", err)
+	}
+	st := FunctionState{FunctionArgs: in, Signature: sig, OwnerOut: row.Owner, CreatedAt: row.CreatedAt.Format(time.RFC3339)}
+	getLogger(ctx).Info(fmt.Sprintf("Function created: %s", sig))
+	return infer.CreateResponse[FunctionState]{ID: sig, Output: st}, nil
+}
+
+// Read reconciles the function against the catalog. The source, class, language, return type
+// and parameters are read back so drift is detected and imports recover the full definition.
+func (Function) Read(ctx context.Context, req infer.ReadRequest[FunctionArgs, FunctionState]) (infer.ReadResponse[FunctionArgs, FunctionState], error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.ReadResponse[FunctionArgs, FunctionState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.ReadResponse[FunctionArgs, FunctionState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	st := req.State
+	if st.Name == "" {
+		st.Name, _, _ = strings.Cut(req.ID, "(")
+	}
+	row, err := lookupFunction(ctx, conn, st.Name, req.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return infer.ReadResponse[FunctionArgs, FunctionState]{}, nil
+		}
+		return infer.ReadResponse[FunctionArgs, FunctionState]{}, err
+	}
+	row.apply(&st.FunctionArgs)
+	st.OwnerOut = row.Owner
+	st.CreatedAt = row.CreatedAt.Format(time.RFC3339)
+	if st.Signature == "" {
+		st.Signature = req.ID
+	}
+	return infer.ReadResponse[FunctionArgs, FunctionState]{ID: req.ID, Inputs: st.FunctionArgs, State: st}, nil
+}
+
+// Update is not supported; every change replaces the function.
+func (Function) Update(ctx context.Context, req infer.UpdateRequest[FunctionArgs, FunctionState]) (infer.UpdateResponse[FunctionState], error) {
+	if req.DryRun {
+		return infer.UpdateResponse[FunctionState]{}, nil
+	}
+	return infer.UpdateResponse[FunctionState]{}, fmt.Errorf("function updates not supported; requires replacement")
+}
+
+// Delete drops the function by its signature.
+func (Function) Delete(ctx context.Context, req infer.DeleteRequest[FunctionState]) (infer.DeleteResponse, error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP FUNCTION %s;", renderFunctionRef(&req.State.FunctionArgs))); err != nil {
+		// A missing function has no dedicated SQL state, so confirm it against the catalog.
+		if _, lerr := lookupFunction(ctx, conn, req.State.Name, functionSignature(&req.State.FunctionArgs)); errors.Is(lerr, sql.ErrNoRows) {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete function: %w", err)
+	}
+	return infer.DeleteResponse{}, nil
+}
+
+// WireDependencies declares resource graph dependencies for Pulumi.
+func (Function) WireDependencies(f infer.FieldSelector, args *FunctionArgs, state *FunctionState) {
+	f.OutputField(&state.Signature).DependsOn(f.InputField(&args.Name))
+	f.OutputField(&state.Signature).DependsOn(f.InputField(&args.Parameters))
+	f.OutputField(&state.CreatedAt).DependsOn(f.InputField(&args.Source))
+	f.OutputField(&state.CreatedAt).DependsOn(f.InputField(&args.ClassName))
+}
+
+// functionLanguage returns the implementation language, defaulting to JAVA.
+func functionLanguage(in *FunctionArgs) string {
+	return strings.ToUpper(ptr.Deref(in.Language, "JAVA"))
+}
+
+// functionSignature returns name(TYPE, ...) used as the resource ID.
+func functionSignature(in *FunctionArgs) string {
+	types := make([]string, len(in.Parameters))
+	for i, a := range in.Parameters {
+		types[i] = strings.ToUpper(a.Type)
+	}
+	return fmt.Sprintf("%s(%s)", in.Name, strings.Join(types, ", "))
+}
+
+// renderFunctionRef renders "name"(TYPE, ...) identifying a function overload.
+func renderFunctionRef(in *FunctionArgs) string {
+	types := make([]string, len(in.Parameters))
+	for i, a := range in.Parameters {
+		types[i] = a.Type
+	}
+	return fmt.Sprintf("%s(%s)", quoteIdent(in.Name), strings.Join(types, ", "))
+}
+
+// renderCreateFunction renders the CREATE FUNCTION statement for the given inputs.
+func renderCreateFunction(in *FunctionArgs) string {
+	params := make([]string, len(in.Parameters))
+	for i, a := range in.Parameters {
+		params[i] = fmt.Sprintf("%s %s", quoteIdent(a.Name), a.Type)
+	}
+	return fmt.Sprintf("CREATE FUNCTION %s(%s) RETURNS %s LANGUAGE %s WITH ( 'source.name' = %s, 'class.name' = %s );",
+		quoteIdent(in.Name), strings.Join(params, ", "), in.ReturnType, functionLanguage(in), quoteString(in.Source), quoteString(in.ClassName))
+}
+
+// functionRow is a deltastream.sys."functions" catalog row.
+type functionRow struct {
+	Source, ClassName, Language, ReturnType string
+	Parameters                              []FunctionParameter
+	Owner                                   string
+	CreatedAt                               time.Time
+}
+
+// apply copies the catalog definition into args. Values equal to the current ones up to case
+// are kept as written so Read does not report spurious drift.
+func (r *functionRow) apply(args *FunctionArgs) {
+	args.Source = r.Source
+	args.ClassName = r.ClassName
+	if !strings.EqualFold(functionLanguage(args), r.Language) {
+		args.Language = ptr.To(r.Language)
+	}
+	if !strings.EqualFold(args.ReturnType, r.ReturnType) {
+		args.ReturnType = r.ReturnType
+	}
+	if !slices.EqualFunc(args.Parameters, r.Parameters, func(a, b FunctionParameter) bool {
+		return a.Name == b.Name && strings.EqualFold(a.Type, b.Type)
+	}) {
+		args.Parameters = r.Parameters
+	}
+}
+
+// lookupFunction returns the catalog row of the overload of name whose signature is sig. With
+// a single overload, that one is returned whatever its signature.
+func lookupFunction(ctx context.Context, conn *sql.Conn, name, sig string) (functionRow, error) {
+	q := fmt.Sprintf(`SELECT source_name, class_name, "language", return_type, "parameters", "owner", created_at FROM deltastream.sys."functions" WHERE name = %s;`, quoteString(name))
+	rows, err := conn.QueryContext(ctx, q)
+	if err != nil {
+		return functionRow{}, err
+	}
+	defer rows.Close() //nolint:errcheck
+	var found []functionRow
+	for rows.Next() {
+		var r functionRow
+		var params string
+		if err := rows.Scan(&r.Source, &r.ClassName, &r.Language, &r.ReturnType, &params, &r.Owner, &r.CreatedAt); err != nil {
+			return functionRow{}, err
+		}
+		if r.Parameters, err = parseFunctionParameters(params); err != nil {
+			return functionRow{}, fmt.Errorf("function %s: %w", name, err)
+		}
+		found = append(found, r)
+	}
+	if err := rows.Err(); err != nil {
+		return functionRow{}, err
+	}
+	for _, r := range found {
+		if functionSignature(&FunctionArgs{Name: name, Parameters: r.Parameters}) == sig {
+			return r, nil
+		}
+	}
+	if len(found) == 1 {
+		return found[0], nil
+	}
+	return functionRow{}, sql.ErrNoRows
+}
+
+// parseFunctionParameters parses a catalog parameter list such as "email" VARCHAR, n DECIMAL(10, 2).
+// Commas inside parentheses, angle brackets or quotes do not separate parameters.
+func parseFunctionParameters(s string) ([]FunctionParameter, error) {
+	var params []FunctionParameter
+	depth, start := 0, 0
+	add := func(part string) error {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil
+		}
+		var name string
+		if part[0] == '"' {
+			end := scanQuoted(part, 0)
+			name, part = strings.ReplaceAll(part[1:end-1], `""`, `"`), part[end:]
+		} else {
+			var ok bool
+			if name, part, ok = strings.Cut(part, " "); !ok {
+				return fmt.Errorf("invalid parameter %q, expected <name> <type>", part)
+			}
+		}
+		typ := strings.TrimSpace(part)
+		if typ == "" {
+			return fmt.Errorf("parameter %s has no type", name)
+		}
+		params = append(params, FunctionParameter{Name: name, Type: typ})
+		return nil
+	}
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"', '\'':
+			i = scanQuoted(s, i) - 1
+		case '(', '<':
+			depth++
+		case ')', '>':
+			depth--
+		case ',':
+			if depth == 0 {
+				if err := add(s[start:i]); err != nil {
+					return nil, err
+				}
+				start = i + 1
+			}
+		}
+	}
+	if err := add(s[start:]); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
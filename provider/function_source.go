@@ -0,0 +1,141 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+)
+
+// FunctionSource resource uploading a JAR containing user-defined functions.
+type FunctionSource struct{}
+
+// Annotate sets descriptions on FunctionSource for schema generation.
+func (f *FunctionSource) Annotate(a infer.Annotator) {
+	a.Describe(f, "Function source resource uploading a JAR that provides the implementation of user-defined functions")
+}
+
+// FunctionSourceArgs defines user inputs; exactly one of File or Content must be set.
+type FunctionSourceArgs struct {
+	Name        string  `pulumi:"name"`
+	File        *string `pulumi:"file,optional"`
+	Content     *string `pulumi:"content,optional"`
+	Description *string `pulumi:"description,optional"`
+	Owner       *string `pulumi:"owner,optional"`
+}
+
+// Annotate sets descriptions on FunctionSourceArgs fields for schema generation.
+func (a *FunctionSourceArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Name, "Name of the function source")
+	an.Describe(&a.File, "Path to the JAR file. Mutually exclusive with content.")
+	an.Describe(&a.Content, "Base64 encoded JAR bytes. Mutually exclusive with file.")
+	an.Describe(&a.Description, "Optional description of the function source")
+	an.Describe(&a.Owner, "Optional owning role. When set, statements execute as this role.")
+}
+
+// FunctionSourceState extends inputs with computed fields.
+type FunctionSourceState struct {
+	FunctionSourceArgs
+	ContentHash string `pulumi:"contentHash"`
+	OwnerOut    string `pulumi:"owner"`
+	CreatedAt   string `pulumi:"createdAt"`
+}
+
+// Annotate sets descriptions on FunctionSourceState fields for schema generation.
+func (s *FunctionSourceState) Annotate(a infer.Annotator) {
+	a.Describe(&s.ContentHash, "SHA-256 of the uploaded JAR; a change replaces the function source")
+}
+
+// Check validates that exactly one JAR source is provided.
+func (FunctionSource) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[FunctionSourceArgs], error) {
+	args, failures, err := infer.DefaultCheck[FunctionSourceArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[FunctionSourceArgs]{}, err
+	}
+	failures = append(failures, functionSources.check(args.Name, args.File, args.Content)...)
+	return infer.CheckResponse[FunctionSourceArgs]{Inputs: args, Failures: failures}, nil
+}
+
+// Diff replaces the function source when its name, description, owner or uploaded bytes change.
+func (FunctionSource) Diff(ctx context.Context, req infer.DiffRequest[FunctionSourceArgs, FunctionSourceState]) (infer.DiffResponse, error) {
+	s, n := req.State, req.Inputs
+	diff := functionSources.diff(s.Name, n.Name, s.Owner, n.Owner, n.File, n.Content, s.ContentHash)
+	if ptr.Deref(s.Description, "") != ptr.Deref(n.Description, "") {
+		diff["description"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff, DeleteBeforeReplace: true}, nil
+}
+
+// Create uploads the JAR as an attachment and creates the function source.
+func (FunctionSource) Create(ctx context.Context, req infer.CreateRequest[FunctionSourceArgs]) (infer.CreateResponse[FunctionSourceState], error) {
+	in := req.Inputs
+	b, err := readAttachment(in.File, in.Content)
+	if err != nil {
+		return infer.CreateResponse[FunctionSourceState]{}, err
+	}
+	if req.DryRun {
+		st := FunctionSourceState{FunctionSourceArgs: in, ContentHash: attachmentHash(b), CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+		return infer.CreateResponse[FunctionSourceState]{ID: in.Name, Output: st}, nil
+	}
+	var props []string
+	if in.Description != nil {
+		props = append(props, withProperty("description", quoteString(*in.Description)))
+	}
+	row, err := functionSources.create(ctx, in.Name, in.Owner, b, props...)
+	if err != nil {
+		return infer.CreateResponse[FunctionSourceState]{}, err
+	}
+	st := FunctionSourceState{FunctionSourceArgs: in, ContentHash: attachmentHash(b), OwnerOut: row.Owner, CreatedAt: row.CreatedAt.Format(time.RFC3339)}
+	getLogger(ctx).Info(fmt.Sprintf("Function source created: %s", in.Name))
+	return infer.CreateResponse[FunctionSourceState]{ID: in.Name, Output: st}, nil
+}
+
+// Read refreshes function source metadata from the system catalog.
+func (FunctionSource) Read(ctx context.Context, req infer.ReadRequest[FunctionSourceArgs, FunctionSourceState]) (infer.ReadResponse[FunctionSourceArgs, FunctionSourceState], error) {
+	row, found, err := functionSources.read(ctx, req.ID, req.State.Owner)
+	if err != nil || !found {
+		return infer.ReadResponse[FunctionSourceArgs, FunctionSourceState]{}, err
+	}
+	st := req.State
+	st.Name = req.ID
+	st.OwnerOut = row.Owner
+	st.CreatedAt = row.CreatedAt.Format(time.RFC3339)
+	return infer.ReadResponse[FunctionSourceArgs, FunctionSourceState]{ID: req.ID, Inputs: st.FunctionSourceArgs, State: st}, nil
+}
+
+// Update is not supported; every change replaces the function source.
+func (FunctionSource) Update(ctx context.Context, req infer.UpdateRequest[FunctionSourceArgs, FunctionSourceState]) (infer.UpdateResponse[FunctionSourceState], error) {
+	if req.DryRun {
+		return infer.UpdateResponse[FunctionSourceState]{}, nil
+	}
+	return infer.UpdateResponse[FunctionSourceState]{}, fmt.Errorf("function source updates not supported; requires replacement")
+}
+
+// Delete drops the function source.
+func (FunctionSource) Delete(ctx context.Context, req infer.DeleteRequest[FunctionSourceState]) (infer.DeleteResponse, error) {
+	return infer.DeleteResponse{}, functionSources.drop(ctx, req.ID, req.State.Owner)
+}
+
+// WireDependencies declares resource graph dependencies for Pulumi.
+func (FunctionSource) WireDependencies(f infer.FieldSelector, args *FunctionSourceArgs, state *FunctionSourceState) {
+	f.OutputField(&state.ContentHash).DependsOn(f.InputField(&args.File))
+	f.OutputField(&state.ContentHash).DependsOn(f.InputField(&args.Content))
+	f.OutputField(&state.CreatedAt).DependsOn(f.InputField(&args.Name))
+}
@@ -0,0 +1,121 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestFunctionSourceCheck(t *testing.T) {
+	t.Parallel()
+	server, _ := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("FunctionSource"), "udfs")
+	jar := filepath.Join(t.TempDir(), "udfs.jar")
+	require.NoError(t, os.WriteFile(jar, []byte("jar-v1"), 0o600))
+
+	for name, tc := range map[string]struct {
+		inputs map[string]string
+		want   []string
+	}{
+		"file":         {inputs: map[string]string{"name": "udfs", "file": jar}},
+		"content":      {inputs: map[string]string{"name": "udfs", "content": base64.StdEncoding.EncodeToString([]byte("jar-v1"))}},
+		"no name":      {inputs: map[string]string{"name": "", "file": jar}, want: []string{"name"}},
+		"neither":      {inputs: map[string]string{"name": "udfs"}, want: []string{"file"}},
+		"both":         {inputs: map[string]string{"name": "udfs", "file": jar, "content": "amFy"}, want: []string{"content"}},
+		"missing file": {inputs: map[string]string{"name": "udfs", "file": jar + ".gone"}, want: []string{"file"}},
+		"invalid b64":  {inputs: map[string]string{"name": "udfs", "content": "not base64!"}, want: []string{"content"}},
+	} {
+		resp, err := server.Check(p.CheckRequest{Urn: urn, Inputs: strMap(tc.inputs)})
+		require.NoError(t, err, name)
+		var got []string
+		for _, f := range resp.Failures {
+			got = append(got, f.Property)
+		}
+		assert.Equal(t, tc.want, got, name)
+	}
+}
+
+func TestFunctionSourceDiff(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	jar := filepath.Join(dir, "udfs.jar")
+	require.NoError(t, os.WriteFile(jar, []byte("jar-v1"), 0o600))
+	moved := filepath.Join(dir, "moved.jar")
+	require.NoError(t, os.WriteFile(moved, []byte("jar-v1"), 0o600))
+	rebuilt := filepath.Join(dir, "rebuilt.jar")
+	require.NoError(t, os.WriteFile(rebuilt, []byte("jar-v2"), 0o600))
+
+	args := FunctionSourceArgs{Name: "udfs", File: ptr.To(jar), Description: ptr.To("helpers")}
+	state := FunctionSourceState{FunctionSourceArgs: args, ContentHash: attachmentHash([]byte("jar-v1"))}
+	for name, tc := range map[string]struct {
+		edit func(*FunctionSourceArgs)
+		want []string
+	}{
+		"unchanged":  {edit: func(*FunctionSourceArgs) {}},
+		"moved file": {edit: func(a *FunctionSourceArgs) { a.File = ptr.To(moved) }},
+		"same content": {edit: func(a *FunctionSourceArgs) {
+			a.File, a.Content = nil, ptr.To(base64.StdEncoding.EncodeToString([]byte("jar-v1")))
+		}},
+		"rebuilt jar": {edit: func(a *FunctionSourceArgs) { a.File = ptr.To(rebuilt) }, want: []string{"file"}},
+		"new content": {edit: func(a *FunctionSourceArgs) {
+			a.File, a.Content = nil, ptr.To(base64.StdEncoding.EncodeToString([]byte("jar-v2")))
+		}, want: []string{"content"}},
+		"description": {edit: func(a *FunctionSourceArgs) { a.Description = nil }, want: []string{"description"}},
+		"owner":       {edit: func(a *FunctionSourceArgs) { a.Owner = ptr.To("analyst") }, want: []string{"owner"}},
+		"name":        {edit: func(a *FunctionSourceArgs) { a.Name = "udfs2" }, want: []string{"name"}},
+	} {
+		inputs := args
+		tc.edit(&inputs)
+		resp, err := FunctionSource{}.Diff(context.Background(), infer.DiffRequest[FunctionSourceArgs, FunctionSourceState]{State: state, Inputs: inputs})
+		require.NoError(t, err, name)
+		assert.Equal(t, len(tc.want) > 0, resp.HasChanges, name)
+		assert.Len(t, resp.DetailedDiff, len(tc.want), name)
+		for _, k := range tc.want {
+			assert.Equal(t, p.UpdateReplace, resp.DetailedDiff[k].Kind, "%s: %s", name, k)
+		}
+	}
+}
+
+func TestFunctionSourceDeleteAlreadyGone(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("FunctionSource"), "udfs")
+	jar := filepath.Join(t.TempDir(), "udfs.jar")
+	require.NoError(t, os.WriteFile(jar, []byte("jar-v1"), 0o600))
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: strMap(map[string]string{"name": "udfs", "file": jar})})
+	require.NoError(t, err)
+
+	// A drop the server refuses while the source still exists is still an error.
+	f.seed(t, `CREATE FUNCTION "maskEmail"() RETURNS VARCHAR LANGUAGE JAVA WITH ( 'source.name' = 'udfs', 'class.name' = 'com.example.MaskEmail' )`)
+	err = server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn, Properties: created.Properties})
+	assert.ErrorContains(t, err, "failed to delete function source")
+
+	f.seed(t, `DROP FUNCTION "maskEmail"()`, `DROP FUNCTION_SOURCE "udfs"`)
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn, Properties: created.Properties}),
+		"deleting a function source that no longer exists should succeed")
+}
@@ -0,0 +1,167 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestRenderCreateFunction(t *testing.T) {
+	t.Parallel()
+
+	in := FunctionArgs{
+		Name:       "maskEmail",
+		Source:     "udfs",
+		ClassName:  "com.example.MaskEmail",
+		Parameters: []FunctionParameter{{Name: "email", Type: "VARCHAR"}, {Name: "keep", Type: "integer"}},
+		ReturnType: "VARCHAR",
+	}
+	want := `CREATE FUNCTION "maskEmail"("email" VARCHAR, "keep" integer) RETURNS VARCHAR LANGUAGE JAVA WITH ( 'source.name' = 'udfs', 'class.name' = 'com.example.MaskEmail' );`
+	if got := renderCreateFunction(&in); got != want {
+		t.Errorf("create:\ngot  %s\nwant %s", got, want)
+	}
+	if got := functionSignature(&in); got != "maskEmail(VARCHAR, INTEGER)" {
+		t.Errorf("signature: got %s", got)
+	}
+	if got := renderFunctionRef(&in); got != `"maskEmail"(VARCHAR, integer)` {
+		t.Errorf("ref: got %s", got)
+	}
+}
+
+func TestParseFunctionParameters(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseFunctionParameters(`"e,mail" VARCHAR, amount DECIMAL(10, 2), tags ARRAY<VARCHAR>`)
+	require.NoError(t, err)
+	assert.Equal(t, []FunctionParameter{
+		{Name: "e,mail", Type: "VARCHAR"},
+		{Name: "amount", Type: "DECIMAL(10, 2)"},
+		{Name: "tags", Type: "ARRAY<VARCHAR>"},
+	}, got)
+
+	got, err = parseFunctionParameters("")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = parseFunctionParameters("amount")
+	assert.Error(t, err)
+}
+
+func TestFunctionReadDetectsDriftAndImports(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t,
+		`CREATE FUNCTION_SOURCE "udfs"`,
+		`CREATE FUNCTION "maskEmail"("email" VARCHAR, "keep" INTEGER) RETURNS VARCHAR LANGUAGE JAVA WITH ( 'source.name' = 'udfs', 'class.name' = 'com.example.MaskEmail' )`,
+	)
+	urn := resource.NewURN("test", "provider", "", resourceType("Function"), "mask")
+
+	// Import recovers the full definition from the signature alone.
+	imported, err := server.Read(p.ReadRequest{ID: "maskEmail(VARCHAR, INTEGER)", Urn: urn})
+	require.NoError(t, err)
+	require.Equal(t, "maskEmail(VARCHAR, INTEGER)", imported.ID)
+	assert.Equal(t, "udfs", imported.Inputs.Get("source").AsString())
+	assert.Equal(t, "com.example.MaskEmail", imported.Inputs.Get("className").AsString())
+	assert.Equal(t, "VARCHAR", imported.Inputs.Get("returnType").AsString())
+	params := imported.Inputs.Get("parameters").AsArray()
+	require.Equal(t, 2, params.Len())
+	assert.Equal(t, "keep", params.Get(1).AsMap().Get("name").AsString())
+	assert.Equal(t, "INTEGER", params.Get(1).AsMap().Get("type").AsString())
+
+	// Refreshing a state that differs only in case keeps the configured spelling.
+	state := property.NewMap(map[string]property.Value{
+		"name": property.New("maskEmail"), "source": property.New("udfs"), "className": property.New("com.example.MaskEmail"),
+		"language": property.New("java"), "returnType": property.New("varchar"),
+		"parameters": property.New([]property.Value{
+			property.New(strMap(map[string]string{"name": "email", "type": "varchar"})),
+			property.New(strMap(map[string]string{"name": "keep", "type": "integer"})),
+		}),
+		"signature": property.New("maskEmail(VARCHAR, INTEGER)"),
+	})
+	read, err := server.Read(p.ReadRequest{ID: "maskEmail(VARCHAR, INTEGER)", Urn: urn, Properties: state, Inputs: state.Delete("signature")})
+	require.NoError(t, err)
+	assert.Equal(t, "java", read.Inputs.Get("language").AsString())
+	assert.Equal(t, "varchar", read.Inputs.Get("returnType").AsString())
+	assert.Equal(t, "integer", read.Inputs.Get("parameters").AsArray().Get(1).AsMap().Get("type").AsString())
+
+	// A class changed outside Pulumi shows up as drift on the next diff.
+	f.Named["FUNCTION/maskEmail"].Props["class.name"] = "com.example.Other"
+	drifted, err := server.Read(p.ReadRequest{ID: read.ID, Urn: urn, Properties: read.Properties, Inputs: read.Inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "com.example.Other", drifted.Properties.Get("className").AsString())
+	diff, err := server.Diff(p.DiffRequest{ID: read.ID, Urn: urn, State: drifted.Properties, Inputs: read.Inputs})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{"className": {Kind: p.UpdateReplace}}, diff.DetailedDiff)
+}
+
+func TestFunctionDiff(t *testing.T) {
+	t.Parallel()
+
+	args := FunctionArgs{Name: "f", Source: "udfs", ClassName: "com.example.F", ReturnType: "VARCHAR",
+		Parameters: []FunctionParameter{{Name: "x", Type: "VARCHAR"}}}
+	cases := map[string]struct {
+		edit func(*FunctionArgs)
+		want []string
+	}{
+		"unchanged":      {edit: func(*FunctionArgs) {}},
+		"type case":      {edit: func(a *FunctionArgs) { a.ReturnType, a.Parameters[0].Type = "varchar", "varchar" }},
+		"default lang":   {edit: func(a *FunctionArgs) { a.Language = ptr.To("java") }},
+		"parameter name": {edit: func(a *FunctionArgs) { a.Parameters[0].Name = "y" }, want: []string{"parameters"}},
+		"parameter added": {edit: func(a *FunctionArgs) {
+			a.Parameters = append(a.Parameters, FunctionParameter{Name: "n", Type: "INTEGER"})
+		}, want: []string{"parameters"}},
+		"source and class": {edit: func(a *FunctionArgs) { a.Source, a.ClassName = "other", "com.example.G" }, want: []string{"source", "className"}},
+	}
+	for name, tc := range cases {
+		inputs := args
+		inputs.Parameters = append([]FunctionParameter(nil), args.Parameters...)
+		tc.edit(&inputs)
+		resp, err := Function{}.Diff(context.Background(), infer.DiffRequest[FunctionArgs, FunctionState]{State: FunctionState{FunctionArgs: args}, Inputs: inputs})
+		require.NoError(t, err, name)
+		assert.Equal(t, len(tc.want) > 0, resp.HasChanges, name)
+		assert.Len(t, resp.DetailedDiff, len(tc.want), name)
+		for _, k := range tc.want {
+			assert.Equal(t, p.UpdateReplace, resp.DetailedDiff[k].Kind, "%s: %s", name, k)
+		}
+	}
+}
+
+func TestFunctionDeleteAlreadyGone(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t, `CREATE FUNCTION_SOURCE "udfs"`)
+	urn := resource.NewURN("test", "provider", "", resourceType("Function"), "mask")
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: property.NewMap(map[string]property.Value{
+		"name":       property.New("maskEmail"),
+		"source":     property.New("udfs"),
+		"className":  property.New("com.example.MaskEmail"),
+		"returnType": property.New("VARCHAR"),
+	})})
+	require.NoError(t, err)
+	f.seed(t, `DROP FUNCTION "maskEmail"()`)
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn, Properties: created.Properties}),
+		"deleting a function that no longer exists should succeed")
+}
@@ -352,3 +352,100 @@ func (GetStores) Invoke(ctx context.Context, req infer.FunctionRequest[GetStores
 	}
 	return infer.FunctionResponse[GetStoresResult]{Output: GetStoresResult{Stores: list}}, nil
 }
+
+// GetEntityArgs identifies an entity within a store.
+type GetEntityArgs struct {
+	// Store containing the entity.
+	Store string `pulumi:"store"`
+	// Entity name (topic name for Kafka stores).
+	Name string `pulumi:"name"`
+}
+
+// GetEntityResult details a single entity.
+type GetEntityResult struct {
+	// Store containing the entity.
+	Store string `pulumi:"store"`
+	// Entity name.
+	Name string `pulumi:"name"`
+	// Whether the entity holds data rather than other entities.
+	IsLeaf bool `pulumi:"isLeaf"`
+}
+
+// GetEntity looks up a single entity in a store.
+type GetEntity struct{}
+
+// Invoke executes the GetEntity function.
+func (GetEntity) Invoke(ctx context.Context, req infer.FunctionRequest[GetEntityArgs]) (infer.FunctionResponse[GetEntityResult], error) {
+	args := req.Input
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.FunctionResponse[GetEntityResult]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(cfg.Role, "")
+	org := ptr.Deref(cfg.Organization, "")
+	ctx2, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.FunctionResponse[GetEntityResult]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	row, err := lookupEntity(ctx2, conn, args.Store, args.Name)
+	if err != nil {
+		var sqlErr ds.ErrSQLError
+		if errors.As(err, &sqlErr) && sqlErr.SQLCode == ds.SqlStateInvalidStore {
+			return infer.FunctionResponse[GetEntityResult]{}, fmt.Errorf("store %s not found", args.Store)
+		}
+		return infer.FunctionResponse[GetEntityResult]{}, err
+	}
+	if row == nil {
+		return infer.FunctionResponse[GetEntityResult]{}, fmt.Errorf("entity %s not found in store %s", args.Name, args.Store)
+	}
+	return infer.FunctionResponse[GetEntityResult]{Output: GetEntityResult{Store: args.Store, Name: args.Name, IsLeaf: entityIsLeaf(row)}}, nil
+}
+
+// GetEntitiesArgs specifies the store whose entities to list.
+type GetEntitiesArgs struct {
+	// Store name.
+	Store string `pulumi:"store"`
+}
+
+// GetEntitiesResult contains the entities of a store.
+type GetEntitiesResult struct {
+	// Entities in the store.
+	Entities []GetEntityResult `pulumi:"entities"`
+}
+
+// GetEntities lists the top-level entities (e.g. Kafka topics) of a store.
+type GetEntities struct{}
+
+// Invoke executes the GetEntities function.
+func (GetEntities) Invoke(ctx context.Context, req infer.FunctionRequest[GetEntitiesArgs]) (infer.FunctionResponse[GetEntitiesResult], error) {
+	args := req.Input
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.FunctionResponse[GetEntitiesResult]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(cfg.Role, "")
+	org := ptr.Deref(cfg.Organization, "")
+	ctx2, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.FunctionResponse[GetEntitiesResult]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	rows, err := listEntities(ctx2, conn, args.Store)
+	if err != nil {
+		return infer.FunctionResponse[GetEntitiesResult]{}, err
+	}
+	var list []GetEntityResult
+	for i, row := range rows {
+		if i >= invokeMaxRows {
+			log.Printf("pulumi-deltastream warning: getEntities truncated at %d rows (more available)", invokeMaxRows)
+			break
+		}
+		list = append(list, GetEntityResult{Store: args.Store, Name: row["name"], IsLeaf: entityIsLeaf(row)})
+	}
+	return infer.FunctionResponse[GetEntitiesResult]{Output: GetEntitiesResult{Entities: list}}, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"time"
+)
+
+// instrumentConnector wraps a driver connector so every statement its connections run is
+// traced and, when an audit log is configured, recorded in it.
+func instrumentConnector(cfg *Config, connector driver.Connector) driver.Connector {
+	c := statementConnector{Connector: connector}
+	if path := auditLogPath(cfg); path != "" {
+		c.log = openAuditLog(path)
+	}
+	return c
+}
+
+type statementConnector struct {
+	driver.Connector
+	log *auditLog
+}
+
+func (c statementConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &statementConn{Conn: conn, log: c.log}, nil
+}
+
+// statementConn observes the statements run on an underlying connection. withOrgRole sets
+// org and role; code that needs the driver connection itself unwraps it with rawConn.
+type statementConn struct {
+	driver.Conn
+	log       *auditLog
+	org, role string
+}
+
+// observe runs one statement inside a span and records it in the audit log.
+func (c *statementConn) observe(ctx context.Context, query string, run func(context.Context) error) error {
+	ctx, span := startStatementSpan(ctx, query, c.org, c.role)
+	start := time.Now()
+	err := run(ctx)
+	if errors.Is(err, driver.ErrSkip) {
+		span.End()
+		return err
+	}
+	endSpan(ctx, span, err)
+	if c.log != nil {
+		c.log.record(ctx, c.org, c.role, query, start, err)
+	}
+	return err
+}
+
+func (c *statementConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	var res driver.Result
+	err := c.observe(ctx, query, func(ctx context.Context) error {
+		var err error
+		res, err = execer.ExecContext(ctx, query, args)
+		return err
+	})
+	return res, err
+}
+
+func (c *statementConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	var rows driver.Rows
+	err := c.observe(ctx, query, func(ctx context.Context) error {
+		var err error
+		rows, err = queryer.QueryContext(ctx, query, args)
+		return err
+	})
+	return rows, err
+}
+
+func (c *statementConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// rawConn returns the driver connection under the statement wrapper, recording org and role
+// on the wrapper when set.
+func rawConn(dc any, org, role string) any {
+	c, ok := dc.(*statementConn)
+	if !ok {
+		return dc
+	}
+	if org != "" {
+		c.org = org
+	}
+	if role != "" {
+		c.role = role
+	}
+	return c.Conn
+}
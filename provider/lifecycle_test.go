@@ -0,0 +1,472 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blang/semver"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/deltastreaminc/pulumi-deltastream/internal/fakeds"
+)
+
+// newFakeServer returns a provider server configured against a fresh in-memory DeltaStream.
+func newFakeServer(t *testing.T) (integration.Server, *fakeDS) {
+	t.Helper()
+	f, url := newFakeDS(t)
+	server, err := integration.NewServer(context.Background(), Name, semver.MustParse("1.0.0"), integration.WithProvider(Provider()))
+	require.NoError(t, err)
+	require.NoError(t, server.Configure(p.ConfigureRequest{Args: property.NewMap(map[string]property.Value{
+		"apiKey": property.New("test-key"),
+		"server": property.New(url),
+	})}))
+	return server, f
+}
+
+// seed runs statements against the fake as the default role, for resources a test does not
+// manage itself.
+func (f *fakeDS) seed(t *testing.T, stmts ...string) {
+	t.Helper()
+	for _, stmt := range stmts {
+		_, err := f.Exec(&fakeds.Session{}, stmt)
+		require.NoError(t, err, stmt)
+	}
+}
+
+func strMap(kv map[string]string) property.Map {
+	m := map[string]property.Value{}
+	for k, v := range kv {
+		m[k] = property.New(v)
+	}
+	return property.NewMap(m)
+}
+
+func resourceType(name string) tokens.Type {
+	return tokens.Type("deltastream:index:" + name)
+}
+
+func TestLifecycleDatabase(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+
+	integration.LifeCycleTest{
+		Resource: resourceType("Database"),
+		Create: integration.Operation{
+			Inputs: strMap(map[string]string{"name": "analytics"}),
+			Hook: func(_, output property.Map) {
+				assert.Equal(t, "analytics", output.Get("name").AsString())
+				assert.Equal(t, "sysadmin", output.Get("owner").AsString())
+				assert.NotEmpty(t, output.Get("createdAt").AsString())
+			},
+		},
+		Updates: []integration.Operation{{
+			Inputs: strMap(map[string]string{"name": "analytics", "owner": "analyst"}),
+			Hook: func(_, output property.Map) {
+				assert.Equal(t, "analyst", output.Get("owner").AsString())
+				assert.Equal(t, "analyst", f.Databases["analytics"].Owner)
+			},
+		}},
+	}.Run(t, server)
+
+	assert.Empty(t, f.Databases, "database should be dropped")
+	assert.Contains(t, f.Executed(), `ALTER DATABASE "analytics" OWNER TO "analyst"`)
+}
+
+func TestLifecycleNamespace(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t, `CREATE DATABASE "analytics"`)
+
+	integration.LifeCycleTest{
+		Resource: resourceType("Namespace"),
+		Create: integration.Operation{
+			Inputs: strMap(map[string]string{"database": "analytics", "name": "raw"}),
+			Hook: func(_, output property.Map) {
+				assert.Equal(t, "raw", output.Get("name").AsString())
+				assert.NotNil(t, f.Schemas["analytics/raw"])
+			},
+		},
+		Updates: []integration.Operation{{
+			Inputs: strMap(map[string]string{"database": "analytics", "name": "curated"}),
+			Hook: func(_, output property.Map) {
+				assert.Equal(t, "curated", output.Get("name").AsString())
+			},
+		}},
+	}.Run(t, server)
+
+	assert.Nil(t, f.Schemas["analytics/raw"])
+	assert.Nil(t, f.Schemas["analytics/curated"])
+	assert.NotNil(t, f.Schemas["analytics/public"])
+}
+
+func kafkaStoreInputs(name, uris string) property.Map {
+	return property.NewMap(map[string]property.Value{
+		"name": property.New(name),
+		"kafka": property.New(strMap(map[string]string{
+			"uris":             uris,
+			"saslHashFunction": "PLAIN",
+			"saslUsername":     "user",
+			"saslPassword":     "pass",
+		})),
+	})
+}
+
+func TestLifecycleStore(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+
+	integration.LifeCycleTest{
+		Resource: resourceType("Store"),
+		Create: integration.Operation{
+			Inputs: kafkaStoreInputs("events", "kafka-1:9092"),
+			Hook: func(_, output property.Map) {
+				assert.Equal(t, "KAFKA", output.Get("type").AsString())
+				assert.Equal(t, "ready", output.Get("state").AsString())
+			},
+		},
+		Updates: []integration.Operation{{
+			Inputs: kafkaStoreInputs("events", "kafka-2:9092"),
+			Hook: func(_, _ property.Map) {
+				assert.Equal(t, "kafka-2:9092", f.Stores["events"].Props["uris"])
+			},
+		}},
+	}.Run(t, server)
+
+	assert.Empty(t, f.Stores)
+}
+
+// seedRelations creates a database, namespace, store and two streams for query tests.
+func (f *fakeDS) seedRelations(t *testing.T) {
+	f.seed(t,
+		`CREATE DATABASE "db"`,
+		`CREATE STORE "events" WITH ('type' = KAFKA, 'uris' = 'kafka:9092')`,
+		`CREATE STREAM "db"."public"."pageviews" (viewtime BIGINT) WITH ('store' = 'events', 'topic' = 'pageviews')`,
+	)
+}
+
+func TestLifecycleObjectAndQuery(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seedRelations(t)
+
+	objectInputs := func(sqlText string) property.Map {
+		return strMap(map[string]string{"database": "db", "namespace": "public", "store": "events", "sql": sqlText})
+	}
+	integration.LifeCycleTest{
+		Resource: resourceType("DeltaStreamObject"),
+		Create: integration.Operation{
+			Inputs: objectInputs(`CREATE STREAM "pv_copy" (viewtime BIGINT) WITH ('topic' = 'pv_copy');`),
+			Hook: func(_, output property.Map) {
+				assert.Equal(t, `"db"."public"."pv_copy"`, output.Get("fqn").AsString())
+				assert.Equal(t, "stream", output.Get("type").AsString())
+				assert.NotNil(t, f.Relations["db/public/pv_copy"])
+			},
+		},
+	}.Run(t, server)
+	assert.Nil(t, f.Relations["db/public/pv_copy"])
+
+	f.seed(t, `CREATE STREAM "db"."public"."sink" (viewtime BIGINT) WITH ('store' = 'events', 'topic' = 'sink')`)
+	integration.LifeCycleTest{
+		Resource: resourceType("Query"),
+		Create: integration.Operation{
+			Inputs: property.NewMap(map[string]property.Value{
+				"sourceRelationFqns": property.New([]property.Value{property.New(`"db"."public"."pageviews"`)}),
+				"sinkRelationFqn":    property.New(`"db"."public"."sink"`),
+				"sql":                property.New(`INSERT INTO "db"."public"."sink" SELECT * FROM "db"."public"."pageviews";`),
+			}),
+			Hook: func(_, output property.Map) {
+				id := output.Get("queryId").AsString()
+				require.NotNil(t, f.Queries[id])
+				assert.Equal(t, "running", f.Queries[id].State)
+				assert.Equal(t, "running", output.Get("state").AsString())
+			},
+		},
+	}.Run(t, server)
+
+	for id, q := range f.Queries {
+		assert.Equal(t, "terminated", q.State, "query %s", id)
+	}
+}
+
+func TestLifecycleRawSQLObjectIsStable(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seedRelations(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("DeltaStreamObject"), "copy")
+
+	inputs := strMap(map[string]string{"database": "db", "namespace": "public", "store": "events",
+		"sql": `CREATE STREAM "pv_copy" (viewtime BIGINT) WITH ('topic' = 'pv_copy');`})
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, "pv_copy", created.Properties.Get("name").AsString())
+
+	// The planner name in state must not make the raw-SQL object look structured.
+	diff, err := server.Diff(p.DiffRequest{Urn: urn, ID: created.ID, State: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges, "unchanged raw-SQL object diffed: %v", diff.DetailedDiff)
+
+	read, err := server.Read(p.ReadRequest{Urn: urn, ID: created.ID, Properties: created.Properties, Inputs: inputs})
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, read.ID)
+	assert.True(t, read.Inputs.Get("relationName").IsNull())
+	check, err := server.Check(p.CheckRequest{Urn: urn, Inputs: read.Inputs})
+	require.NoError(t, err)
+	assert.Empty(t, check.Failures, "read inputs should pass check")
+	diff, err = server.Diff(p.DiffRequest{Urn: urn, ID: read.ID, State: read.Properties, Inputs: check.Inputs})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges, "refreshed raw-SQL object diffed: %v", diff.DetailedDiff)
+
+	updatedInputs := inputs.Set("forceDestroy", property.New(true))
+	diff, err = server.Diff(p.DiffRequest{Urn: urn, ID: read.ID, State: read.Properties, Inputs: updatedInputs})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{"forceDestroy": {Kind: p.Update}}, diff.DetailedDiff)
+	updated, err := server.Update(p.UpdateRequest{Urn: urn, ID: read.ID, State: read.Properties, Inputs: updatedInputs})
+	require.NoError(t, err)
+	assert.Equal(t, `"db"."public"."pv_copy"`, updated.Properties.Get("fqn").AsString())
+	assert.True(t, updated.Properties.Get("forceDestroy").AsBool())
+
+	require.NoError(t, server.Delete(p.DeleteRequest{Urn: urn, ID: read.ID, Properties: updated.Properties}))
+	assert.Nil(t, f.Relations["db/public/pv_copy"])
+}
+
+func TestLifecycleDeleteBlockedByQuery(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seedRelations(t)
+	f.seed(t,
+		`CREATE STREAM "db"."public"."sink" (viewtime BIGINT) WITH ('store' = 'events', 'topic' = 'sink')`,
+		`INSERT INTO "db"."public"."sink" SELECT * FROM "db"."public"."pageviews"`,
+	)
+
+	urn := resource.NewURN("test", "provider", "", resourceType("Store"), "test")
+	read, err := server.Read(p.ReadRequest{ID: "events", Urn: urn})
+	require.NoError(t, err)
+	err = server.Delete(p.DeleteRequest{ID: "events", Urn: urn, Properties: read.Properties})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "query 00000000-0000-4000-8000-000000000001")
+	assert.NotNil(t, f.Stores["events"])
+
+	props := read.Properties.Set("forceDestroy", property.New(true))
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: "events", Urn: urn, Properties: props}))
+	assert.Nil(t, f.Stores["events"])
+	assert.Empty(t, f.Relations)
+}
+
+func TestLifecycleSecretAndComputePool(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+
+	integration.LifeCycleTest{
+		Resource: resourceType("Secret"),
+		Create: integration.Operation{
+			Inputs: property.NewMap(map[string]property.Value{
+				"name":  property.New("token"),
+				"type":  property.New("generic_string"),
+				"value": property.New("s3cr3t").WithSecret(true),
+			}),
+			Hook: func(_, _ property.Map) {
+				assert.NotNil(t, f.Named["SECRET/token"])
+			},
+		},
+	}.Run(t, server)
+	assert.Nil(t, f.Named["SECRET/token"])
+
+	integration.LifeCycleTest{
+		Resource: resourceType("ComputePool"),
+		Create: integration.Operation{
+			Inputs: property.NewMap(map[string]property.Value{
+				"name":    property.New("pool"),
+				"size":    property.New("small"),
+				"running": property.New(true),
+			}),
+			Hook: func(_, _ property.Map) {
+				require.NotNil(t, f.Named["COMPUTE_POOL/pool"])
+				assert.Equal(t, "running", f.Named["COMPUTE_POOL/pool"].Status)
+			},
+		},
+	}.Run(t, server)
+	assert.Nil(t, f.Named["COMPUTE_POOL/pool"])
+}
+
+func TestLifecycleDescriptorSourceAndEntity(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t,
+		`CREATE STORE "events" WITH ('type' = KAFKA, 'uris' = 'kafka:9092')`,
+		`CREATE DESCRIPTOR_SOURCE "pb"`,
+	)
+	require.NotNil(t, f.Named["DESCRIPTOR_SOURCE/pb"])
+
+	integration.LifeCycleTest{
+		Resource: resourceType("DescriptorSource"),
+		Create: integration.Operation{
+			Inputs: strMap(map[string]string{"name": "pageviews_pb", "content": base64.StdEncoding.EncodeToString([]byte("descriptor-v1"))}),
+			Hook: func(_, output property.Map) {
+				require.NotNil(t, f.Named["DESCRIPTOR_SOURCE/pageviews_pb"])
+				assert.Equal(t, "descriptor-v1", f.Named["DESCRIPTOR_SOURCE/pageviews_pb"].Props["file"])
+				assert.Equal(t, attachmentHash([]byte("descriptor-v1")), output.Get("contentHash").AsString())
+			},
+		},
+	}.Run(t, server)
+	assert.Nil(t, f.Named["DESCRIPTOR_SOURCE/pageviews_pb"])
+
+	descriptor := func(source string) property.Value {
+		return property.New(strMap(map[string]string{"source": source, "message": "com.example.PageView"}))
+	}
+	integration.LifeCycleTest{
+		Resource: resourceType("Entity"),
+		Create: integration.Operation{
+			Inputs: property.NewMap(map[string]property.Value{
+				"store":      property.New("events"),
+				"name":       property.New("pageviews"),
+				"partitions": property.New(3.0),
+				"configs":    property.New(strMap(map[string]string{"retention.ms": "86400000"})),
+			}),
+			Hook: func(_, output property.Map) {
+				require.NotNil(t, f.Entities["events/pageviews"])
+				assert.Equal(t, "3", f.Entities["events/pageviews"].Props["kafka.partitions"])
+				assert.True(t, output.Get("isLeaf").AsBool())
+			},
+		},
+		Updates: []integration.Operation{{
+			Inputs: property.NewMap(map[string]property.Value{
+				"store":           property.New("events"),
+				"name":            property.New("pageviews"),
+				"partitions":      property.New(3.0),
+				"configs":         property.New(strMap(map[string]string{"cleanup.policy": "compact"})),
+				"valueDescriptor": descriptor("pb"),
+			}),
+			Hook: func(_, _ property.Map) {
+				props := f.Entities["events/pageviews"].Props
+				assert.Equal(t, "compact", props["kafka.topic.cleanup.policy"])
+				assert.NotContains(t, props, "kafka.topic.retention.ms")
+				assert.Equal(t, `"pb"."com.example.PageView"`, props["kafka.topic.descriptor.value"])
+			},
+		}},
+	}.Run(t, server)
+	assert.Empty(t, f.Entities)
+}
+
+func TestLifecycleFunctionSourceAndFunction(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+
+	jar := filepath.Join(t.TempDir(), "udfs.jar")
+	require.NoError(t, os.WriteFile(jar, []byte("jar-v1"), 0o600))
+	integration.LifeCycleTest{
+		Resource: resourceType("FunctionSource"),
+		Create: integration.Operation{
+			Inputs: strMap(map[string]string{"name": "udfs", "file": jar, "description": "string helpers"}),
+			Hook: func(_, _ property.Map) {
+				require.NotNil(t, f.Named["FUNCTION_SOURCE/udfs"])
+				assert.Equal(t, "jar-v1", f.Named["FUNCTION_SOURCE/udfs"].Props["file"])
+				assert.Equal(t, "string helpers", f.Named["FUNCTION_SOURCE/udfs"].Props["description"])
+			},
+		},
+	}.Run(t, server)
+	assert.Nil(t, f.Named["FUNCTION_SOURCE/udfs"])
+
+	f.seed(t, `CREATE FUNCTION_SOURCE "udfs"`)
+	integration.LifeCycleTest{
+		Resource: resourceType("Function"),
+		Create: integration.Operation{
+			Inputs: property.NewMap(map[string]property.Value{
+				"name":       property.New("maskEmail"),
+				"source":     property.New("udfs"),
+				"className":  property.New("com.example.MaskEmail"),
+				"returnType": property.New("VARCHAR"),
+				"parameters": property.New([]property.Value{property.New(strMap(map[string]string{"name": "email", "type": "VARCHAR"}))}),
+			}),
+			Hook: func(_, output property.Map) {
+				require.NotNil(t, f.Named["FUNCTION/maskEmail"])
+				assert.Equal(t, "com.example.MaskEmail", f.Named["FUNCTION/maskEmail"].Props["class.name"])
+				assert.Equal(t, "maskEmail(VARCHAR)", output.Get("signature").AsString())
+			},
+		},
+	}.Run(t, server)
+	assert.Nil(t, f.Named["FUNCTION/maskEmail"])
+}
+
+func TestReadDeletedResource(t *testing.T) {
+	t.Parallel()
+	server, _ := newFakeServer(t)
+
+	for typ, id := range map[string]string{"Database": "gone", "Store": "gone"} {
+		resp, err := server.Read(p.ReadRequest{ID: id, Urn: resource.NewURN("test", "provider", "", resourceType(typ), "test")})
+		require.NoError(t, err, typ)
+		assert.Empty(t, resp.ID, "%s read of a missing resource should clear the ID", typ)
+	}
+}
+
+func TestPreviewObjectPlansRelation(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seedRelations(t)
+
+	urn := resource.NewURN("test", "provider", "", resourceType("DeltaStreamObject"), "sink")
+	resp, err := server.Create(p.CreateRequest{Urn: urn, DryRun: true, Properties: strMap(map[string]string{
+		"database": "db", "namespace": "public", "store": "events",
+		"sql": `CREATE STREAM "sink" (viewtime BIGINT) WITH ('topic' = 'sink');`,
+	})})
+	require.NoError(t, err)
+	fqn := resp.Properties.Get("fqn").AsString()
+	assert.Equal(t, `"db"."public"."sink"`, fqn)
+	assert.Equal(t, fqn, resp.ID)
+	assert.Equal(t, "sink", resp.Properties.Get("name").AsString())
+	assert.Equal(t, "stream", resp.Properties.Get("type").AsString())
+	assert.Nil(t, f.Relations["db/public/sink"], "preview must not create the relation")
+
+	// A query reading the planned fqn validates while the sink does not exist yet.
+	check, err := server.Check(p.CheckRequest{Urn: resource.NewURN("test", "provider", "", resourceType("Query"), "copy"), Inputs: property.NewMap(map[string]property.Value{
+		"sourceRelationFqns": property.New([]property.Value{property.New(`"db"."public"."pageviews"`)}),
+		"sinkRelationFqn":    property.New(fqn),
+		"sql":                property.New(`INSERT INTO ` + fqn + ` SELECT * FROM "db"."public"."pageviews";`),
+	})})
+	require.NoError(t, err)
+	assert.Empty(t, check.Failures)
+
+	// A misspelled relation that is not declared still fails the check.
+	f.seed(t, `CREATE STREAM "db"."public"."out" (viewtime BIGINT) WITH ('store' = 'events', 'topic' = 'out')`)
+	check, err = server.Check(p.CheckRequest{Urn: resource.NewURN("test", "provider", "", resourceType("Query"), "copy"), Inputs: property.NewMap(map[string]property.Value{
+		"sourceRelationFqns": property.New([]property.Value{property.New(`"db"."public"."pageviews"`)}),
+		"sinkRelationFqn":    property.New(`"db"."public"."out"`),
+		"sql":                property.New(`INSERT INTO "db"."public"."out" SELECT * FROM "db"."public"."pageveiws";`),
+	})})
+	require.NoError(t, err)
+	require.Len(t, check.Failures, 1)
+	assert.Equal(t, "sql", check.Failures[0].Property)
+	assert.Contains(t, check.Failures[0].Reason, `relation "db"."public"."pageveiws" not found`)
+	assert.Contains(t, check.Failures[0].Reason, "did you declare the DeltaStreamObject first?")
+
+	// Planning is skipped when the namespace is only created later in the same update.
+	resp, err = server.Create(p.CreateRequest{Urn: urn, DryRun: true, Properties: strMap(map[string]string{
+		"database": "db", "namespace": "staging", "store": "events",
+		"sql": `CREATE STREAM "sink" (viewtime BIGINT) WITH ('topic' = 'sink');`,
+	})})
+	require.NoError(t, err)
+	assert.Equal(t, "db/staging/events", resp.ID)
+	assert.True(t, resp.Properties.Get("fqn").IsComputed(), "fqn should stay unknown")
+}
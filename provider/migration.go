@@ -0,0 +1,392 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+)
+
+// Migration resource applies an append-only list of SQL scripts, each exactly once.
+type Migration struct{}
+
+// Annotate sets descriptions on Migration for schema generation.
+func (m *Migration) Annotate(a infer.Annotator) {
+	a.Describe(m, "Migration resource applying an ordered list of named SQL scripts exactly once each. Applied scripts are recorded in state with their checksums; new scripts appended to the list are applied in order on update.")
+}
+
+// MigrationScript is one named script; exactly one of SQL or File must be set.
+type MigrationScript struct {
+	Name string  `pulumi:"name"`
+	SQL  *string `pulumi:"sql,optional"`
+	File *string `pulumi:"file,optional"`
+}
+
+// Annotate sets descriptions on MigrationScript fields for schema generation.
+func (s *MigrationScript) Annotate(a infer.Annotator) {
+	a.Describe(&s.Name, "Unique name of the script, recorded once it has been applied")
+	a.Describe(&s.SQL, "Inline script. Mutually exclusive with file.")
+	a.Describe(&s.File, "Path to a file holding the script. Mutually exclusive with sql.")
+}
+
+// MigrationArgs defines the scripts to apply and the scope they run in.
+type MigrationArgs struct {
+	Scripts   []MigrationScript `pulumi:"scripts"`
+	Database  *string           `pulumi:"database,optional"`
+	Namespace *string           `pulumi:"namespace,optional"`
+	Store     *string           `pulumi:"store,optional"`
+	Owner     *string           `pulumi:"owner,optional"`
+}
+
+// Annotate sets descriptions on MigrationArgs fields for schema generation.
+func (a *MigrationArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Scripts, "Ordered scripts. Scripts can only be appended: removing, reordering or editing an applied script is reported as an error.")
+	an.Describe(&a.Database, "Optional database the scripts run in")
+	an.Describe(&a.Namespace, "Optional namespace the scripts run in")
+	an.Describe(&a.Store, "Optional default store for the scripts")
+	an.Describe(&a.Owner, "Optional role the scripts execute as")
+}
+
+// AppliedMigration records a script that ran successfully, or the statements that ran of a
+// script that failed part-way.
+type AppliedMigration struct {
+	Name                string `pulumi:"name"`
+	Checksum            string `pulumi:"checksum"`
+	AppliedAt           string `pulumi:"appliedAt"`
+	CompletedStatements *int   `pulumi:"completedStatements,optional"`
+}
+
+// Annotate sets descriptions on AppliedMigration fields for schema generation.
+func (m *AppliedMigration) Annotate(a infer.Annotator) {
+	a.Describe(&m.Checksum, "SHA-256 of the normalized script text when it was applied, or of its completed statements when it is partially applied")
+	a.Describe(&m.CompletedStatements, "Set when the script failed part-way: the number of leading statements that ran. The next update resumes after them.")
+}
+
+// partial reports whether the script failed part-way.
+func (m *AppliedMigration) partial() bool {
+	return ptr.Deref(m.CompletedStatements, 0) > 0
+}
+
+// MigrationState extends inputs with the scripts applied so far.
+type MigrationState struct {
+	MigrationArgs
+	Applied []AppliedMigration `pulumi:"applied"`
+}
+
+// Annotate sets descriptions on MigrationState fields for schema generation.
+func (s *MigrationState) Annotate(a infer.Annotator) {
+	a.Describe(&s.Applied, "Scripts applied so far, in order")
+}
+
+// Check validates script names and sources.
+func (Migration) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[MigrationArgs], error) {
+	args, failures, err := infer.DefaultCheck[MigrationArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[MigrationArgs]{}, err
+	}
+	if len(args.Scripts) == 0 {
+		failures = append(failures, p.CheckFailure{Property: "scripts", Reason: "at least one script required"})
+	}
+	seen := map[string]bool{}
+	for i, s := range args.Scripts {
+		prop := fmt.Sprintf("scripts[%d]", i)
+		switch {
+		case s.Name == "":
+			failures = append(failures, p.CheckFailure{Property: prop + ".name", Reason: "name required"})
+		case seen[s.Name]:
+			failures = append(failures, p.CheckFailure{Property: prop + ".name", Reason: fmt.Sprintf("duplicate script name %q", s.Name)})
+		}
+		seen[s.Name] = true
+		hasSQL, hasFile := s.SQL != nil, s.File != nil && *s.File != ""
+		switch {
+		case hasSQL && hasFile:
+			failures = append(failures, p.CheckFailure{Property: prop + ".sql", Reason: "sql and file are mutually exclusive"})
+			continue
+		case !hasSQL && !hasFile:
+			failures = append(failures, p.CheckFailure{Property: prop + ".sql", Reason: "one of sql or file required"})
+			continue
+		}
+		text, err := s.text()
+		if err != nil {
+			failures = append(failures, p.CheckFailure{Property: prop + ".file", Reason: fmt.Sprintf("cannot read file: %v", err)})
+			continue
+		}
+		if len(splitSQLStatements(text)) == 0 {
+			field := ".sql"
+			if hasFile {
+				field = ".file"
+			}
+			failures = append(failures, p.CheckFailure{Property: prop + field, Reason: "script has no statements"})
+		}
+	}
+	if args.Namespace != nil && args.Database == nil {
+		failures = append(failures, p.CheckFailure{Property: "namespace", Reason: "namespace requires database"})
+	}
+	return infer.CheckResponse[MigrationArgs]{Inputs: args, Failures: failures}, nil
+}
+
+// Diff reports pending scripts and scope changes as in-place updates. Removing, reordering or
+// editing an applied script shows up as a change to that script, so the preview points at it;
+// Update then refuses it, since the provider cannot undo what it ran.
+func (Migration) Diff(ctx context.Context, req infer.DiffRequest[MigrationArgs, MigrationState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	changed := appliedChanges(req.Inputs.Scripts, req.State.Applied)
+	for _, c := range changed {
+		kind := p.Update
+		if c.removed {
+			kind = p.Delete
+		}
+		diff[fmt.Sprintf("scripts[%d]", c.index)] = p.PropertyDiff{Kind: kind}
+	}
+	if len(changed) > 0 {
+		getLogger(ctx).Warning(appliedChangesError(changed).Error() + "; the update will fail")
+	}
+	if migrationPending(req.Inputs.Scripts, req.State.Applied) {
+		diff["scripts"] = p.PropertyDiff{Kind: p.Update}
+	}
+	scope := map[string][2]*string{
+		"database":  {req.State.Database, req.Inputs.Database},
+		"namespace": {req.State.Namespace, req.Inputs.Namespace},
+		"store":     {req.State.Store, req.Inputs.Store},
+		"owner":     {req.State.Owner, req.Inputs.Owner},
+	}
+	for prop, v := range scope {
+		if ptr.Deref(v[0], "") != ptr.Deref(v[1], "") {
+			diff[prop] = p.PropertyDiff{Kind: p.Update}
+		}
+	}
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff}, nil
+}
+
+// Create applies every script in order. When a statement fails after others succeeded, the
+// resource is created with the progress so far and the next update resumes from there.
+func (Migration) Create(ctx context.Context, req infer.CreateRequest[MigrationArgs]) (infer.CreateResponse[MigrationState], error) {
+	in := req.Inputs
+	if req.DryRun {
+		return infer.CreateResponse[MigrationState]{ID: "preview-migration", Output: MigrationState{MigrationArgs: in}}, nil
+	}
+	id := uuid.NewString()
+	applied, err := applyMigrations(ctx, &in, nil)
+	st := MigrationState{MigrationArgs: in, Applied: applied}
+	if err != nil {
+		if len(applied) == 0 {
+			return infer.CreateResponse[MigrationState]{}, err
+		}
+		return infer.CreateResponse[MigrationState]{ID: id, Output: st}, infer.ResourceInitFailedError{Reasons: []string{err.Error()}}
+	}
+	getLogger(ctx).Info(fmt.Sprintf("Migration %s applied %d scripts", id, len(applied)))
+	return infer.CreateResponse[MigrationState]{ID: id, Output: st}, nil
+}
+
+// Read returns the recorded state; applied scripts are tracked only in Pulumi state.
+func (Migration) Read(ctx context.Context, req infer.ReadRequest[MigrationArgs, MigrationState]) (infer.ReadResponse[MigrationArgs, MigrationState], error) {
+	return infer.ReadResponse[MigrationArgs, MigrationState]{ID: req.ID, Inputs: req.State.MigrationArgs, State: req.State}, nil
+}
+
+// Update applies the scripts appended since the last run, resuming a partially applied script
+// after its completed statements, and stops at the first failure.
+func (Migration) Update(ctx context.Context, req infer.UpdateRequest[MigrationArgs, MigrationState]) (infer.UpdateResponse[MigrationState], error) {
+	in := req.Inputs
+	st := MigrationState{MigrationArgs: in, Applied: req.State.Applied}
+	if req.DryRun {
+		return infer.UpdateResponse[MigrationState]{Output: st}, nil
+	}
+	if err := verifyApplied(in.Scripts, st.Applied); err != nil {
+		return infer.UpdateResponse[MigrationState]{}, err
+	}
+	if !migrationPending(in.Scripts, st.Applied) {
+		return infer.UpdateResponse[MigrationState]{Output: st}, nil
+	}
+	applied, err := applyMigrations(ctx, &in, st.Applied)
+	st.Applied = applied
+	if err != nil {
+		if reflect.DeepEqual(applied, req.State.Applied) {
+			return infer.UpdateResponse[MigrationState]{}, err
+		}
+		return infer.UpdateResponse[MigrationState]{Output: st}, infer.ResourceInitFailedError{Reasons: []string{err.Error()}}
+	}
+	return infer.UpdateResponse[MigrationState]{Output: st}, nil
+}
+
+// Delete only removes the migration from the stack; applied scripts are not reverted.
+func (Migration) Delete(ctx context.Context, req infer.DeleteRequest[MigrationState]) (infer.DeleteResponse, error) {
+	getLogger(ctx).Info(fmt.Sprintf("Migration %s removed; its %d applied scripts are not reverted", req.ID, len(req.State.Applied)))
+	return infer.DeleteResponse{}, nil
+}
+
+// WireDependencies declares resource graph dependencies for Pulumi.
+func (Migration) WireDependencies(f infer.FieldSelector, args *MigrationArgs, state *MigrationState) {
+	f.OutputField(&state.Applied).DependsOn(f.InputField(&args.Scripts))
+}
+
+// text returns the script from its inline SQL or file.
+func (s *MigrationScript) text() (string, error) {
+	if s.File != nil && *s.File != "" {
+		b, err := os.ReadFile(*s.File)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	return ptr.Deref(s.SQL, ""), nil
+}
+
+// migrationChecksum returns the hex SHA-256 of a normalized script, so formatting and comment
+// edits do not count as changes.
+func migrationChecksum(script string) string {
+	return statementsChecksum(splitSQLStatements(script))
+}
+
+// statementsChecksum returns the hex SHA-256 of normalized statements.
+func statementsChecksum(stmts []string) string {
+	var normalized []string
+	for _, stmt := range stmts {
+		normalized = append(normalized, normalizeSQL(stmt))
+	}
+	sum := sha256.Sum256([]byte(strings.Join(normalized, ";\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// migrationPending reports whether scripts remain to run: appended ones, or the rest of a
+// partially applied one.
+func migrationPending(scripts []MigrationScript, applied []AppliedMigration) bool {
+	return len(scripts) > len(applied) || len(applied) > 0 && applied[len(applied)-1].partial()
+}
+
+// appliedChange is an applied script the inputs no longer match.
+type appliedChange struct {
+	index   int  // position of the applied script
+	removed bool // the inputs have fewer scripts than were applied
+	problem string
+}
+
+// appliedChanges checks that the scripts start with the applied ones, unchanged. For a
+// partially applied script only its completed statements must be unchanged.
+func appliedChanges(scripts []MigrationScript, applied []AppliedMigration) []appliedChange {
+	var changes []appliedChange
+	for i, a := range applied {
+		if i >= len(scripts) {
+			changes = append(changes, appliedChange{index: i, removed: true, problem: fmt.Sprintf("applied script %q was removed", a.Name)})
+			continue
+		}
+		s := scripts[i]
+		if s.Name != a.Name {
+			changes = append(changes, appliedChange{index: i, problem: fmt.Sprintf("script %d is %q but %q was applied at that position; scripts can only be appended", i, s.Name, a.Name)})
+			continue
+		}
+		text, err := s.text()
+		if err != nil {
+			changes = append(changes, appliedChange{index: i, problem: fmt.Sprintf("failed reading script %q: %v", s.Name, err)})
+			continue
+		}
+		if a.partial() {
+			stmts := splitSQLStatements(text)
+			n := *a.CompletedStatements
+			if len(stmts) < n || statementsChecksum(stmts[:n]) != a.Checksum {
+				changes = append(changes, appliedChange{index: i, problem: fmt.Sprintf("the first %d statements of script %q were edited after they ran on %s", n, s.Name, a.AppliedAt)})
+			}
+			continue
+		}
+		if sum := migrationChecksum(text); sum != a.Checksum {
+			changes = append(changes, appliedChange{index: i, problem: fmt.Sprintf("script %q was edited after it was applied on %s (checksum %s, applied %s)", s.Name, a.AppliedAt, sum[:12], a.Checksum[:min(12, len(a.Checksum))])})
+		}
+	}
+	return changes
+}
+
+// appliedChangesError describes changes to applied scripts.
+func appliedChangesError(changes []appliedChange) error {
+	problems := make([]string, len(changes))
+	for i, c := range changes {
+		problems[i] = c.problem
+	}
+	return fmt.Errorf("applied migration scripts changed: %s; restore them and add a new script instead", strings.Join(problems, "; "))
+}
+
+// verifyApplied returns an error describing every applied script the inputs no longer match.
+func verifyApplied(scripts []MigrationScript, applied []AppliedMigration) error {
+	if changes := appliedChanges(scripts, applied); len(changes) > 0 {
+		return appliedChangesError(changes)
+	}
+	return nil
+}
+
+// applyMigrations runs the scripts after the applied ones in order and returns the updated
+// applied list. A partially applied script resumes after its completed statements. It stops at
+// the first failing statement and records the statements of that script that ran.
+func applyMigrations(ctx context.Context, in *MigrationArgs, applied []AppliedMigration) ([]AppliedMigration, error) {
+	applied = slices.Clone(applied)
+	var resumed *AppliedMigration
+	if n := len(applied); n > 0 && applied[n-1].partial() {
+		resumed = &applied[n-1]
+		applied = applied[:n-1]
+	}
+	err := withScopedConn(ctx, in.Owner, in.Database, in.Namespace, in.Store, func(ctx context.Context, conn *sql.Conn) error {
+		for _, s := range in.Scripts[len(applied):] {
+			skip := 0
+			if resumed != nil {
+				skip = *resumed.CompletedStatements
+			}
+			sum, completed, err := runMigrationScript(ctx, conn, s, skip)
+			if err != nil {
+				switch {
+				case completed > skip:
+					applied = append(applied, AppliedMigration{Name: s.Name, Checksum: sum, AppliedAt: time.Now().UTC().Format(time.RFC3339), CompletedStatements: ptr.To(completed)})
+				case resumed != nil:
+					applied = append(applied, *resumed)
+				}
+				return err
+			}
+			resumed = nil
+			applied = append(applied, AppliedMigration{Name: s.Name, Checksum: sum, AppliedAt: time.Now().UTC().Format(time.RFC3339)})
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// runMigrationScript executes the statements of one script after the first skip and returns
+// the number of statements completed with their checksum, which covers the whole script when
+// it succeeds.
+func runMigrationScript(ctx context.Context, conn *sql.Conn, s MigrationScript, skip int) (string, int, error) {
+	text, err := s.text()
+	if err != nil {
+		return "", 0, fmt.Errorf("failed reading script %q: %w", s.Name, err)
+	}
+	stmts := splitSQLStatements(text)
+	if skip > 0 {
+		getLogger(ctx).Info(fmt.Sprintf("Resuming migration script %s after statement %d of %d", s.Name, skip, len(stmts)))
+	}
+	for i := skip; i < len(stmts); i++ {
+		if _, err := conn.ExecContext(ctx, stmts[i]); err != nil {
+			return statementsChecksum(stmts[:i]), i, fmt.Errorf("migration script %q failed at statement %d of %d: %w", s.Name, i+1, len(stmts), err)
+		}
+	}
+	getLogger(ctx).Info(fmt.Sprintf("Applied migration script %s", s.Name))
+	return statementsChecksum(stmts), len(stmts), nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func migrationScripts(scripts ...MigrationScript) property.Map {
+	values := make([]property.Value, len(scripts))
+	for i, s := range scripts {
+		m := map[string]property.Value{"name": property.New(s.Name)}
+		if s.SQL != nil {
+			m["sql"] = property.New(*s.SQL)
+		}
+		if s.File != nil {
+			m["file"] = property.New(*s.File)
+		}
+		values[i] = property.New(property.NewMap(m))
+	}
+	return property.NewMap(map[string]property.Value{"scripts": property.New(property.NewArray(values))})
+}
+
+func appliedNames(t *testing.T, state property.Map) []string {
+	t.Helper()
+	var names []string
+	for _, a := range state.Get("applied").AsArray().AsSlice() {
+		names = append(names, a.AsMap().Get("name").AsString())
+	}
+	return names
+}
+
+func TestMigrationLifecycle(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("Migration"), "setup")
+
+	file := filepath.Join(t.TempDir(), "003_reports.sql")
+	require.NoError(t, os.WriteFile(file, []byte("-- reporting\nCREATE DATABASE \"reports\";\nCREATE SCHEMA \"daily\" IN DATABASE \"reports\";\n"), 0o600))
+	first := []MigrationScript{
+		{Name: "001_analytics", SQL: ptr.To(`CREATE DATABASE "analytics";`)},
+		{Name: "002_staging", SQL: ptr.To(`CREATE SCHEMA "staging" IN DATABASE "analytics"`)},
+	}
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: migrationScripts(first...)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"001_analytics", "002_staging"}, appliedNames(t, created.Properties))
+	assert.NotNil(t, f.Schemas["analytics/staging"])
+
+	// Reformatting an applied script is not a change; appending one is.
+	reformatted := append([]MigrationScript{
+		{Name: "001_analytics", SQL: ptr.To("-- base database\ncreate database analytics")},
+		first[1],
+	}, MigrationScript{Name: "003_reports", File: &file})
+	diff, err := server.Diff(p.DiffRequest{Urn: urn, ID: created.ID, State: created.Properties, Inputs: migrationScripts(reformatted...)})
+	require.NoError(t, err)
+	assert.True(t, diff.HasChanges)
+	assert.Equal(t, map[string]p.PropertyDiff{"scripts": {Kind: p.Update}}, diff.DetailedDiff)
+
+	updated, err := server.Update(p.UpdateRequest{Urn: urn, ID: created.ID, State: created.Properties, Inputs: migrationScripts(reformatted...)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"001_analytics", "002_staging", "003_reports"}, appliedNames(t, updated.Properties))
+	assert.NotNil(t, f.Schemas["reports/daily"])
+
+	diff, err = server.Diff(p.DiffRequest{Urn: urn, ID: created.ID, State: updated.Properties, Inputs: migrationScripts(reformatted...)})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges)
+
+	// Editing, reordering or removing applied scripts previews as a change to those scripts and
+	// fails the update.
+	require.NoError(t, os.WriteFile(file, []byte(`CREATE DATABASE "reports2";`), 0o600))
+	diff, err = server.Diff(p.DiffRequest{Urn: urn, ID: created.ID, State: updated.Properties, Inputs: migrationScripts(reformatted...)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{"scripts[2]": {Kind: p.Update}}, diff.DetailedDiff)
+	_, err = server.Update(p.UpdateRequest{Urn: urn, ID: created.ID, State: updated.Properties, Inputs: migrationScripts(reformatted...)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `script "003_reports" was edited after it was applied`)
+	assert.Nil(t, f.Databases["reports2"])
+
+	diff, err = server.Diff(p.DiffRequest{Urn: urn, ID: created.ID, State: updated.Properties, Inputs: migrationScripts(first[1], first[0])})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"scripts[0]": {Kind: p.Update},
+		"scripts[1]": {Kind: p.Update},
+		"scripts[2]": {Kind: p.Delete},
+	}, diff.DetailedDiff)
+	_, err = server.Update(p.UpdateRequest{Urn: urn, ID: created.ID, State: updated.Properties, Inputs: migrationScripts(first[1], first[0])})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "scripts can only be appended")
+	assert.Contains(t, err.Error(), `applied script "003_reports" was removed`)
+
+	require.NoError(t, server.Delete(p.DeleteRequest{Urn: urn, ID: created.ID, Properties: updated.Properties}))
+	assert.NotNil(t, f.Databases["analytics"], "deleting a migration must not revert it")
+}
+
+func TestMigrationPartialFailure(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t, `CREATE DATABASE "taken"`)
+	urn := resource.NewURN("test", "provider", "", resourceType("Migration"), "setup")
+
+	scripts := []MigrationScript{
+		{Name: "001_ok", SQL: ptr.To(`CREATE DATABASE "ok";`)},
+		{Name: "002_conflict", SQL: ptr.To(`CREATE DATABASE "taken";`)},
+		{Name: "003_never", SQL: ptr.To(`CREATE DATABASE "never";`)},
+	}
+	resp, err := server.Create(p.CreateRequest{Urn: urn, Properties: migrationScripts(scripts...)})
+	require.Error(t, err)
+	require.NotNil(t, resp.PartialState, "a partially applied migration should be recorded")
+	assert.Contains(t, resp.PartialState.Reasons[0], `migration script "002_conflict" failed at statement 1 of 1`)
+	assert.Equal(t, []string{"001_ok"}, appliedNames(t, resp.Properties))
+	assert.Nil(t, f.Databases["never"])
+
+	// Fixing the failed script resumes after the last applied one.
+	scripts[1].SQL = ptr.To(`CREATE DATABASE "taken_v2";`)
+	updated, err := server.Update(p.UpdateRequest{Urn: urn, ID: resp.ID, State: resp.Properties, Inputs: migrationScripts(scripts...)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"001_ok", "002_conflict", "003_never"}, appliedNames(t, updated.Properties))
+	assert.NotNil(t, f.Databases["never"])
+
+	// A script failing at statement 3 of 5 records the two statements that ran.
+	multi := MigrationScript{Name: "004_multi", SQL: ptr.To(`CREATE DATABASE "m1"; CREATE DATABASE "m2"; CREATE DATABASE "taken"; CREATE DATABASE "m4"; CREATE DATABASE "m5";`)}
+	scripts = append(scripts, multi)
+	partial, err := server.Update(p.UpdateRequest{Urn: urn, ID: resp.ID, State: updated.Properties, Inputs: migrationScripts(scripts...)})
+	require.Error(t, err)
+	require.NotNil(t, partial.PartialState)
+	assert.Contains(t, partial.PartialState.Reasons[0], `migration script "004_multi" failed at statement 3 of 5`)
+	applied := partial.Properties.Get("applied").AsArray()
+	require.Equal(t, 4, applied.Len())
+	assert.Equal(t, 2.0, applied.Get(3).AsMap().Get("completedStatements").AsNumber())
+	assert.NotNil(t, f.Databases["m2"])
+	assert.Nil(t, f.Databases["m4"])
+
+	diff, err := server.Diff(p.DiffRequest{Urn: urn, ID: resp.ID, State: partial.Properties, Inputs: migrationScripts(scripts...)})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{"scripts": {Kind: p.Update}}, diff.DetailedDiff, "the rest of a partial script is pending")
+
+	// Editing a statement that already ran is rejected.
+	scripts[3].SQL = ptr.To(`CREATE DATABASE "m1_v2"; CREATE DATABASE "m2"; CREATE DATABASE "m3"; CREATE DATABASE "m4"; CREATE DATABASE "m5";`)
+	diff, err = server.Diff(p.DiffRequest{Urn: urn, ID: resp.ID, State: partial.Properties, Inputs: migrationScripts(scripts...)})
+	require.NoError(t, err)
+	assert.Equal(t, p.PropertyDiff{Kind: p.Update}, diff.DetailedDiff["scripts[3]"])
+	_, err = server.Update(p.UpdateRequest{Urn: urn, ID: resp.ID, State: partial.Properties, Inputs: migrationScripts(scripts...)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `the first 2 statements of script "004_multi" were edited`)
+
+	// Fixing the failed statement resumes after the completed ones instead of rerunning them.
+	scripts[3].SQL = ptr.To(`CREATE DATABASE "m1"; CREATE DATABASE "m2"; CREATE DATABASE "m3"; CREATE DATABASE "m4"; CREATE DATABASE "m5";`)
+	resumed, err := server.Update(p.UpdateRequest{Urn: urn, ID: resp.ID, State: partial.Properties, Inputs: migrationScripts(scripts...)})
+	require.NoError(t, err)
+	applied = resumed.Properties.Get("applied").AsArray()
+	require.Equal(t, 4, applied.Len())
+	assert.True(t, applied.Get(3).AsMap().Get("completedStatements").IsNull())
+	assert.Equal(t, migrationChecksum(*scripts[3].SQL), applied.Get(3).AsMap().Get("checksum").AsString())
+	assert.NotNil(t, f.Databases["m5"])
+
+	diff, err = server.Diff(p.DiffRequest{Urn: urn, ID: resp.ID, State: resumed.Properties, Inputs: migrationScripts(scripts...)})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges)
+}
+
+func TestMigrationCheck(t *testing.T) {
+	t.Parallel()
+	resp, err := Migration{}.Check(context.Background(), infer.CheckRequest{NewInputs: migrationScripts(
+		MigrationScript{Name: "001", SQL: ptr.To("CREATE DATABASE a")},
+		MigrationScript{Name: "001", SQL: ptr.To("-- nothing")},
+		MigrationScript{Name: "", File: ptr.To(filepath.Join(t.TempDir(), "missing.sql"))},
+		MigrationScript{Name: "004", SQL: ptr.To("x"), File: ptr.To("x.sql")},
+	)})
+	require.NoError(t, err)
+	got := map[string]string{}
+	for _, f := range resp.Failures {
+		got[f.Property] = f.Reason
+	}
+	assert.Equal(t, `duplicate script name "001"`, got["scripts[1].name"])
+	assert.Equal(t, "script has no statements", got["scripts[1].sql"])
+	assert.Equal(t, "name required", got["scripts[2].name"])
+	assert.Contains(t, got["scripts[2].file"], "cannot read file")
+	assert.Equal(t, "sql and file are mutually exclusive", got["scripts[3].sql"])
+	assert.Len(t, got, 5)
+}
+
+func FuzzSplitSQLStatements(f *testing.F) {
+	for _, seed := range []string{"SELECT 1; SELECT 2;", `CREATE STREAM "a`, "x'; y", "-- only a comment", "BEGIN APPLICATION a; INSERT INTO b SELECT 1; END APPLICATION;", `;"`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, stmt := range splitSQLStatements(s) {
+			assert.True(t, strings.HasSuffix(stmt, ";"), "statement %q of %q", stmt, s)
+			assert.NotEmpty(t, normalizeSQL(stmt), "statement %q of %q", stmt, s)
+		}
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	p "github.com/pulumi/pulumi-go-provider"
@@ -41,13 +42,16 @@ type NamespaceArgs struct {
 	Database string  `pulumi:"database"`
 	Name     string  `pulumi:"name"`
 	Owner    *string `pulumi:"owner,optional"`
+	// Terminate queries and drop relations in the namespace on delete (optional)
+	ForceDestroy *bool `pulumi:"forceDestroy,optional"`
 }
 
 // Annotate sets descriptions on NamespaceArgs fields for schema generation.
 func (a *NamespaceArgs) Annotate(an infer.Annotator) {
 	an.Describe(&a.Database, "Name of the database containing the namespace")
 	an.Describe(&a.Name, "Name of the namespace")
-	an.Describe(&a.Owner, "Optional owning role. When set, statements execute as this role during create.")
+	an.Describe(&a.Owner, "Optional owning role. When set, statements execute as this role during create; changing it transfers ownership in place.")
+	an.Describe(&a.ForceDestroy, "When true, deleting the namespace first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.")
 }
 
 // NamespaceState persists namespace data.
@@ -64,7 +68,7 @@ func (s *NamespaceState) Annotate(a infer.Annotator) {
 // Create a namespace.
 func (Namespace) Create(ctx context.Context, req infer.CreateRequest[NamespaceArgs]) (infer.CreateResponse[NamespaceState], error) {
 	in := req.Inputs
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(fmt.Sprintf("Creating namespace %s.%s", in.Database, in.Name))
 
 	if req.DryRun {
@@ -96,11 +100,11 @@ func (Namespace) Create(ctx context.Context, req infer.CreateRequest[NamespaceAr
 	owner, createdAt, err := lookupNamespace(ctx, conn, in.Database, in.Name)
 	if err != nil {
 		// best effort cleanup
-		_, _ = conn.ExecContext(ctx, fmt.Sprintf("DROP SCHEMA %s.%s;", quoteIdent(in.Database), quoteIdent(in.Name)))
+		_, _ = conn.ExecContext(ctx, fmt.Sprintf("DROP SCHEMA %s;", quoteFQN(in.Database, in.Name)))
 		return infer.CreateResponse[NamespaceState]{}, fmt.Errorf("failed to verify namespace: %w", err)
 	}
 
-	state := NamespaceState{NamespaceArgs: NamespaceArgs{Database: in.Database, Name: in.Name, Owner: &owner}, CreatedAt: createdAt.Format(time.RFC3339)}
+	state := NamespaceState{NamespaceArgs: NamespaceArgs{Database: in.Database, Name: in.Name, Owner: &owner, ForceDestroy: in.ForceDestroy}, CreatedAt: createdAt.Format(time.RFC3339)}
 	logger.Info(fmt.Sprintf("Namespace created: %s.%s", in.Database, in.Name))
 	return infer.CreateResponse[NamespaceState]{ID: fmt.Sprintf("%s/%s", in.Database, in.Name), Output: state}, nil
 }
@@ -124,6 +128,12 @@ func (Namespace) Read(ctx context.Context, req infer.ReadRequest[NamespaceArgs,
 
 	dbName := req.State.Database
 	nsName := req.State.Name
+	if dbName == "" || nsName == "" {
+		var ok bool
+		if dbName, nsName, ok = strings.Cut(req.ID, "/"); !ok {
+			return infer.ReadResponse[NamespaceArgs, NamespaceState]{}, fmt.Errorf("invalid namespace id %q, expected <database>/<name>", req.ID)
+		}
+	}
 	owner, createdAt, err := lookupNamespace(ctx, conn, dbName, nsName)
 	if err != nil {
 		var sqlErr ds.ErrSQLError
@@ -132,13 +142,52 @@ func (Namespace) Read(ctx context.Context, req infer.ReadRequest[NamespaceArgs,
 		}
 		return infer.ReadResponse[NamespaceArgs, NamespaceState]{}, err
 	}
-	state := NamespaceState{NamespaceArgs: NamespaceArgs{Database: dbName, Name: nsName, Owner: &owner}, CreatedAt: createdAt.Format(time.RFC3339)}
-	return infer.ReadResponse[NamespaceArgs, NamespaceState]{ID: fmt.Sprintf("%s/%s", dbName, nsName), Inputs: state.NamespaceArgs, State: state}, nil
+	// State reports the server-side owner so drift is visible; inputs keep the declared owner.
+	state := NamespaceState{NamespaceArgs: NamespaceArgs{Database: dbName, Name: nsName, Owner: &owner, ForceDestroy: req.State.ForceDestroy}, CreatedAt: createdAt.Format(time.RFC3339)}
+	in := req.Inputs
+	in.Database, in.Name = dbName, nsName
+	return infer.ReadResponse[NamespaceArgs, NamespaceState]{ID: fmt.Sprintf("%s/%s", dbName, nsName), Inputs: in, State: state}, nil
 }
 
-// Update not supported.
+// Update transfers ownership in place and records forceDestroy.
 func (Namespace) Update(ctx context.Context, req infer.UpdateRequest[NamespaceArgs, NamespaceState]) (infer.UpdateResponse[NamespaceState], error) {
-	return infer.UpdateResponse[NamespaceState]{}, fmt.Errorf("namespace updates not supported")
+	st := req.State
+	st.ForceDestroy = req.Inputs.ForceDestroy
+	if req.Inputs.Owner == nil || ptr.Equal(req.State.Owner, req.Inputs.Owner) {
+		return infer.UpdateResponse[NamespaceState]{Output: st}, nil
+	}
+	if req.DryRun {
+		st.Owner = req.Inputs.Owner
+		return infer.UpdateResponse[NamespaceState]{Output: st}, nil
+	}
+
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.UpdateResponse[NamespaceState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+
+	// The transfer has to be issued by the current owner.
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.UpdateResponse[NamespaceState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+
+	stmt := fmt.Sprintf("ALTER SCHEMA %s OWNER TO %s;", quoteFQN(st.Database, st.Name), quoteIdent(*req.Inputs.Owner))
+	if _, err := conn.ExecContext(ctx, stmt); err != nil {
+		return infer.UpdateResponse[NamespaceState]{}, fmt.Errorf("failed altering owner: %w", err)
+	}
+	owner, _, err := lookupNamespace(ctx, conn, st.Database, st.Name)
+	if err != nil {
+		return infer.UpdateResponse[NamespaceState]{}, err
+	}
+	st.Owner = &owner
+	getLogger(ctx).Info(fmt.Sprintf("Namespace %s.%s owner changed to %s", st.Database, st.Name, owner))
+	return infer.UpdateResponse[NamespaceState]{Output: st}, nil
 }
 
 // Delete namespace.
@@ -158,7 +207,14 @@ func (Namespace) Delete(ctx context.Context, req infer.DeleteRequest[NamespaceSt
 	}
 	defer conn.Close() //nolint:errcheck
 
-	stmt := fmt.Sprintf("DROP SCHEMA %s.%s;", quoteIdent(req.State.Database), quoteIdent(req.State.Name))
+	rels, err := listRelationPaths(ctx, conn, fmt.Sprintf("database_name = %s AND schema_name = %s", quoteString(req.State.Database), quoteString(req.State.Name)))
+	if err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("failed to list relations of namespace %s.%s: %w", req.State.Database, req.State.Name, err)
+	}
+	if err := clearDependents(ctx, conn, fmt.Sprintf("namespace %s.%s", req.State.Database, req.State.Name), rels, true, req.State.ForceDestroy); err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	stmt := fmt.Sprintf("DROP SCHEMA %s;", quoteFQN(req.State.Database, req.State.Name))
 	if _, err := conn.ExecContext(ctx, stmt); err != nil {
 		var sqlErr ds.ErrSQLError
 		if !errors.As(err, &sqlErr) || (sqlErr.SQLCode != ds.SqlStateInvalidDatabase && sqlErr.SQLCode != ds.SqlStateInvalidSchema) {
@@ -180,6 +236,9 @@ func (Namespace) Diff(ctx context.Context, req infer.DiffRequest[NamespaceArgs,
 	if (req.State.Owner == nil && req.Inputs.Owner != nil) || (req.State.Owner != nil && req.Inputs.Owner != nil && *req.State.Owner != *req.Inputs.Owner) {
 		diff["owner"] = p.PropertyDiff{Kind: p.Update}
 	}
+	if forceDestroyChanged(req.State.ForceDestroy, req.Inputs.ForceDestroy) {
+		diff["forceDestroy"] = p.PropertyDiff{Kind: p.Update}
+	}
 	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff}, nil
 }
 
@@ -189,6 +248,10 @@ func (Namespace) Check(ctx context.Context, req infer.CheckRequest) (infer.Check
 	if err != nil {
 		return infer.CheckResponse[NamespaceArgs]{}, err
 	}
+	if old, _, oerr := infer.DefaultCheck[NamespaceArgs](ctx, req.OldInputs); oerr == nil && old.Name != "" && (old.Database != args.Database || old.Name != args.Name) {
+		checkRename(ctx, old.Owner, fmt.Sprintf("namespace %s.%s", old.Database, old.Name), fmt.Sprintf("%s.%s", args.Database, args.Name),
+			fmt.Sprintf("database_name = %s AND schema_name = %s", quoteString(old.Database), quoteString(old.Name)))
+	}
 	return infer.CheckResponse[NamespaceArgs]{Inputs: args, Failures: failures}, nil
 }
 
@@ -202,7 +265,7 @@ func (Namespace) WireDependencies(f infer.FieldSelector, args *NamespaceArgs, st
 // lookupNamespace queries owner and created_at for namespace.
 func lookupNamespace(ctx context.Context, conn *sql.Conn, dbName, nsName string) (owner string, createdAt time.Time, err error) {
 	q := fmt.Sprintf(`SELECT "owner", created_at FROM deltastream.sys."schemas" WHERE database_name = %s AND name = %s;`, quoteString(dbName), quoteString(nsName))
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(q)
 	row := conn.QueryRowContext(ctx, q)
 	if err := row.Err(); err != nil {
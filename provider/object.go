@@ -28,6 +28,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	ds "github.com/deltastreaminc/go-deltastream"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // DeltaStreamObject represents a DeltaStream relation (stream, changelog, table).
@@ -47,10 +48,36 @@ type DeltaStreamObjectArgs struct {
 	Namespace string `pulumi:"namespace"`
 	// Store backing the relation.
 	Store string `pulumi:"store"`
-	// SQL DDL statement (CREATE STREAM/CHANGELOG/TABLE ...).
-	SQL string `pulumi:"sql"`
+	// SQL DDL statement (CREATE STREAM/CHANGELOG/TABLE ...). Omit when using a structured definition.
+	SQL string `pulumi:"sql,optional"`
 	// Owning role (optional) overriding provider role.
 	Owner *string `pulumi:"owner,optional"`
+	// Relation kind for structured definitions (stream|changelog|table).
+	Kind *string `pulumi:"kind,optional"`
+	// Relation name for structured definitions.
+	RelationName *string `pulumi:"relationName,optional"`
+	// Column definitions for structured definitions.
+	Columns []ObjectColumn `pulumi:"columns,optional"`
+	// Primary key columns (changelogs only).
+	PrimaryKey []string `pulumi:"primaryKey,optional"`
+	// WITH properties for structured definitions (topic, value.format, key.format, timestamp, ...).
+	With map[string]string `pulumi:"with,optional"`
+	// Values for typed {{type:name}} placeholders in sql (optional).
+	Parameters map[string]string `pulumi:"parameters,optional"`
+	// Terminate queries using the relation on delete (optional).
+	ForceDestroy *bool `pulumi:"forceDestroy,optional"`
+}
+
+// Annotate sets descriptions on DeltaStreamObjectArgs fields for schema generation.
+func (a *DeltaStreamObjectArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.SQL, "CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with kind, relationName, columns, primaryKey and with.")
+	an.Describe(&a.Kind, "Relation kind for structured definitions (stream|changelog|table)")
+	an.Describe(&a.RelationName, "Relation name for structured definitions")
+	an.Describe(&a.Columns, "Ordered column definitions for structured definitions")
+	an.Describe(&a.PrimaryKey, "Primary key columns; required for changelogs and not allowed otherwise")
+	an.Describe(&a.With, "WITH properties for structured definitions, e.g. topic, value.format, key.format, timestamp")
+	an.Describe(&a.Parameters, "Values for typed placeholders in sql: {{ident:name}}, {{fqn:name}}, {{string:name}} or {{number:name}}. Each value is quoted for its type before substitution.")
+	an.Describe(&a.ForceDestroy, "When true, deleting the relation first terminates running queries that read or write it. When false (default), delete fails and lists them.")
 }
 
 // DeltaStreamObjectState extends inputs with computed fields
@@ -73,14 +100,18 @@ type DeltaStreamObjectState struct {
 	CreatedAt string `pulumi:"createdAt"`
 	// Last update timestamp.
 	UpdatedAt string `pulumi:"updatedAt"`
+	// DDL after parameter substitution or structured rendering.
+	RenderedSQL string `pulumi:"renderedSql"`
 }
 
 // Annotate sets descriptions on DeltaStreamObjectState fields for schema generation.
 func (s *DeltaStreamObjectState) Annotate(a infer.Annotator) {
+	a.Describe(&s.Name, "Name of the relation")
 	a.Describe(&s.Type, "Type of the relation (stream|changelog|table)")
 	a.Describe(&s.State, "Provisioning state of the relation")
 	a.Describe(&s.Path, "Path of the relation as [database, namespace, name]")
 	a.Describe(&s.FQN, "Fully qualified name of the relation as database.namespace.name")
+	a.Describe(&s.RenderedSQL, "DDL statement after substituting parameters or rendering the structured definition; used for change detection")
 }
 
 // Planning output structure (subset)
@@ -111,8 +142,12 @@ func (DeltaStreamObject) Check(ctx context.Context, req infer.CheckRequest) (inf
 		return infer.CheckResponse[DeltaStreamObjectArgs]{}, err
 	}
 
-	// Require core scoping fields before attempting planning
-	if args.SQL == "" || args.Database == "" || args.Namespace == "" || args.Store == "" {
+	defFailures := validateObjectDefinition(&args)
+	failures = append(failures, defFailures...)
+
+	// Require a valid definition and core scoping fields before attempting planning
+	sqlText := objectSQL(&args)
+	if len(defFailures) > 0 || sqlText == "" || args.Database == "" || args.Namespace == "" || args.Store == "" {
 		return infer.CheckResponse[DeltaStreamObjectArgs]{Inputs: args, Failures: failures}, nil
 	}
 
@@ -136,9 +171,9 @@ func (DeltaStreamObject) Check(ctx context.Context, req infer.CheckRequest) (inf
 		return infer.CheckResponse[DeltaStreamObjectArgs]{Inputs: args, Failures: failures}, nil
 	}
 
-	kind, plan, dErr := describeStatement(ctx2, conn, args.SQL)
+	kind, plan, dErr := describeStatement(ctx2, conn, sqlText)
 	if dErr != nil {
-		failures = append(failures, p.CheckFailure{Property: "sql", Reason: fmt.Sprintf("describe failed: %v", dErr)})
+		failures = append(failures, describeCheckFailure("sql", sqlText, dErr))
 		return infer.CheckResponse[DeltaStreamObjectArgs]{Inputs: args, Failures: failures}, nil
 	}
 
@@ -161,9 +196,43 @@ func (DeltaStreamObject) Check(ctx context.Context, req infer.CheckRequest) (inf
 		failures = append(failures, p.CheckFailure{Property: "sql", Reason: fmt.Sprintf("store mismatch: statement targets %s", plan.Ddl.StoreName)})
 	}
 
+	if old, _, oerr := infer.DefaultCheck[DeltaStreamObjectArgs](ctx, req.OldInputs); oerr == nil && len(failures) == 0 {
+		newPath := []string{plan.Ddl.DbName, plan.Ddl.SchemaName, plan.Ddl.Name}
+		if oldPath := oldRelationPath(ctx2, conn, &old, sqlText); oldPath != nil && getFQN(oldPath) != getFQN(newPath) {
+			warnRenameDependents(ctx2, conn, "relation "+getFQN(oldPath), getFQN(newPath), [][]string{oldPath}, false)
+		}
+	}
+
 	return infer.CheckResponse[DeltaStreamObjectArgs]{Inputs: args, Failures: failures}, nil
 }
 
+// oldRelationPath returns the path of the relation described by the previous inputs, or nil
+// when it cannot be determined or the statement is unchanged. Planning an old SQL statement
+// switches the connection context to the old database, namespace and store.
+func oldRelationPath(ctx context.Context, conn *sql.Conn, old *DeltaStreamObjectArgs, newSQL string) []string {
+	if old.Database == "" || old.Namespace == "" {
+		return nil
+	}
+	if isStructuredObject(old) {
+		if old.RelationName == nil {
+			return nil
+		}
+		return []string{old.Database, old.Namespace, *old.RelationName}
+	}
+	oldSQL := objectSQL(old)
+	if oldSQL == "" || sqlEqual(oldSQL, newSQL) {
+		return nil
+	}
+	if err := setSQLContext(conn, old.Database, old.Namespace, old.Store); err != nil {
+		return nil
+	}
+	_, plan, err := describeStatement(ctx, conn, oldSQL)
+	if err != nil || plan.Ddl == nil {
+		return nil
+	}
+	return []string{plan.Ddl.DbName, plan.Ddl.SchemaName, plan.Ddl.Name}
+}
+
 // Diff decides replace vs update
 // Diff decides whether changes require replacement or can be updated in-place.
 func (DeltaStreamObject) Diff(ctx context.Context, req infer.DiffRequest[DeltaStreamObjectArgs, DeltaStreamObjectState]) (infer.DiffResponse, error) {
@@ -177,12 +246,22 @@ func (DeltaStreamObject) Diff(ctx context.Context, req infer.DiffRequest[DeltaSt
 	if req.State.Store != "" && req.State.Store != req.Inputs.Store {
 		diff["store"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
-	if req.State.SQL != "" && req.State.SQL != req.Inputs.SQL {
+	stateStructured := isStructuredObject(&req.State.DeltaStreamObjectArgs)
+	inputStructured := isStructuredObject(&req.Inputs)
+	switch {
+	case stateStructured != inputStructured:
+		diff["sql"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	case stateStructured:
+		diffObjectDefinition(&req.State.DeltaStreamObjectArgs, &req.Inputs, diff)
+	case req.State.SQL != "" && !sqlEqual(renderedStateSQL(req.State.RenderedSQL, req.State.SQL, req.State.Parameters), objectSQL(&req.Inputs)):
 		diff["sql"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
 	if (req.State.Owner == nil && req.Inputs.Owner != nil) || (req.State.Owner != nil && req.Inputs.Owner != nil && *req.State.Owner != *req.Inputs.Owner) {
 		diff["owner"] = p.PropertyDiff{Kind: p.Update}
 	}
+	if forceDestroyChanged(req.State.ForceDestroy, req.Inputs.ForceDestroy) {
+		diff["forceDestroy"] = p.PropertyDiff{Kind: p.Update}
+	}
 	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff, DeleteBeforeReplace: true}, nil
 }
 
@@ -190,13 +269,17 @@ func (DeltaStreamObject) Diff(ctx context.Context, req infer.DiffRequest[DeltaSt
 // Create plans and executes creation of the relation, polling until ready.
 func (DeltaStreamObject) Create(ctx context.Context, req infer.CreateRequest[DeltaStreamObjectArgs]) (infer.CreateResponse[DeltaStreamObjectState], error) {
 	in := req.Inputs
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(fmt.Sprintf("Planning object create in %s.%s store=%s", in.Database, in.Namespace, in.Store))
 
 	if req.DryRun {
 		now := time.Now().UTC().Format(time.RFC3339)
-		st := DeltaStreamObjectState{DeltaStreamObjectArgs: in, CreatedAt: now, UpdatedAt: now}
-		return infer.CreateResponse[DeltaStreamObjectState]{ID: provisionalID(in), Output: st}, nil
+		st := DeltaStreamObjectState{DeltaStreamObjectArgs: in, CreatedAt: now, UpdatedAt: now, RenderedSQL: objectSQL(&in)}
+		id := provisionalID(in)
+		if planPreviewRelation(ctx, &st) {
+			id = st.FQN
+		}
+		return infer.CreateResponse[DeltaStreamObjectState]{ID: id, Output: st}, nil
 	}
 
 	cfg := infer.GetConfig[Config](ctx)
@@ -218,7 +301,8 @@ func (DeltaStreamObject) Create(ctx context.Context, req infer.CreateRequest[Del
 		return infer.CreateResponse[DeltaStreamObjectState]{}, fmt.Errorf("failed setting sql context: %w", err)
 	}
 
-	kind, plan, err := describeStatement(ctx, conn, in.SQL)
+	sqlText := objectSQL(&in)
+	kind, plan, err := describeStatement(ctx, conn, sqlText)
 	if err != nil {
 		return infer.CreateResponse[DeltaStreamObjectState]{}, err
 	}
@@ -239,7 +323,7 @@ func (DeltaStreamObject) Create(ctx context.Context, req infer.CreateRequest[Del
 		return infer.CreateResponse[DeltaStreamObjectState]{}, err
 	}
 
-	art, err := executeCreate(ctx, conn, in.SQL)
+	art, err := executeCreate(ctx, conn, sqlText)
 	if err != nil {
 		return infer.CreateResponse[DeltaStreamObjectState]{}, fmt.Errorf("failed to create relation: %w", err)
 	}
@@ -258,7 +342,7 @@ func (DeltaStreamObject) Create(ctx context.Context, req infer.CreateRequest[Del
 	id := getFQN(pathArr)
 
 	ownerOut := row.Owner
-	state := DeltaStreamObjectState{DeltaStreamObjectArgs: in, Name: art.Name, Path: pathArr, FQN: fqn, Type: typNormalized, State: row.State, OwnerOut: &ownerOut, CreatedAt: row.CreatedAt.Format(time.RFC3339), UpdatedAt: row.UpdatedAt.Format(time.RFC3339)}
+	state := DeltaStreamObjectState{DeltaStreamObjectArgs: in, Name: art.Name, Path: pathArr, FQN: fqn, Type: typNormalized, State: row.State, OwnerOut: &ownerOut, CreatedAt: row.CreatedAt.Format(time.RFC3339), UpdatedAt: row.UpdatedAt.Format(time.RFC3339), RenderedSQL: sqlText}
 	logger.Info(fmt.Sprintf("Object created: %s (%s)", art.Name, state.Type))
 	return infer.CreateResponse[DeltaStreamObjectState]{ID: id, Output: state}, nil
 }
@@ -283,7 +367,17 @@ func (DeltaStreamObject) Read(ctx context.Context, req infer.ReadRequest[DeltaSt
 	}
 	defer conn.Close() //nolint:errcheck
 
-	row, err := lookupRelation(ctx, conn, req.State.Path)
+	st := req.State
+	if len(st.Path) != 3 {
+		// Import: only the ID (the relation FQN) is known.
+		path, perr := parseFQN(req.ID)
+		if perr != nil {
+			return infer.ReadResponse[DeltaStreamObjectArgs, DeltaStreamObjectState]{}, perr
+		}
+		st.Path = path
+		st.FQN = getFQN(path)
+	}
+	row, err := lookupRelation(ctx, conn, st.Path)
 	if err != nil {
 		var sqlErr ds.ErrSQLError
 		if errors.As(err, &sqlErr) && sqlErr.SQLCode == ds.SqlStateInvalidRelation {
@@ -294,9 +388,19 @@ func (DeltaStreamObject) Read(ctx context.Context, req infer.ReadRequest[DeltaSt
 		}
 		return infer.ReadResponse[DeltaStreamObjectArgs, DeltaStreamObjectState]{}, err
 	}
+	if st.SQL == "" && st.Kind == nil {
+		ddl, store, derr := lookupRelationDDL(ctx, conn, st.Path)
+		if derr != nil {
+			return infer.ReadResponse[DeltaStreamObjectArgs, DeltaStreamObjectState]{}, fmt.Errorf("failed to read relation definition: %w", derr)
+		}
+		st.Database, st.Namespace, st.Store, st.SQL = st.Path[0], st.Path[1], store, ddl
+		st.RenderedSQL = ddl
+	}
+	if st.RenderedSQL == "" {
+		st.RenderedSQL = objectSQL(&st.DeltaStreamObjectArgs)
+	}
 	typ := strings.ToLower(row.Type)
 	ownerOut := row.Owner
-	st := req.State
 	st.Type = typ
 	st.State = row.State
 	st.OwnerOut = &ownerOut
@@ -306,24 +410,29 @@ func (DeltaStreamObject) Read(ctx context.Context, req infer.ReadRequest[DeltaSt
 	return infer.ReadResponse[DeltaStreamObjectArgs, DeltaStreamObjectState]{ID: req.ID, Inputs: st.DeltaStreamObjectArgs, State: st}, nil
 }
 
-// Update only supports owner change (future)
-// Update changes supported mutable fields (currently only owner).
+// Update changes supported mutable fields: owner, and for structured streams and changelogs
+// appended nullable columns and mutable WITH properties.
 func (DeltaStreamObject) Update(ctx context.Context, req infer.UpdateRequest[DeltaStreamObjectArgs, DeltaStreamObjectState]) (infer.UpdateResponse[DeltaStreamObjectState], error) {
 	st := req.State
-	// Only owner changes are supported
-	if (st.Owner == nil && req.Inputs.Owner == nil) || (st.Owner != nil && req.Inputs.Owner != nil && *st.Owner == *req.Inputs.Owner) {
-		return infer.UpdateResponse[DeltaStreamObjectState]{}, nil
+	// As in Diff, an unset owner input keeps the current owner.
+	ownerChanged := req.Inputs.Owner != nil && (st.Owner == nil || *st.Owner != *req.Inputs.Owner)
+	// Structured streams and changelogs may additionally evolve in place (see diffObjectDefinition)
+	var alters []string
+	if isStructuredObject(&st.DeltaStreamObjectArgs) && isStructuredObject(&req.Inputs) && len(st.Path) == 3 {
+		alters = objectAlterStatements(&st.DeltaStreamObjectArgs, &req.Inputs, getFQN(st.Path))
+	}
+	if !ownerChanged && len(alters) == 0 {
+		st.DeltaStreamObjectArgs = req.Inputs
+		return infer.UpdateResponse[DeltaStreamObjectState]{Output: st}, nil
 	}
 	if req.DryRun {
-		st.Owner = req.Inputs.Owner
+		st.DeltaStreamObjectArgs = req.Inputs
+		st.RenderedSQL = objectSQL(&req.Inputs)
 		return infer.UpdateResponse[DeltaStreamObjectState]{Output: st}, nil
 	}
 	if len(st.Path) == 0 {
 		return infer.UpdateResponse[DeltaStreamObjectState]{}, fmt.Errorf("missing path for update")
 	}
-	if req.Inputs.Owner == nil {
-		return infer.UpdateResponse[DeltaStreamObjectState]{}, fmt.Errorf("owner update requires non-nil owner")
-	}
 	cfg := infer.GetConfig[Config](ctx)
 	db, err := openDB(ctx, &cfg)
 	if err != nil {
@@ -338,10 +447,20 @@ func (DeltaStreamObject) Update(ctx context.Context, req infer.UpdateRequest[Del
 		return infer.UpdateResponse[DeltaStreamObjectState]{}, err
 	}
 	defer conn.Close() //nolint:errcheck
-	typ := strings.ToUpper(st.Type)
-	stmt := fmt.Sprintf("ALTER %s %s OWNER TO %s;", typ, getFQN(st.Path), *req.Inputs.Owner)
-	if _, err := conn.ExecContext(ctx2, stmt); err != nil {
-		return infer.UpdateResponse[DeltaStreamObjectState]{}, fmt.Errorf("failed altering owner: %w", err)
+	for _, stmt := range alters {
+		if _, err := conn.ExecContext(ctx2, stmt); err != nil {
+			return infer.UpdateResponse[DeltaStreamObjectState]{}, fmt.Errorf("failed altering relation: %w", err)
+		}
+	}
+	if ownerChanged {
+		typ := strings.ToUpper(st.Type)
+		if err := checkSQLKeyword(typ); err != nil {
+			return infer.UpdateResponse[DeltaStreamObjectState]{}, fmt.Errorf("cannot alter owner of relation %s: %w", getFQN(st.Path), err)
+		}
+		stmt := fmt.Sprintf("ALTER %s %s OWNER TO %s;", typ, getFQN(st.Path), quoteIdent(*req.Inputs.Owner))
+		if _, err := conn.ExecContext(ctx2, stmt); err != nil {
+			return infer.UpdateResponse[DeltaStreamObjectState]{}, fmt.Errorf("failed altering owner: %w", err)
+		}
 	}
 	// Re-query to refresh owner & timestamps
 	row, err := lookupRelation(ctx2, conn, st.Path)
@@ -349,7 +468,8 @@ func (DeltaStreamObject) Update(ctx context.Context, req infer.UpdateRequest[Del
 		return infer.UpdateResponse[DeltaStreamObjectState]{}, err
 	}
 	newOwner := row.Owner
-	st.Owner = req.Inputs.Owner
+	st.DeltaStreamObjectArgs = req.Inputs
+	st.RenderedSQL = objectSQL(&req.Inputs)
 	st.OwnerOut = &newOwner
 	st.UpdatedAt = row.UpdatedAt.Format(time.RFC3339)
 	return infer.UpdateResponse[DeltaStreamObjectState]{Output: st}, nil
@@ -374,17 +494,37 @@ func (DeltaStreamObject) Delete(ctx context.Context, req infer.DeleteRequest[Del
 		return infer.DeleteResponse{}, err
 	}
 	defer conn.Close() //nolint:errcheck
-	_ = dropRelation(ctx, conn, req.State.FQN)
-	_ = waitForRelationGone(ctx, conn, req.State.Path, time.Minute)
+	if err := clearDependents(ctx, conn, "relation "+req.State.FQN, [][]string{req.State.Path}, false, req.State.ForceDestroy); err != nil {
+		return infer.DeleteResponse{}, lenientDeleteErr(ctx, &cfg, err)
+	}
+	if err := dropRelation(ctx, conn, req.State.FQN); err != nil {
+		var sqlErr ds.ErrSQLError
+		if !errors.As(err, &sqlErr) || sqlErr.SQLCode != ds.SqlStateInvalidRelation {
+			return infer.DeleteResponse{}, lenientDeleteErr(ctx, &cfg, fmt.Errorf("failed to drop relation %s: %w", req.State.FQN, err))
+		}
+	}
+	if err := waitForRelationGone(ctx, conn, req.State.Path, time.Minute); err != nil {
+		return infer.DeleteResponse{}, lenientDeleteErr(ctx, &cfg, fmt.Errorf("relation %s still exists after DROP RELATION: %w; re-run the delete once it is gone", req.State.FQN, err))
+	}
 	return infer.DeleteResponse{}, nil
 }
 
 // WireDependencies declares input-output relationships for Pulumi's graph.
 func (DeltaStreamObject) WireDependencies(f infer.FieldSelector, args *DeltaStreamObjectArgs, state *DeltaStreamObjectState) {
-	f.OutputField(&state.Name).DependsOn(f.InputField(&args.SQL))
-	f.OutputField(&state.Path).DependsOn(f.InputField(&args.SQL))
-	f.OutputField(&state.Type).DependsOn(f.InputField(&args.SQL))
-	f.OutputField(&state.State).DependsOn(f.InputField(&args.SQL))
+	f.OutputField(&state.Name).DependsOn(f.InputField(&args.SQL), f.InputField(&args.RelationName))
+	f.OutputField(&state.Path).DependsOn(f.InputField(&args.SQL), f.InputField(&args.RelationName))
+	f.OutputField(&state.Type).DependsOn(f.InputField(&args.SQL), f.InputField(&args.Kind))
+	f.OutputField(&state.State).DependsOn(f.InputField(&args.SQL), f.InputField(&args.Kind), f.InputField(&args.Columns), f.InputField(&args.With))
+	// Secret parameters or WITH values make the rendered statement secret too.
+	f.OutputField(&state.RenderedSQL).DependsOn(f.InputField(&args.SQL), f.InputField(&args.Parameters), f.InputField(&args.Kind), f.InputField(&args.RelationName),
+		f.InputField(&args.Columns), f.InputField(&args.PrimaryKey), f.InputField(&args.With))
+	// A preview create that planned the relation reports its identity as known.
+	if state.FQN != "" {
+		f.OutputField(&state.Name).AlwaysKnown()
+		f.OutputField(&state.Path).AlwaysKnown()
+		f.OutputField(&state.FQN).AlwaysKnown()
+		f.OutputField(&state.Type).AlwaysKnown()
+	}
 }
 
 func describeStatement(ctx context.Context, conn *sql.Conn, sqlText string) (kind string, plan plannerStatement, err error) {
@@ -442,14 +582,26 @@ func lookupRelation(ctx context.Context, conn *sql.Conn, path []string) (relatio
 	return r, nil
 }
 
+// lookupRelationDDL returns the CREATE statement recorded for a relation and the store backing it.
+func lookupRelationDDL(ctx context.Context, conn *sql.Conn, path []string) (ddl, store string, err error) {
+	q := fmt.Sprintf(`SELECT "dsql", store_name FROM deltastream.sys."relations" WHERE database_name = %s AND schema_name = %s AND name = %s;`, quoteString(path[0]), quoteString(path[1]), quoteString(path[2]))
+	var storeName sql.NullString
+	if err := conn.QueryRowContext(ctx, q).Scan(&ddl, &storeName); err != nil {
+		return "", "", err
+	}
+	return ddl, storeName.String, nil
+}
+
 func waitForRelationReady(ctx context.Context, conn *sql.Conn, path []string) (relationRow, error) {
 	deadline := time.Now().Add(5 * time.Minute)
 	var lastErr error
 
 	t := time.NewTicker(5 * time.Second)
 	defer t.Stop()
-	for {
-		row, err := lookupRelation(ctx, conn, path)
+	for attempt := 1; ; attempt++ {
+		pctx, span := startPollSpan(ctx, "relation ready", attempt, attribute.String("deltastream.relation", strings.Join(path, ".")))
+		row, err := lookupRelation(pctx, conn, path)
+		endPollSpan(pctx, span, row.State, err)
 		if err == nil {
 			return row, nil
 		}
@@ -478,11 +630,17 @@ func dropRelation(ctx context.Context, conn *sql.Conn, fqn string) error {
 
 func waitForRelationGone(ctx context.Context, conn *sql.Conn, path []string, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
-	for {
+	for attempt := 1; ; attempt++ {
+		pctx, span := startPollSpan(ctx, "relation gone", attempt, attribute.String("deltastream.relation", strings.Join(path, ".")))
 		q := fmt.Sprintf(`SELECT 1 FROM deltastream.sys."relations" WHERE database_name=%s AND schema_name=%s AND name=%s;`, quoteString(path[0]), quoteString(path[1]), quoteString(path[2]))
-		row := conn.QueryRowContext(ctx, q)
+		row := conn.QueryRowContext(pctx, q)
 		var one int
 		err := row.Scan(&one)
+		state, pollErr := "present", err
+		if errors.Is(err, sql.ErrNoRows) {
+			state, pollErr = "gone", nil
+		}
+		endPollSpan(pctx, span, state, pollErr)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return nil
@@ -501,6 +659,7 @@ func waitForRelationGone(ctx context.Context, conn *sql.Conn, path []string, tim
 
 func setSQLContext(conn *sql.Conn, database, schema, store string) error {
 	return conn.Raw(func(dc interface{}) error {
+		dc = rawConn(dc, "", "")
 		if c, ok := dc.(*ds.Conn); ok {
 			rsctx := c.GetContext()
 			if database != "" {
@@ -513,11 +672,61 @@ func setSQLContext(conn *sql.Conn, database, schema, store string) error {
 				rsctx.StoreName = &store
 			}
 			c.SetContext(rsctx)
+		} else if c, ok := dc.(statementContexter); ok {
+			c.setStatementContext("", "", database, schema, store)
 		}
 		return nil
 	})
 }
 
+// planPreviewRelation fills the name, path, fqn and type of a relation that has not been
+// created yet by planning its statement with DESCRIBE, so that previews can show and
+// validate downstream resources that reference it. Planning is best effort: it reports
+// false and leaves st untouched when no connection is available or the statement cannot
+// be planned yet, for example because its namespace is created in the same update.
+func planPreviewRelation(ctx context.Context, st *DeltaStreamObjectState) bool {
+	in := &st.DeltaStreamObjectArgs
+	if st.RenderedSQL == "" || in.Database == "" || in.Namespace == "" || in.Store == "" || len(validateObjectDefinition(in)) > 0 {
+		return false
+	}
+	logger := getLogger(ctx)
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		logger.Debug(fmt.Sprintf("Skipping preview planning: %v", err))
+		return false
+	}
+	defer db.Close() //nolint:errcheck
+
+	role := ptr.Deref(in.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		logger.Debug(fmt.Sprintf("Skipping preview planning: %v", err))
+		return false
+	}
+	defer conn.Close() //nolint:errcheck
+
+	if err := setSQLContext(conn, in.Database, in.Namespace, in.Store); err != nil {
+		logger.Debug(fmt.Sprintf("Skipping preview planning: %v", err))
+		return false
+	}
+	kind, plan, err := describeStatement(ctx, conn, st.RenderedSQL)
+	if err != nil {
+		logger.Debug(fmt.Sprintf("Skipping preview planning: %v", err))
+		return false
+	}
+	typ, err := normalizeKind(kind)
+	if err != nil || plan.Ddl == nil || plan.Ddl.DbName != in.Database || plan.Ddl.SchemaName != in.Namespace {
+		return false // Check reports these
+	}
+	st.Name = plan.Ddl.Name
+	st.Path = []string{plan.Ddl.DbName, plan.Ddl.SchemaName, plan.Ddl.Name}
+	st.FQN = getFQN(st.Path)
+	st.Type = typ
+	return true
+}
+
 func provisionalID(in DeltaStreamObjectArgs) string {
 	return fmt.Sprintf("%s/%s/%s", in.Database, in.Namespace, in.Store)
 }
@@ -627,5 +836,33 @@ func (GetObjects) Invoke(ctx context.Context, req infer.FunctionRequest[GetObjec
 }
 
 func getFQN(path []string) string {
-	return fmt.Sprintf(`"%s"."%s"."%s"`, path[0], path[1], path[2])
+	return quoteFQN(path[0], path[1], path[2])
+}
+
+// parseFQN splits a database.namespace.relation name into its three parts. Parts may be
+// double-quoted, in which case dots are literal and "" is an escaped quote.
+func parseFQN(fqn string) ([]string, error) {
+	var parts []string
+	var cur strings.Builder
+	quoted := false
+	for i := 0; i < len(fqn); i++ {
+		c := fqn[i]
+		switch {
+		case c == '"' && quoted && i+1 < len(fqn) && fqn[i+1] == '"':
+			cur.WriteByte('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == '.' && !quoted:
+			parts = append(parts, cur.String())
+			cur.Reset()
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	parts = append(parts, cur.String())
+	if quoted || len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid relation name %q, expected <database>.<namespace>.<name>", fqn)
+	}
+	return parts, nil
 }
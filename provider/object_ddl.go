@@ -0,0 +1,285 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+)
+
+// ObjectColumn describes a single column of a structured relation definition.
+type ObjectColumn struct {
+	// Column name.
+	Name string `pulumi:"name"`
+	// SQL data type, e.g. VARCHAR, BIGINT, TIMESTAMP_LTZ(3), STRUCT<...>.
+	Type string `pulumi:"type"`
+	// Whether the column accepts NULL values. Default: true.
+	Nullable *bool `pulumi:"nullable,optional"`
+}
+
+// Annotate sets descriptions on ObjectColumn fields for schema generation.
+func (c *ObjectColumn) Annotate(a infer.Annotator) {
+	a.Describe(&c.Name, "Name of the column")
+	a.Describe(&c.Type, "SQL data type of the column, e.g. VARCHAR, BIGINT or STRUCT<...>")
+	a.Describe(&c.Nullable, "Whether the column accepts NULL values (default true)")
+}
+
+// isStructuredObject reports whether any of the structured definition fields are set.
+func isStructuredObject(in *DeltaStreamObjectArgs) bool {
+	return in.Kind != nil || in.RelationName != nil || len(in.Columns) > 0 || len(in.PrimaryKey) > 0 || len(in.With) > 0
+}
+
+// validateObjectDefinition checks that exactly one of sql or the structured fields is
+// provided and that a structured definition is internally consistent.
+func validateObjectDefinition(in *DeltaStreamObjectArgs) []p.CheckFailure {
+	failures := []p.CheckFailure{}
+	structured := isStructuredObject(in)
+	if in.SQL != "" && structured {
+		failures = append(failures, p.CheckFailure{Property: "sql", Reason: "sql cannot be combined with kind, name, columns, primaryKey or with"})
+		return failures
+	}
+	if in.SQL != "" {
+		if _, err := renderSQLTemplate(in.SQL, in.Parameters); err != nil {
+			failures = append(failures, p.CheckFailure{Property: "parameters", Reason: err.Error()})
+		}
+		return failures
+	}
+	if len(in.Parameters) > 0 {
+		failures = append(failures, p.CheckFailure{Property: "parameters", Reason: "parameters only apply to sql"})
+	}
+	if !structured {
+		failures = append(failures, p.CheckFailure{Property: "sql", Reason: "either sql or a structured definition (kind, name, columns) is required"})
+		return failures
+	}
+	kind := strings.ToLower(ptr.Deref(in.Kind, ""))
+	switch kind {
+	case "stream", "changelog", "table":
+	case "":
+		failures = append(failures, p.CheckFailure{Property: "kind", Reason: "kind required (stream, changelog or table)"})
+	default:
+		failures = append(failures, p.CheckFailure{Property: "kind", Reason: fmt.Sprintf("invalid kind %q (expected stream, changelog or table)", *in.Kind)})
+	}
+	if ptr.Deref(in.RelationName, "") == "" {
+		failures = append(failures, p.CheckFailure{Property: "relationName", Reason: "relationName required for structured definitions"})
+	}
+	if len(in.Columns) == 0 {
+		failures = append(failures, p.CheckFailure{Property: "columns", Reason: "at least one column required"})
+	}
+	seen := map[string]bool{}
+	for i, c := range in.Columns {
+		if c.Name == "" {
+			failures = append(failures, p.CheckFailure{Property: fmt.Sprintf("columns[%d].name", i), Reason: "column name required"})
+		} else if seen[c.Name] {
+			failures = append(failures, p.CheckFailure{Property: fmt.Sprintf("columns[%d].name", i), Reason: fmt.Sprintf("duplicate column %s", c.Name)})
+		}
+		seen[c.Name] = true
+		if strings.TrimSpace(c.Type) == "" {
+			failures = append(failures, p.CheckFailure{Property: fmt.Sprintf("columns[%d].type", i), Reason: "column type required"})
+		} else if err := checkSQLType(c.Type); err != nil {
+			failures = append(failures, p.CheckFailure{Property: fmt.Sprintf("columns[%d].type", i), Reason: err.Error()})
+		}
+	}
+	if kind == "changelog" && len(in.PrimaryKey) == 0 {
+		failures = append(failures, p.CheckFailure{Property: "primaryKey", Reason: "primaryKey required for changelogs"})
+	}
+	if kind != "changelog" && len(in.PrimaryKey) > 0 {
+		failures = append(failures, p.CheckFailure{Property: "primaryKey", Reason: "primaryKey is only supported for changelogs"})
+	}
+	for i, k := range in.PrimaryKey {
+		if !seen[k] {
+			failures = append(failures, p.CheckFailure{Property: fmt.Sprintf("primaryKey[%d]", i), Reason: fmt.Sprintf("primary key column %s is not defined in columns", k)})
+		}
+	}
+	return failures
+}
+
+// objectSQL returns the DDL for the relation, rendering it from the structured
+// definition when no raw sql is provided. Parameters are substituted into raw sql.
+func objectSQL(in *DeltaStreamObjectArgs) string {
+	if in.SQL != "" || !isStructuredObject(in) {
+		return renderSQLOrRaw(in.SQL, in.Parameters)
+	}
+	return renderObjectDDL(in)
+}
+
+// renderObjectDDL renders a CREATE STREAM/CHANGELOG/TABLE statement from a structured
+// definition. Identifiers are quoted and WITH properties are emitted in sorted order so
+// the output is stable across runs.
+func renderObjectDDL(in *DeltaStreamObjectArgs) string {
+	defs := make([]string, 0, len(in.Columns)+1)
+	for _, c := range in.Columns {
+		defs = append(defs, renderObjectColumn(c))
+	}
+	if len(in.PrimaryKey) > 0 {
+		keys := make([]string, len(in.PrimaryKey))
+		for i, k := range in.PrimaryKey {
+			keys[i] = quoteIdent(k)
+		}
+		defs = append(defs, fmt.Sprintf("PRIMARY KEY(%s)", strings.Join(keys, ", ")))
+	}
+	name := quoteFQN(in.Database, in.Namespace, ptr.Deref(in.RelationName, ""))
+	stmt := fmt.Sprintf("CREATE %s %s (%s)", strings.ToUpper(ptr.Deref(in.Kind, "")), name, strings.Join(defs, ", "))
+	if len(in.With) > 0 {
+		stmt += fmt.Sprintf(" WITH (%s)", renderWithProperties(in.With))
+	}
+	return stmt + ";"
+}
+
+// renderObjectColumn renders a single column definition.
+func renderObjectColumn(c ObjectColumn) string {
+	def := fmt.Sprintf("%s %s", quoteIdent(c.Name), strings.TrimSpace(c.Type))
+	if c.Nullable != nil && !*c.Nullable {
+		def += " NOT NULL"
+	}
+	return def
+}
+
+// renderWithProperties renders a WITH property list in sorted key order.
+func renderWithProperties(props map[string]string) string {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s = %s", quoteString(k), quoteString(props[k])))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// objectColumnsEqual compares two column definitions treating a nil nullable flag as true.
+func objectColumnsEqual(a, b ObjectColumn) bool {
+	return a.Name == b.Name && strings.EqualFold(strings.TrimSpace(a.Type), strings.TrimSpace(b.Type)) && ptr.Deref(a.Nullable, true) == ptr.Deref(b.Nullable, true)
+}
+
+// immutableObjectProperties lists WITH properties that cannot be changed on an existing
+// relation; changing any of them requires replacement.
+var immutableObjectProperties = map[string]bool{
+	"topic":        true,
+	"store":        true,
+	"key.format":   true,
+	"key.type":     true,
+	"value.format": true,
+}
+
+// supportsObjectEvolution reports whether a relation of the given kind can be altered in place.
+func supportsObjectEvolution(kind string) bool {
+	switch strings.ToLower(kind) {
+	case "stream", "changelog":
+		return true
+	default:
+		return false
+	}
+}
+
+// diffObjectDefinition reports per-field differences between two structured definitions.
+// Appending nullable columns and changing mutable WITH properties of streams and
+// changelogs are in-place updates; every other change requires replacement.
+func diffObjectDefinition(old, curr *DeltaStreamObjectArgs, diff map[string]p.PropertyDiff) {
+	evolvable := supportsObjectEvolution(ptr.Deref(old.Kind, ""))
+	if !strings.EqualFold(ptr.Deref(old.Kind, ""), ptr.Deref(curr.Kind, "")) {
+		diff["kind"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if ptr.Deref(old.RelationName, "") != ptr.Deref(curr.RelationName, "") {
+		diff["relationName"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	for i := 0; i < max(len(old.Columns), len(curr.Columns)); i++ {
+		switch {
+		case i >= len(old.Columns):
+			if evolvable && ptr.Deref(curr.Columns[i].Nullable, true) {
+				diff[fmt.Sprintf("columns[%d]", i)] = p.PropertyDiff{Kind: p.Add}
+			} else {
+				diff[fmt.Sprintf("columns[%d]", i)] = p.PropertyDiff{Kind: p.AddReplace}
+			}
+		case i >= len(curr.Columns):
+			diff[fmt.Sprintf("columns[%d]", i)] = p.PropertyDiff{Kind: p.DeleteReplace}
+		case !objectColumnsEqual(old.Columns[i], curr.Columns[i]):
+			diff[fmt.Sprintf("columns[%d]", i)] = p.PropertyDiff{Kind: p.UpdateReplace}
+		}
+	}
+	if !slices.Equal(old.PrimaryKey, curr.PrimaryKey) {
+		diff["primaryKey"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	inPlace := func(key string) bool { return evolvable && !immutableObjectProperties[key] }
+	for k, v := range curr.With {
+		ov, ok := old.With[k]
+		switch {
+		case !ok && inPlace(k):
+			diff[fmt.Sprintf("with[%q]", k)] = p.PropertyDiff{Kind: p.Add}
+		case !ok:
+			diff[fmt.Sprintf("with[%q]", k)] = p.PropertyDiff{Kind: p.AddReplace}
+		case ov != v && inPlace(k):
+			diff[fmt.Sprintf("with[%q]", k)] = p.PropertyDiff{Kind: p.Update}
+		case ov != v:
+			diff[fmt.Sprintf("with[%q]", k)] = p.PropertyDiff{Kind: p.UpdateReplace}
+		}
+	}
+	for k := range old.With {
+		if _, ok := curr.With[k]; !ok {
+			if inPlace(k) {
+				diff[fmt.Sprintf("with[%q]", k)] = p.PropertyDiff{Kind: p.Delete}
+			} else {
+				diff[fmt.Sprintf("with[%q]", k)] = p.PropertyDiff{Kind: p.DeleteReplace}
+			}
+		}
+	}
+}
+
+// objectAlterStatements returns the ALTER statements that evolve the relation identified
+// by fqn from old to curr. It assumes diffObjectDefinition classified every change as
+// in-place: only appended columns and mutable WITH property changes are rendered.
+func objectAlterStatements(old, curr *DeltaStreamObjectArgs, fqn string) []string {
+	if !supportsObjectEvolution(ptr.Deref(old.Kind, "")) {
+		return nil
+	}
+	kind := strings.ToUpper(ptr.Deref(old.Kind, ""))
+	stmts := []string{}
+	for i := len(old.Columns); i < len(curr.Columns); i++ {
+		stmts = append(stmts, fmt.Sprintf("ALTER %s %s ADD COLUMN %s;", kind, fqn, renderObjectColumn(curr.Columns[i])))
+	}
+	keys := make([]string, 0, len(curr.With)+len(old.With))
+	for k := range curr.With {
+		keys = append(keys, k)
+	}
+	for k := range old.With {
+		if _, ok := curr.With[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	pairs := []string{}
+	for _, k := range keys {
+		if immutableObjectProperties[k] {
+			continue
+		}
+		v, ok := curr.With[k]
+		switch {
+		case !ok:
+			pairs = append(pairs, fmt.Sprintf("%s = NULL", quoteString(k)))
+		case old.With[k] != v:
+			pairs = append(pairs, fmt.Sprintf("%s = %s", quoteString(k), quoteString(v)))
+		}
+	}
+	if len(pairs) > 0 {
+		stmts = append(stmts, fmt.Sprintf("ALTER %s %s SET ( %s );", kind, fqn, strings.Join(pairs, ", ")))
+	}
+	return stmts
+}
@@ -0,0 +1,244 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"k8s.io/utils/ptr"
+)
+
+func structuredPageviews() DeltaStreamObjectArgs {
+	return DeltaStreamObjectArgs{
+		Database:     "db",
+		Namespace:    "public",
+		Store:        "kafka",
+		Kind:         ptr.To("changelog"),
+		RelationName: ptr.To("pageviews"),
+		Columns: []ObjectColumn{
+			{Name: "viewtime", Type: "BIGINT", Nullable: ptr.To(false)},
+			{Name: "userid", Type: "VARCHAR"},
+		},
+		PrimaryKey: []string{"userid"},
+		With: map[string]string{
+			"value.format": "json",
+			"topic":        "pageviews",
+		},
+	}
+}
+
+func TestRenderObjectDDL(t *testing.T) {
+	t.Parallel()
+
+	in := structuredPageviews()
+	got := objectSQL(&in)
+	want := `CREATE CHANGELOG "db"."public"."pageviews" ("viewtime" BIGINT NOT NULL, "userid" VARCHAR, PRIMARY KEY("userid")) WITH ('topic' = 'pageviews', 'value.format' = 'json');`
+	if got != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+
+	raw := DeltaStreamObjectArgs{SQL: "CREATE STREAM s (a INT);"}
+	if got := objectSQL(&raw); got != raw.SQL {
+		t.Fatalf("raw sql should be returned unchanged, got %q", got)
+	}
+}
+
+func TestValidateObjectDefinition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		mutate     func(*DeltaStreamObjectArgs)
+		wantFields []string
+	}{
+		{
+			name:   "valid structured changelog",
+			mutate: func(*DeltaStreamObjectArgs) {},
+		},
+		{
+			name:       "sql combined with structured fields",
+			mutate:     func(a *DeltaStreamObjectArgs) { a.SQL = "CREATE STREAM x (a INT);" },
+			wantFields: []string{"sql"},
+		},
+		{
+			name: "neither sql nor structured fields",
+			mutate: func(a *DeltaStreamObjectArgs) {
+				*a = DeltaStreamObjectArgs{Database: "db", Namespace: "public", Store: "kafka"}
+			},
+			wantFields: []string{"sql"},
+		},
+		{
+			name:       "invalid kind",
+			mutate:     func(a *DeltaStreamObjectArgs) { a.Kind = ptr.To("view"); a.PrimaryKey = nil },
+			wantFields: []string{"kind"},
+		},
+		{
+			name:       "changelog without primary key",
+			mutate:     func(a *DeltaStreamObjectArgs) { a.PrimaryKey = nil },
+			wantFields: []string{"primaryKey"},
+		},
+		{
+			name:       "stream with primary key",
+			mutate:     func(a *DeltaStreamObjectArgs) { a.Kind = ptr.To("stream") },
+			wantFields: []string{"primaryKey"},
+		},
+		{
+			name:       "primary key references unknown column",
+			mutate:     func(a *DeltaStreamObjectArgs) { a.PrimaryKey = []string{"missing"} },
+			wantFields: []string{"primaryKey[0]"},
+		},
+		{
+			name: "duplicate column and missing type",
+			mutate: func(a *DeltaStreamObjectArgs) {
+				a.Columns = append(a.Columns, ObjectColumn{Name: "userid"})
+			},
+			wantFields: []string{"columns[2].name", "columns[2].type"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			in := structuredPageviews()
+			tt.mutate(&in)
+			failures := validateObjectDefinition(&in)
+			if len(failures) != len(tt.wantFields) {
+				t.Fatalf("expected failures on %v, got %v", tt.wantFields, failures)
+			}
+			for i, f := range failures {
+				if f.Property != tt.wantFields[i] {
+					t.Errorf("failure %d: got property %q, want %q (%s)", i, f.Property, tt.wantFields[i], f.Reason)
+				}
+			}
+		})
+	}
+}
+
+func TestDiffObjectDefinition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		base   func(*DeltaStreamObjectArgs)
+		mutate func(*DeltaStreamObjectArgs)
+		want   map[string]p.DiffKind
+	}{
+		{
+			name: "column case and default nullability are equivalent",
+			mutate: func(a *DeltaStreamObjectArgs) {
+				a.Columns[1] = ObjectColumn{Name: "userid", Type: "varchar", Nullable: ptr.To(true)}
+			},
+			want: map[string]p.DiffKind{},
+		},
+		{
+			name: "appended nullable column and mutable property are in place",
+			mutate: func(a *DeltaStreamObjectArgs) {
+				a.Columns = append(a.Columns, ObjectColumn{Name: "pageid", Type: "VARCHAR"})
+				a.With["timestamp"] = "viewtime"
+			},
+			want: map[string]p.DiffKind{
+				"columns[2]":        p.Add,
+				`with["timestamp"]`: p.Add,
+			},
+		},
+		{
+			name: "appended non-nullable column replaces",
+			mutate: func(a *DeltaStreamObjectArgs) {
+				a.Columns = append(a.Columns, ObjectColumn{Name: "pageid", Type: "VARCHAR", Nullable: ptr.To(false)})
+			},
+			want: map[string]p.DiffKind{"columns[2]": p.AddReplace},
+		},
+		{
+			name: "immutable properties replace",
+			mutate: func(a *DeltaStreamObjectArgs) {
+				a.With = map[string]string{"topic": "pageviews_v2", "key.format": "json"}
+			},
+			want: map[string]p.DiffKind{
+				`with["topic"]`:        p.UpdateReplace,
+				`with["key.format"]`:   p.AddReplace,
+				`with["value.format"]`: p.DeleteReplace,
+			},
+		},
+		{
+			name: "tables are never evolved in place",
+			base: func(a *DeltaStreamObjectArgs) {
+				a.Kind = ptr.To("table")
+				a.PrimaryKey = nil
+			},
+			mutate: func(a *DeltaStreamObjectArgs) {
+				a.Columns = append(a.Columns, ObjectColumn{Name: "pageid", Type: "VARCHAR"})
+				a.With["timestamp"] = "viewtime"
+			},
+			want: map[string]p.DiffKind{
+				"columns[2]":        p.AddReplace,
+				`with["timestamp"]`: p.AddReplace,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			old := structuredPageviews()
+			curr := structuredPageviews()
+			if tt.base != nil {
+				tt.base(&old)
+				tt.base(&curr)
+			}
+			tt.mutate(&curr)
+			diff := map[string]p.PropertyDiff{}
+			diffObjectDefinition(&old, &curr, diff)
+			if len(diff) != len(tt.want) {
+				t.Fatalf("got diff %v, want keys %v", diff, tt.want)
+			}
+			for k, kind := range tt.want {
+				if diff[k].Kind != kind {
+					t.Errorf("diff[%s] = %v, want %v", k, diff[k].Kind, kind)
+				}
+			}
+		})
+	}
+}
+
+func TestObjectAlterStatements(t *testing.T) {
+	t.Parallel()
+
+	old := structuredPageviews()
+	old.With["timestamp"] = "viewtime"
+	curr := structuredPageviews()
+	curr.Columns = append(curr.Columns, ObjectColumn{Name: "pageid", Type: "VARCHAR"})
+	curr.With["value.skip.header"] = "true"
+
+	got := objectAlterStatements(&old, &curr, `"db"."public"."pageviews"`)
+	want := []string{
+		`ALTER CHANGELOG "db"."public"."pageviews" ADD COLUMN "pageid" VARCHAR;`,
+		`ALTER CHANGELOG "db"."public"."pageviews" SET ( 'timestamp' = NULL, 'value.skip.header' = 'true' );`,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("statement %d:\ngot  %s\nwant %s", i, got[i], want[i])
+		}
+	}
+
+	if got := objectAlterStatements(&old, &old, `"db"."public"."pageviews"`); len(got) != 0 {
+		t.Errorf("expected no statements for an unchanged definition, got %v", got)
+	}
+}
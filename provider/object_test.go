@@ -0,0 +1,83 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestParseFQN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    []string
+		wantErr bool
+	}{
+		{name: "quoted", in: `"db"."ns"."orders"`, want: []string{"db", "ns", "orders"}},
+		{name: "unquoted", in: "db.ns.orders", want: []string{"db", "ns", "orders"}},
+		{name: "dot and escaped quote inside quotes", in: `"my.db"."ns"."say ""hi"""`, want: []string{"my.db", "ns", `say "hi"`}},
+		{name: "too few parts", in: `"db"."orders"`, wantErr: true},
+		{name: "empty part", in: `"db".."orders"`, wantErr: true},
+		{name: "unterminated quote", in: `"db"."ns"."orders`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseFQN(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOldRelationPath(t *testing.T) {
+	t.Parallel()
+
+	const stmt = `CREATE STREAM pageviews (viewtime BIGINT) WITH ('topic'='pageviews');`
+	tests := []struct {
+		name string
+		old  DeltaStreamObjectArgs
+		want []string
+	}{
+		{name: "no previous inputs", old: DeltaStreamObjectArgs{}, want: nil},
+		{name: "structured", old: DeltaStreamObjectArgs{Database: "db", Namespace: "ns", Kind: ptr.To("stream"), RelationName: ptr.To("pageviews")}, want: []string{"db", "ns", "pageviews"}},
+		{name: "structured without name", old: DeltaStreamObjectArgs{Database: "db", Namespace: "ns", Kind: ptr.To("stream")}, want: nil},
+		{name: "unchanged sql is not planned again", old: DeltaStreamObjectArgs{Database: "db", Namespace: "ns", SQL: "  " + stmt}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := oldRelationPath(context.Background(), nil, &tt.old, stmt); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// pipelineSourcePlaceholder is the placeholder the transformation uses for the source relation.
+const pipelineSourcePlaceholder = "{{fqn:source}}"
+
+// Pipeline component wiring a source relation, a sink relation and the query between them.
+type Pipeline struct {
+	pulumi.ResourceState
+
+	SourceFqn pulumi.StringOutput `pulumi:"sourceFqn"`
+	SinkFqn   pulumi.StringOutput `pulumi:"sinkFqn"`
+	QueryID   pulumi.StringOutput `pulumi:"queryId"`
+	Health    pulumi.StringOutput `pulumi:"health"`
+}
+
+// Annotate sets descriptions on Pipeline and its outputs for schema generation.
+func (c *Pipeline) Annotate(a infer.Annotator) {
+	a.Describe(c, "Pipeline component creating a source relation, a sink relation and the INSERT INTO query between them, with fully qualified names and dependencies wired automatically.")
+	a.Describe(&c.SourceFqn, "Fully qualified name of the source relation")
+	a.Describe(&c.SinkFqn, "Fully qualified name of the sink relation")
+	a.Describe(&c.QueryID, "ID of the query moving data from source to sink")
+	a.Describe(&c.Health, "healthy when the query is running, unhealthy when it errored, and pending while it is starting or stopped")
+}
+
+// PipelineRelation defines a relation the pipeline creates, as a DDL statement or a
+// structured definition, mirroring DeltaStreamObject.
+type PipelineRelation struct {
+	SQL          *string           `pulumi:"sql,optional"`
+	Kind         *string           `pulumi:"kind,optional"`
+	RelationName *string           `pulumi:"relationName,optional"`
+	Columns      []ObjectColumn    `pulumi:"columns,optional"`
+	PrimaryKey   []string          `pulumi:"primaryKey,optional"`
+	With         map[string]string `pulumi:"with,optional"`
+}
+
+// Annotate sets descriptions on PipelineRelation fields for schema generation.
+func (a *PipelineRelation) Annotate(an infer.Annotator) {
+	an.Describe(&a.SQL, "CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with the structured fields.")
+	an.Describe(&a.Kind, "Relation kind for structured definitions (stream|changelog|table)")
+	an.Describe(&a.RelationName, "Relation name for structured definitions")
+	an.Describe(&a.Columns, "Ordered column definitions for structured definitions")
+	an.Describe(&a.PrimaryKey, "Primary key columns; required for changelogs and not allowed otherwise")
+	an.Describe(&a.With, "WITH properties for structured definitions, e.g. topic, value.format, snowflake.db.name")
+}
+
+// PipelineArgs defines the stores, relations and transformation of a pipeline.
+type PipelineArgs struct {
+	Database    pulumi.StringInput    `pulumi:"database"`
+	Namespace   pulumi.StringPtrInput `pulumi:"namespace,optional"`
+	SourceStore pulumi.StringInput    `pulumi:"sourceStore"`
+	Source      PipelineRelation      `pulumi:"source"`
+	SQL         string                `pulumi:"sql"`
+	SinkStore   pulumi.StringInput    `pulumi:"sinkStore"`
+	Sink        PipelineRelation      `pulumi:"sink"`
+	ComputePool pulumi.StringPtrInput `pulumi:"computePool,optional"`
+	Owner       pulumi.StringPtrInput `pulumi:"owner,optional"`
+}
+
+// Annotate sets descriptions on PipelineArgs fields for schema generation.
+func (a *PipelineArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Database, "Database holding the source and sink relations")
+	an.Describe(&a.Namespace, "Namespace holding the source and sink relations (default public)")
+	an.Describe(&a.SourceStore, "Store the source relation reads from, e.g. the name output of a Kafka Store")
+	an.Describe(&a.Source, "Source relation definition")
+	an.Describe(&a.SQL, "Transformation query, e.g. SELECT ... FROM {{fqn:source}}. The pipeline prefixes it with INSERT INTO the sink and substitutes the source name.")
+	an.Describe(&a.SinkStore, "Store the sink relation writes to, e.g. the name output of a Snowflake Store")
+	an.Describe(&a.Sink, "Sink relation definition")
+	an.Describe(&a.ComputePool, "Optional compute pool the query runs on")
+	an.Describe(&a.Owner, "Optional role owning the relations and the query")
+}
+
+// pipelineRelation captures the outputs of a DeltaStreamObject child.
+type pipelineRelation struct {
+	pulumi.CustomResourceState
+
+	FQN pulumi.StringOutput `pulumi:"fqn"`
+}
+
+// pipelineQuery captures the outputs of a Query child.
+type pipelineQuery struct {
+	pulumi.CustomResourceState
+
+	QueryID pulumi.StringOutput `pulumi:"queryId"`
+	State   pulumi.StringOutput `pulumi:"state"`
+}
+
+// NewPipeline registers a Pipeline and its source relation, sink relation and query.
+func NewPipeline(ctx *pulumi.Context, name string, args PipelineArgs, opts ...pulumi.ResourceOption) (*Pipeline, error) {
+	if err := validatePipelineArgs(&args); err != nil {
+		return nil, err
+	}
+	comp := &Pipeline{}
+	if err := ctx.RegisterComponentResource(p.GetTypeToken(ctx), name, comp, opts...); err != nil {
+		return nil, err
+	}
+	namespace := pulumi.String("public").ToStringOutput()
+	if args.Namespace != nil {
+		namespace = args.Namespace.ToStringPtrOutput().Elem()
+	}
+	relation := func(suffix string, store pulumi.StringInput, def PipelineRelation) (*pipelineRelation, error) {
+		props := pipelineRelationInputs(def)
+		props["database"] = args.Database
+		props["namespace"] = namespace
+		props["store"] = store
+		if args.Owner != nil {
+			props["owner"] = args.Owner
+		}
+		rel := &pipelineRelation{}
+		err := ctx.RegisterResource(resourceToken("DeltaStreamObject"), name+"-"+suffix, props, rel, pulumi.Parent(comp))
+		return rel, err
+	}
+	source, err := relation("source", args.SourceStore, args.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register source relation: %w", err)
+	}
+	sink, err := relation("sink", args.SinkStore, args.Sink)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register sink relation: %w", err)
+	}
+
+	queryProps := pulumi.Map{
+		"sourceRelationFqns": pulumi.StringArray{source.FQN},
+		"sinkRelationFqn":    sink.FQN,
+		"sql":                pulumi.String("INSERT INTO {{fqn:sink}} " + strings.TrimSpace(args.SQL)),
+		"parameters":         pulumi.StringMap{"source": source.FQN, "sink": sink.FQN},
+	}
+	if args.ComputePool != nil {
+		queryProps["computePool"] = args.ComputePool
+	}
+	if args.Owner != nil {
+		queryProps["owner"] = args.Owner
+	}
+	query := &pipelineQuery{}
+	if err := ctx.RegisterResource(resourceToken("Query"), name+"-query", queryProps, query, pulumi.Parent(comp)); err != nil {
+		return nil, fmt.Errorf("failed to register query: %w", err)
+	}
+
+	comp.SourceFqn = source.FQN
+	comp.SinkFqn = sink.FQN
+	comp.QueryID = query.QueryID
+	comp.Health = query.State.ApplyT(pipelineHealth).(pulumi.StringOutput)
+	return comp, nil
+}
+
+// validatePipelineArgs checks the parts of a pipeline that must be known before its children
+// are registered.
+func validatePipelineArgs(args *PipelineArgs) error {
+	var problems []string
+	if args.Database == nil {
+		problems = append(problems, "database required")
+	}
+	if args.SourceStore == nil {
+		problems = append(problems, "sourceStore required")
+	}
+	if args.SinkStore == nil {
+		problems = append(problems, "sinkStore required")
+	}
+	if !strings.Contains(args.SQL, pipelineSourcePlaceholder) {
+		problems = append(problems, fmt.Sprintf("sql must reference the source relation as %s", pipelineSourcePlaceholder))
+	}
+	if strings.HasPrefix(normalizeSQL(args.SQL), "INSERT INTO") {
+		problems = append(problems, "sql must be the SELECT only; the pipeline adds INSERT INTO the sink")
+	}
+	for prop, def := range map[string]PipelineRelation{"source": args.Source, "sink": args.Sink} {
+		if (def.SQL == nil) == (def.RelationName == nil) {
+			problems = append(problems, fmt.Sprintf("%s needs exactly one of sql or a structured definition with relationName", prop))
+		}
+	}
+	if len(problems) > 0 {
+		slices.Sort(problems)
+		return fmt.Errorf("invalid pipeline: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// pipelineRelationInputs converts a relation definition to DeltaStreamObject inputs.
+func pipelineRelationInputs(def PipelineRelation) pulumi.Map {
+	props := pulumi.Map{}
+	if def.SQL != nil {
+		props["sql"] = pulumi.String(*def.SQL)
+	}
+	if def.Kind != nil {
+		props["kind"] = pulumi.String(*def.Kind)
+	}
+	if def.RelationName != nil {
+		props["relationName"] = pulumi.String(*def.RelationName)
+	}
+	if len(def.Columns) > 0 {
+		cols := pulumi.Array{}
+		for _, c := range def.Columns {
+			col := pulumi.Map{"name": pulumi.String(c.Name), "type": pulumi.String(c.Type)}
+			if c.Nullable != nil {
+				col["nullable"] = pulumi.Bool(*c.Nullable)
+			}
+			cols = append(cols, col)
+		}
+		props["columns"] = cols
+	}
+	if len(def.PrimaryKey) > 0 {
+		props["primaryKey"] = pulumi.ToStringArray(def.PrimaryKey)
+	}
+	if len(def.With) > 0 {
+		props["with"] = pulumi.ToStringMap(def.With)
+	}
+	return props
+}
+
+// pipelineHealth maps the query state to the pipeline health output.
+func pipelineHealth(state string) string {
+	switch strings.ToLower(state) {
+	case "running":
+		return "healthy"
+	case "errored":
+		return "unhealthy"
+	default:
+		return "pending"
+	}
+}
+
+// resourceToken returns the type token of one of this provider's resources.
+func resourceToken(typ string) string {
+	return Name + ":index:" + typ
+}
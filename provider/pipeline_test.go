@@ -0,0 +1,144 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/blang/semver"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pipelineMocks answers child registrations the way the provider would, recording their inputs.
+type pipelineMocks struct {
+	mu       sync.Mutex
+	children map[string]integration.MockResourceArgs
+}
+
+func (m *pipelineMocks) newResource(args integration.MockResourceArgs) (string, property.Map, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.children[args.Name] = args
+	out := args.Inputs.AsMap()
+	switch args.TypeToken {
+	case resourceType("DeltaStreamObject"):
+		out["fqn"] = property.New(getFQN([]string{
+			args.Inputs.Get("database").AsString(),
+			args.Inputs.Get("namespace").AsString(),
+			args.Inputs.Get("relationName").AsString(),
+		}))
+	case resourceType("Query"):
+		out["queryId"] = property.New("00000000-0000-4000-8000-000000000001")
+		out["state"] = property.New("running")
+	}
+	return args.Name + "-id", property.NewMap(out), nil
+}
+
+func pipelineRelationInput(kind, name string, with map[string]string) property.Value {
+	return property.New(property.NewMap(map[string]property.Value{
+		"kind":         property.New(kind),
+		"relationName": property.New(name),
+		"columns": property.New(property.NewArray([]property.Value{
+			property.New(property.NewMap(map[string]property.Value{
+				"name": property.New("userid"),
+				"type": property.New("VARCHAR"),
+			})),
+		})),
+		"with": property.New(strMap(with)),
+	}))
+}
+
+func TestPipelineConstruct(t *testing.T) {
+	t.Parallel()
+	mocks := &pipelineMocks{children: map[string]integration.MockResourceArgs{}}
+	server, err := integration.NewServer(context.Background(), Name, semver.MustParse("1.0.0"),
+		integration.WithProvider(Provider()),
+		integration.WithMocks(&integration.MockResourceMonitor{NewResourceF: mocks.newResource}),
+	)
+	require.NoError(t, err)
+
+	resp, err := server.Construct(p.ConstructRequest{
+		Urn: resource.NewURN("test", "provider", "", resourceType("Pipeline"), "clicks"),
+		Inputs: property.NewMap(map[string]property.Value{
+			"database":    property.New("analytics"),
+			"sourceStore": property.New("kafka"),
+			"source":      pipelineRelationInput("stream", "pageviews", map[string]string{"topic": "pageviews", "value.format": "json"}),
+			"sql":         property.New("SELECT userid FROM {{fqn:source}}"),
+			"sinkStore":   property.New("snowflake"),
+			"sink":        pipelineRelationInput("table", "pageviews_sf", map[string]string{"snowflake.db.name": "DB", "snowflake.schema.name": "PUBLIC"}),
+			"computePool": property.New("small"),
+		}),
+	})
+	require.NoError(t, err)
+
+	sourceFqn := `"analytics"."public"."pageviews"`
+	sinkFqn := `"analytics"."public"."pageviews_sf"`
+	assert.Equal(t, sourceFqn, resp.State.Get("sourceFqn").AsString())
+	assert.Equal(t, sinkFqn, resp.State.Get("sinkFqn").AsString())
+	assert.Equal(t, "healthy", resp.State.Get("health").AsString())
+	assert.Equal(t, "00000000-0000-4000-8000-000000000001", resp.State.Get("queryId").AsString())
+
+	mocks.mu.Lock()
+	defer mocks.mu.Unlock()
+	source := mocks.children["clicks-source"]
+	assert.Equal(t, resourceType("DeltaStreamObject"), source.TypeToken)
+	assert.Equal(t, "kafka", source.Inputs.Get("store").AsString())
+	assert.Equal(t, "public", source.Inputs.Get("namespace").AsString())
+	assert.Equal(t, "snowflake", mocks.children["clicks-sink"].Inputs.Get("store").AsString())
+
+	query := mocks.children["clicks-query"].Inputs
+	assert.Equal(t, "INSERT INTO {{fqn:sink}} SELECT userid FROM {{fqn:source}}", query.Get("sql").AsString())
+	assert.Equal(t, sinkFqn, query.Get("sinkRelationFqn").AsString())
+	require.Len(t, query.Get("sourceRelationFqns").AsArray().AsSlice(), 1)
+	assert.Equal(t, sourceFqn, query.Get("sourceRelationFqns").AsArray().AsSlice()[0].AsString())
+	params := query.Get("parameters").AsMap()
+	assert.Equal(t, sourceFqn, params.Get("source").AsString())
+	assert.Equal(t, sinkFqn, params.Get("sink").AsString())
+	assert.Equal(t, "small", query.Get("computePool").AsString())
+
+	rendered, err := renderSQLTemplate(query.Get("sql").AsString(), map[string]string{"source": sourceFqn, "sink": sinkFqn})
+	require.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "analytics"."public"."pageviews_sf" SELECT userid FROM "analytics"."public"."pageviews"`, rendered)
+}
+
+func TestValidatePipelineArgs(t *testing.T) {
+	t.Parallel()
+	err := validatePipelineArgs(&PipelineArgs{
+		SQL:    "INSERT INTO x SELECT * FROM y",
+		Source: PipelineRelation{},
+	})
+	require.Error(t, err)
+	for _, want := range []string{
+		"database required",
+		"sourceStore required",
+		"sinkStore required",
+		"sql must reference the source relation as {{fqn:source}}",
+		"sql must be the SELECT only",
+		"source needs exactly one of sql or a structured definition with relationName",
+		"sink needs exactly one of sql or a structured definition with relationName",
+	} {
+		assert.Contains(t, err.Error(), want)
+	}
+	for _, tt := range []struct{ state, want string }{{"running", "healthy"}, {"errored", "unhealthy"}, {"starting", "pending"}, {"", "pending"}} {
+		assert.Equal(t, tt.want, pipelineHealth(tt.state), tt.state)
+	}
+}
@@ -41,9 +41,20 @@ func Provider() p.Provider {
 		infer.Resource(Database{}),
 		infer.Resource(Namespace{}),
 		infer.Resource(Store{}),
+		infer.Resource(Entity{}),
+		infer.Resource(DescriptorSource{}),
+		infer.Resource(FunctionSource{}),
+		infer.Resource(Function{}),
+		infer.Resource(ComputePool{}),
+		infer.Resource(Secret{}),
 		infer.Resource(DeltaStreamObject{}),
 		infer.Resource(Query{}),
 		infer.Resource(Application{}),
+		infer.Resource(Statement{}),
+		infer.Resource(Migration{}),
+	)
+	b = b.WithComponents(
+		infer.ComponentF(NewPipeline),
 	)
 	b = b.WithFunctions(
 		infer.Function(GetDatabase{}),
@@ -52,6 +63,8 @@ func Provider() p.Provider {
 		infer.Function(GetNamespaces{}),
 		infer.Function(GetStore{}),
 		infer.Function(GetStores{}),
+		infer.Function(GetEntity{}),
+		infer.Function(GetEntities{}),
 		infer.Function(GetObject{}),
 		infer.Function(GetObjects{}),
 	)
@@ -61,13 +74,13 @@ func Provider() p.Provider {
 	if err != nil {
 		panic(fmt.Errorf("unable to build provider: %w", err))
 	}
-	return prov
+	return traceRequests(redactSecrets(auditRequests(prov)))
 }
 
 // Config defines provider-level configuration
 type Config struct {
 	// API key for authentication (env: DELTASTREAM_API_KEY)
-	APIKey *string `pulumi:"apiKey,optional"`
+	APIKey *string `pulumi:"apiKey,optional" provider:"secret"`
 	// Server base URL, e.g. https://api.deltastream.io/v2 (env: DELTASTREAM_SERVER)
 	Server *string `pulumi:"server"`
 	// Skip TLS certificate verification (env: DELTASTREAM_INSECURE_SKIP_VERIFY)
@@ -78,4 +91,8 @@ type Config struct {
 	Role *string `pulumi:"role,optional"`
 	// Optional session ID (env: DELTASTREAM_SESSION_ID)
 	SessionID *string `pulumi:"sessionId,optional"`
+	// Log delete failures instead of returning them, dropping the resource from state (optional)
+	LenientDelete *bool `pulumi:"lenientDelete,optional"`
+	// Append every statement sent to DeltaStream to this file as JSON lines (env: DELTASTREAM_AUDIT_LOG_PATH)
+	AuditLogPath *string `pulumi:"auditLogPath,optional"`
 }
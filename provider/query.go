@@ -30,6 +30,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	ds "github.com/deltastreaminc/go-deltastream"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Query resource implements continuous INSERT INTO ... SELECT ... queries.
@@ -49,6 +50,10 @@ type QueryArgs struct {
 	SinkRelationFqn    string   `pulumi:"sinkRelationFqn"`
 	SQL                string   `pulumi:"sql"`
 	Owner              *string  `pulumi:"owner,optional"`
+	// Compute pool the query runs on; applied when it starts (optional)
+	ComputePool *string `pulumi:"computePool,optional"`
+	// Values for typed {{type:name}} placeholders in SQL (optional)
+	Parameters map[string]string `pulumi:"parameters,optional"`
 }
 
 // QueryState captures runtime attributes of a continuous query after creation.
@@ -62,11 +67,13 @@ type QueryState struct {
 	CreatedAt    string  `pulumi:"createdAt"`
 	UpdatedAt    string  `pulumi:"updatedAt"`
 	OwnerOut     *string `pulumi:"owner"`
+	RenderedSQL  string  `pulumi:"renderedSql"`
 }
 
 // Annotate sets descriptions on QueryState fields for schema generation.
 func (s *QueryState) Annotate(a infer.Annotator) {
 	a.Describe(&s.State, "Lifecycle state of the query (starting|running|terminate_requested|terminated|errored)")
+	a.Describe(&s.RenderedSQL, "SQL statement after substituting parameters; used for change detection")
 	a.Describe(&s.QueryID, "System-generated query identifier")
 }
 
@@ -105,13 +112,20 @@ func (Query) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResp
 		return infer.CheckResponse[QueryArgs]{Inputs: args, Failures: failures}, nil
 	}
 
+	sqlText, rerr := renderSQLTemplate(args.SQL, args.Parameters)
+	if rerr != nil {
+		failures = append(failures, p.CheckFailure{Property: "parameters", Reason: rerr.Error()})
+		return infer.CheckResponse[QueryArgs]{Inputs: args, Failures: failures}, nil
+	}
+
 	// Skip DESCRIBE when all relevant inputs (sql, sink, sources) are unchanged from the
 	// prior deploy. This prevents re-validating stale RESUME FROM QUERY ID references that
 	// may have been garbage-collected while the query is still running correctly.
 	// We decode OldInputs to include sinkRelationFqn and sourceRelationFqns in the
-	// comparison so that user changes to those fields always trigger re-validation.
+	// comparison so that user changes to those fields always trigger re-validation. SQL is
+	// compared after normalizeSQL so formatting and comment edits do not re-validate either.
 	if oldArgs, _, oerr := infer.DefaultCheck[QueryArgs](ctx, req.OldInputs); oerr == nil &&
-		oldArgs.SQL == args.SQL &&
+		sqlEqual(renderSQLOrRaw(oldArgs.SQL, oldArgs.Parameters), renderSQLOrRaw(args.SQL, args.Parameters)) &&
 		oldArgs.SinkRelationFqn == args.SinkRelationFqn &&
 		stringSlicesEqual(oldArgs.SourceRelationFqns, args.SourceRelationFqns) {
 		return infer.CheckResponse[QueryArgs]{Inputs: args, Failures: failures}, nil
@@ -131,12 +145,18 @@ func (Query) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResp
 	}
 	defer conn.Close() //nolint:errcheck
 
-	kind, plan, derr := describeQuery(ctx2, conn, args.SQL)
+	kind, plan, derr := describeQuery(ctx2, conn, sqlText)
 	if derr != nil {
-		failures = append(failures, p.CheckFailure{Property: "sql", Reason: fmt.Sprintf("describe failed: %v", derr)})
+		// A declared relation created in the same update only exists in the preview; Create
+		// plans the statement again once it does.
+		if fqn, ok := pendingRelation(ctx2, conn, derr, append([]string{args.SinkRelationFqn}, args.SourceRelationFqns...)); ok {
+			getLogger(ctx).Warning(fmt.Sprintf("Skipping query validation until relation %s exists: %v", fqn, derr))
+			return infer.CheckResponse[QueryArgs]{Inputs: args, Failures: failures}, nil
+		}
+		failures = append(failures, describeCheckFailure("sql", sqlText, derr))
 		return infer.CheckResponse[QueryArgs]{Inputs: args, Failures: failures}, nil
 	}
-	if kind != "INSERT_INTO" && !insertIntoRe.MatchString(args.SQL) { // fallback regex
+	if kind != "INSERT_INTO" && !insertIntoRe.MatchString(sqlText) { // fallback regex
 		failures = append(failures, p.CheckFailure{Property: "sql", Reason: "only INSERT INTO ... SELECT ... queries are supported"})
 	}
 	if plan.Sink == nil {
@@ -161,9 +181,11 @@ func (Query) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResp
 }
 
 // Diff computes property differences; all fields trigger replacement except owner (in-place update).
+// SQL is compared after normalizeSQL, so formatting, comment and keyword case edits are not changes.
 func (Query) Diff(ctx context.Context, req infer.DiffRequest[QueryArgs, QueryState]) (infer.DiffResponse, error) {
 	diff := map[string]p.PropertyDiff{}
-	if req.State.SQL != "" && req.State.SQL != req.Inputs.SQL {
+	stateSQL := renderedStateSQL(req.State.RenderedSQL, req.State.SQL, req.State.Parameters)
+	if req.State.SQL != "" && !sqlEqual(stateSQL, renderSQLOrRaw(req.Inputs.SQL, req.Inputs.Parameters)) {
 		diff["sql"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
 	if req.State.SinkRelationFqn != "" && req.State.SinkRelationFqn != req.Inputs.SinkRelationFqn {
@@ -172,6 +194,9 @@ func (Query) Diff(ctx context.Context, req infer.DiffRequest[QueryArgs, QuerySta
 	if len(req.State.SourceRelationFqns) > 0 && !stringSlicesEqual(req.State.SourceRelationFqns, req.Inputs.SourceRelationFqns) {
 		diff["sourceRelationFqns"] = p.PropertyDiff{Kind: p.UpdateReplace}
 	}
+	if ptr.Deref(req.State.ComputePool, "") != ptr.Deref(req.Inputs.ComputePool, "") {
+		diff["computePool"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
 	if (req.State.Owner == nil && req.Inputs.Owner != nil) || (req.State.Owner != nil && req.Inputs.Owner != nil && *req.State.Owner != *req.Inputs.Owner) {
 		diff["owner"] = p.PropertyDiff{Kind: p.Update}
 	}
@@ -203,10 +228,10 @@ func stringSlicesEqual(a, b []string) bool {
 // the running state before returning. On preview it returns a provisional ID.
 func (Query) Create(ctx context.Context, req infer.CreateRequest[QueryArgs]) (infer.CreateResponse[QueryState], error) {
 	in := req.Inputs
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	if req.DryRun {
 		now := time.Now().UTC().Format(time.RFC3339)
-		st := QueryState{QueryArgs: in, CreatedAt: now, UpdatedAt: now, State: "starting"}
+		st := QueryState{QueryArgs: in, CreatedAt: now, UpdatedAt: now, State: "starting", RenderedSQL: renderSQLOrRaw(in.SQL, in.Parameters)}
 		return infer.CreateResponse[QueryState]{ID: provisionalQueryID(&in), Output: st}, nil
 	}
 	cfg := infer.GetConfig[Config](ctx)
@@ -223,11 +248,15 @@ func (Query) Create(ctx context.Context, req infer.CreateRequest[QueryArgs]) (in
 	}
 	defer conn.Close() //nolint:errcheck
 
-	kind, plan, derr := describeQuery(ctx2, conn, in.SQL)
+	sqlText, err := renderSQLTemplate(in.SQL, in.Parameters)
+	if err != nil {
+		return infer.CreateResponse[QueryState]{}, fmt.Errorf("invalid parameters: %w", err)
+	}
+	kind, plan, derr := describeQuery(ctx2, conn, sqlText)
 	if derr != nil {
 		return infer.CreateResponse[QueryState]{}, derr
 	}
-	if kind != "INSERT_INTO" && !insertIntoRe.MatchString(in.SQL) {
+	if kind != "INSERT_INTO" && !insertIntoRe.MatchString(sqlText) {
 		return infer.CreateResponse[QueryState]{}, fmt.Errorf("unsupported query kind: %s", kind)
 	}
 	if plan.Sink == nil {
@@ -248,7 +277,12 @@ func (Query) Create(ctx context.Context, req infer.CreateRequest[QueryArgs]) (in
 			return infer.CreateResponse[QueryState]{}, fmt.Errorf("source relation %s not declared", src.Fqn)
 		}
 	}
-	art, aerr := executeQuerySQL(ctx2, conn, in.SQL)
+	if in.ComputePool != nil {
+		if err := setComputePool(ctx2, conn, *in.ComputePool); err != nil {
+			return infer.CreateResponse[QueryState]{}, err
+		}
+	}
+	art, aerr := executeQuerySQL(ctx2, conn, sqlText)
 	if aerr != nil {
 		return infer.CreateResponse[QueryState]{}, aerr
 	}
@@ -264,7 +298,7 @@ func (Query) Create(ctx context.Context, req infer.CreateRequest[QueryArgs]) (in
 		return infer.CreateResponse[QueryState]{}, err
 	}
 	ownerOut := qrow.Owner
-	st := QueryState{QueryArgs: in, QueryID: qid, QueryName: qrow.Name, QueryVersion: qrow.Version, State: qrow.State, CreatedAt: qrow.CreatedAt.Format(time.RFC3339), UpdatedAt: qrow.UpdatedAt.Format(time.RFC3339), OwnerOut: &ownerOut}
+	st := QueryState{QueryArgs: in, QueryID: qid, QueryName: qrow.Name, QueryVersion: qrow.Version, State: qrow.State, CreatedAt: qrow.CreatedAt.Format(time.RFC3339), UpdatedAt: qrow.UpdatedAt.Format(time.RFC3339), OwnerOut: &ownerOut, RenderedSQL: sqlText}
 	logger.Info(fmt.Sprintf("Query created: %s", qid))
 	return infer.CreateResponse[QueryState]{ID: qid, Output: st}, nil
 }
@@ -300,8 +334,23 @@ func (Query) Read(ctx context.Context, req infer.ReadRequest[QueryArgs, QuerySta
 		}
 		return infer.ReadResponse[QueryArgs, QueryState]{}, err
 	}
-	ownerOut := qrow.Owner
 	st := req.State
+	if st.SQL == "" {
+		// Import: recover the statement from the catalog and its relations from the plan.
+		sqlText, serr := lookupQuerySQL(ctx2, conn, req.ID)
+		if serr != nil {
+			return infer.ReadResponse[QueryArgs, QueryState]{}, fmt.Errorf("failed to read query statement: %w", serr)
+		}
+		_, plan, derr := describeQuery(ctx2, conn, sqlText)
+		if derr != nil {
+			return infer.ReadResponse[QueryArgs, QueryState]{}, fmt.Errorf("failed to describe query statement: %w", derr)
+		}
+		st.QueryArgs = queryArgsFromPlan(sqlText, plan)
+		st.QueryID = req.ID
+		st.RenderedSQL = sqlText
+	}
+	st.RenderedSQL = renderedStateSQL(st.RenderedSQL, st.SQL, st.Parameters)
+	ownerOut := qrow.Owner
 	st.QueryName = qrow.Name
 	st.QueryVersion = qrow.Version
 	st.State = qrow.State
@@ -342,7 +391,11 @@ func (Query) Update(ctx context.Context, req infer.UpdateRequest[QueryArgs, Quer
 		return infer.UpdateResponse[QueryState]{}, err
 	}
 	defer conn.Close() //nolint:errcheck
-	stmt := fmt.Sprintf("ALTER QUERY %s OWNER TO %s;", st.QueryID, *req.Inputs.Owner)
+	qid, err := queryIDToken(st.QueryID)
+	if err != nil {
+		return infer.UpdateResponse[QueryState]{}, err
+	}
+	stmt := fmt.Sprintf("ALTER QUERY %s OWNER TO %s;", qid, quoteIdent(*req.Inputs.Owner))
 	if _, err := conn.ExecContext(ctx2, stmt); err != nil {
 		return infer.UpdateResponse[QueryState]{}, fmt.Errorf("failed altering owner: %w", err)
 	}
@@ -377,7 +430,11 @@ func (Query) Delete(ctx context.Context, req infer.DeleteRequest[QueryState]) (i
 	}
 	defer conn.Close() //nolint:errcheck
 	if req.State.State != "terminated" && req.State.State != "terminate_requested" {
-		term := fmt.Sprintf("TERMINATE QUERY %s;", req.ID)
+		qid, err := queryIDToken(req.ID)
+		if err != nil {
+			return infer.DeleteResponse{}, err
+		}
+		term := fmt.Sprintf("TERMINATE QUERY %s;", qid)
 		if _, err := conn.ExecContext(ctx2, term); err != nil {
 			var sqlErr ds.ErrSQLError
 			if !errors.As(err, &sqlErr) || sqlErr.SQLCode != ds.SqlStateInvalidQuery {
@@ -385,7 +442,9 @@ func (Query) Delete(ctx context.Context, req infer.DeleteRequest[QueryState]) (i
 			}
 		}
 	}
-	_ = waitForQueryTerminated(ctx2, conn, req.ID, time.Minute*5)
+	if err := waitForQueryTerminated(ctx2, conn, req.ID, time.Minute*5); err != nil {
+		return infer.DeleteResponse{}, lenientDeleteErr(ctx, &cfg, fmt.Errorf("query %s did not terminate: %w; check its state with LIST QUERIES and re-run the delete", req.ID, err))
+	}
 	return infer.DeleteResponse{}, nil
 }
 
@@ -445,7 +504,7 @@ func executeQuerySQL(ctx context.Context, conn *sql.Conn, sqlText string) (query
 
 // lookupQuery returns a hydrated query row for the given ID.
 func lookupQuery(ctx context.Context, conn *sql.Conn, id string) (queryRow, error) {
-	q := fmt.Sprintf("select name, \"version\", current_state, \"owner\", created_at, updated_at from deltastream.sys.\"queries\" where id = '%s';", id)
+	q := fmt.Sprintf("select name, \"version\", current_state, \"owner\", created_at, updated_at from deltastream.sys.\"queries\" where id = %s;", quoteString(id))
 	row := conn.QueryRowContext(ctx, q)
 	var r queryRow
 	if err := row.Scan(&r.Name, &r.Version, &r.State, &r.Owner, &r.CreatedAt, &r.UpdatedAt); err != nil {
@@ -454,6 +513,28 @@ func lookupQuery(ctx context.Context, conn *sql.Conn, id string) (queryRow, erro
 	return r, nil
 }
 
+// lookupQuerySQL returns the statement text the query was started with.
+func lookupQuerySQL(ctx context.Context, conn *sql.Conn, id string) (string, error) {
+	q := fmt.Sprintf(`SELECT "dsql" FROM deltastream.sys."queries" WHERE id = %s;`, quoteString(id))
+	var sqlText string
+	if err := conn.QueryRowContext(ctx, q).Scan(&sqlText); err != nil {
+		return "", err
+	}
+	return sqlText, nil
+}
+
+// queryArgsFromPlan rebuilds query inputs from its statement and DESCRIBE plan.
+func queryArgsFromPlan(sqlText string, plan queryStatementPlan) QueryArgs {
+	in := QueryArgs{SQL: sqlText, SourceRelationFqns: []string{}}
+	if plan.Sink != nil {
+		in.SinkRelationFqn = getFQN([]string{plan.Sink.DbName, plan.Sink.SchemaName, plan.Sink.Name})
+	}
+	for _, src := range plan.Sources {
+		in.SourceRelationFqns = append(in.SourceRelationFqns, getFQN([]string{src.DbName, src.SchemaName, src.Name}))
+	}
+	return in
+}
+
 // ensureQueryLookup attempts a lookup and returns any encountered error.
 func ensureQueryLookup(ctx context.Context, conn *sql.Conn, id string) error {
 	_, err := lookupQuery(ctx, conn, id)
@@ -462,10 +543,12 @@ func ensureQueryLookup(ctx context.Context, conn *sql.Conn, id string) error {
 
 // waitForQueryRunning polls until the query reaches running or errored/timeout occurs.
 func waitForQueryRunning(ctx context.Context, conn *sql.Conn, id string, timeout time.Duration) error {
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	deadline := time.Now().Add(timeout)
-	for {
-		qr, err := lookupQuery(ctx, conn, id)
+	for attempt := 1; ; attempt++ {
+		pctx, span := startPollSpan(ctx, "query running", attempt, attribute.String("deltastream.query_id", id))
+		qr, err := lookupQuery(pctx, conn, id)
+		endPollSpan(pctx, span, qr.State, err)
 		if err != nil {
 			var sqlErr ds.ErrSQLError
 			if errors.As(err, &sqlErr) && sqlErr.SQLCode == ds.SqlStateInvalidQuery {
@@ -496,8 +579,10 @@ func waitForQueryRunning(ctx context.Context, conn *sql.Conn, id string, timeout
 // waitForQueryTerminated polls until the query reaches terminated or disappears.
 func waitForQueryTerminated(ctx context.Context, conn *sql.Conn, id string, timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
-	for {
-		qr, err := lookupQuery(ctx, conn, id)
+	for attempt := 1; ; attempt++ {
+		pctx, span := startPollSpan(ctx, "query terminated", attempt, attribute.String("deltastream.query_id", id))
+		qr, err := lookupQuery(pctx, conn, id)
+		endPollSpan(pctx, span, qr.State, err)
 		if err != nil {
 			var sqlErr ds.ErrSQLError
 			if errors.As(err, &sqlErr) && sqlErr.SQLCode == ds.SqlStateInvalidQuery {
@@ -520,6 +605,7 @@ func waitForQueryTerminated(ctx context.Context, conn *sql.Conn, id string, time
 func (Query) WireDependencies(f infer.FieldSelector, args *QueryArgs, state *QueryState) {
 	f.OutputField(&state.State).DependsOn(f.InputField(&args.SQL))
 	f.OutputField(&state.QueryID).DependsOn(f.InputField(&args.SQL))
+	f.OutputField(&state.RenderedSQL).DependsOn(f.InputField(&args.SQL), f.InputField(&args.Parameters))
 }
 
 // provisionalQueryID derives a stable preview ID for planning phases.
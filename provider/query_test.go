@@ -16,6 +16,7 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -72,10 +73,16 @@ func TestQueryCheck_SkipsDescribeWhenInputsUnchanged(t *testing.T) {
 		},
 		{
 			name:      "changed SQL does not skip",
-			oldInputs: buildInputs(sql+" -- modified", sink, []string{src}),
+			oldInputs: buildInputs(strings.Replace(sql, "SELECT *", "SELECT id", 1), sink, []string{src}),
 			newInputs: buildInputs(sql, sink, []string{src}),
 			wantSkip:  false,
 		},
+		{
+			name:      "comment-only SQL change skips",
+			oldInputs: buildInputs(sql+" -- modified", sink, []string{src}),
+			newInputs: buildInputs(sql, sink, []string{src}),
+			wantSkip:  true,
+		},
 		{
 			name:      "SQL same but sink changed does not skip",
 			oldInputs: buildInputs(sql, `"db"."schema"."old_sink"`, []string{src}),
@@ -131,3 +138,23 @@ func TestQueryCheck_SkipsDescribeWhenInputsUnchanged(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryArgsFromPlan(t *testing.T) {
+	t.Parallel()
+
+	const sql = `INSERT INTO "db"."ns"."sink" SELECT * FROM "db"."ns"."a" JOIN "db"."ns"."b" ON a.id = b.id;`
+	plan := queryStatementPlan{
+		Sink:    &queryRelationPlan{DbName: "db", SchemaName: "ns", Name: "sink"},
+		Sources: []queryRelationPlan{{DbName: "db", SchemaName: "ns", Name: "a"}, {DbName: "db", SchemaName: "ns", Name: "b"}},
+	}
+	got := queryArgsFromPlan(sql, plan)
+	if got.SQL != sql {
+		t.Errorf("sql: got %q", got.SQL)
+	}
+	if got.SinkRelationFqn != `"db"."ns"."sink"` {
+		t.Errorf("sink: got %q", got.SinkRelationFqn)
+	}
+	if !stringSlicesEqual(got.SourceRelationFqns, []string{`"db"."ns"."a"`, `"db"."ns"."b"`}) {
+		t.Errorf("sources: got %q", got.SourceRelationFqns)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// redactedText replaces secret values in errors and log messages.
+const redactedText = "[secret]"
+
+// minRedactLen is the shortest value that is scrubbed. Shorter values would match ordinary
+// words in messages and make them unreadable.
+const minRedactLen = 4
+
+// secretFieldNames are the property names tagged provider:"secret" on the config and on the
+// resource inputs. Values under these names are scrubbed even when the engine did not mark
+// them secret.
+var secretFieldNames = collectSecretFieldNames(Config{}, KafkaInputs{}, SnowflakeInputs{}, PostgresInputs{}, SecretArgs{})
+
+func collectSecretFieldNames(types ...any) map[string]bool {
+	names := map[string]bool{}
+	for _, v := range types {
+		t := reflect.TypeOf(v)
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Tag.Get("provider") != "secret" {
+				continue
+			}
+			name, _, _ := strings.Cut(f.Tag.Get("pulumi"), ",")
+			names[name] = true
+		}
+	}
+	return names
+}
+
+type secretsKey struct{}
+
+// withSecrets returns a context whose errors and log messages have values scrubbed.
+func withSecrets(ctx context.Context, values []string) context.Context {
+	if len(values) == 0 {
+		return ctx
+	}
+	all := append(slices.Clone(secretsFrom(ctx)), values...)
+	for _, v := range values {
+		// Statements embed values through quoteString, which doubles single quotes, so an echoed
+		// statement carries the escaped form.
+		if escaped := strings.ReplaceAll(v, "'", "''"); escaped != v {
+			all = append(all, escaped)
+		}
+	}
+	// Longest first, so a secret containing another is replaced whole.
+	slices.SortFunc(all, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+	return context.WithValue(ctx, secretsKey{}, slices.Compact(all))
+}
+
+func secretsFrom(ctx context.Context) []string {
+	v, _ := ctx.Value(secretsKey{}).([]string)
+	return v
+}
+
+// redact replaces every known secret value in s.
+func redact(ctx context.Context, s string) string {
+	for _, secret := range secretsFrom(ctx) {
+		if len(secret) >= minRedactLen {
+			s = strings.ReplaceAll(s, secret, redactedText)
+		}
+	}
+	return s
+}
+
+// redactedError carries a scrubbed message while keeping the original error in the chain for
+// errors.Is and errors.As.
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e redactedError) Error() string { return e.msg }
+func (e redactedError) Unwrap() error { return e.err }
+
+// redactErr returns err with known secret values removed from its message.
+func redactErr(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if msg := redact(ctx, err.Error()); msg != err.Error() {
+		return redactedError{msg: msg, err: err}
+	}
+	return err
+}
+
+// secretValues returns the strings in m that are marked secret or stored under a secret
+// field name.
+func secretValues(m property.Map) []string {
+	var out []string
+	var walk func(v property.Value, secret bool)
+	walk = func(v property.Value, secret bool) {
+		secret = secret || v.Secret()
+		switch {
+		case v.IsString():
+			if secret && v.AsString() != "" {
+				out = append(out, v.AsString())
+			}
+		case v.IsMap():
+			for k, e := range v.AsMap().All {
+				walk(e, secret || secretFieldNames[k])
+			}
+		case v.IsArray():
+			for _, e := range v.AsArray().All {
+				walk(e, secret)
+			}
+		}
+	}
+	walk(property.New(m), false)
+	return out
+}
+
+// redactingLogger mirrors the p.Logger methods the provider uses, scrubbing known secret values from
+// every message.
+type redactingLogger struct {
+	ctx   context.Context
+	inner p.Logger
+}
+
+// getLogger returns the provider logger for ctx. Use it instead of p.GetLogger.
+func getLogger(ctx context.Context) redactingLogger {
+	return redactingLogger{ctx: ctx, inner: p.GetLogger(ctx)}
+}
+
+func (l redactingLogger) Debug(msg string)              { l.inner.Debug(redact(l.ctx, msg)) }
+func (l redactingLogger) Debugf(msg string, a ...any)   { l.Debug(fmt.Sprintf(msg, a...)) }
+func (l redactingLogger) Info(msg string)               { l.inner.Info(redact(l.ctx, msg)) }
+func (l redactingLogger) Infof(msg string, a ...any)    { l.Info(fmt.Sprintf(msg, a...)) }
+func (l redactingLogger) Warning(msg string)            { l.inner.Warning(redact(l.ctx, msg)) }
+func (l redactingLogger) Warningf(msg string, a ...any) { l.Warning(fmt.Sprintf(msg, a...)) }
+func (l redactingLogger) Error(msg string)              { l.inner.Error(redact(l.ctx, msg)) }
+func (l redactingLogger) Errorf(msg string, a ...any)   { l.Error(fmt.Sprintf(msg, a...)) }
+
+// redactSecrets wraps a provider so that secret values from the configuration and from each
+// request are scrubbed from returned errors, check failures and partial-state reasons, and
+// from messages logged through getLogger.
+func redactSecrets(prov p.Provider) p.Provider {
+	var (
+		mu     sync.RWMutex
+		config []string
+	)
+	scope := func(ctx context.Context, maps ...property.Map) context.Context {
+		mu.RLock()
+		values := slices.Clone(config)
+		mu.RUnlock()
+		for _, m := range maps {
+			values = append(values, secretValues(m)...)
+		}
+		return withSecrets(ctx, values)
+	}
+	redactFailures := func(ctx context.Context, failures []p.CheckFailure) {
+		for i := range failures {
+			failures[i].Reason = redact(ctx, failures[i].Reason)
+		}
+	}
+	redactPartial := func(ctx context.Context, partial *p.InitializationFailed) {
+		if partial == nil {
+			return
+		}
+		for i := range partial.Reasons {
+			partial.Reasons[i] = redact(ctx, partial.Reasons[i])
+		}
+	}
+
+	if configure := prov.Configure; configure != nil {
+		prov.Configure = func(ctx context.Context, req p.ConfigureRequest) error {
+			values := secretValues(req.Args)
+			mu.Lock()
+			config = values
+			mu.Unlock()
+			return redactErr(withSecrets(ctx, values), configure(ctx, req))
+		}
+	}
+	if checkConfig := prov.CheckConfig; checkConfig != nil {
+		prov.CheckConfig = func(ctx context.Context, req p.CheckRequest) (p.CheckResponse, error) {
+			ctx = withSecrets(ctx, secretValues(req.Inputs))
+			resp, err := checkConfig(ctx, req)
+			redactFailures(ctx, resp.Failures)
+			return resp, redactErr(ctx, err)
+		}
+	}
+	if invoke := prov.Invoke; invoke != nil {
+		prov.Invoke = func(ctx context.Context, req p.InvokeRequest) (p.InvokeResponse, error) {
+			ctx = scope(ctx, req.Args)
+			resp, err := invoke(ctx, req)
+			redactFailures(ctx, resp.Failures)
+			return resp, redactErr(ctx, err)
+		}
+	}
+	if check := prov.Check; check != nil {
+		prov.Check = func(ctx context.Context, req p.CheckRequest) (p.CheckResponse, error) {
+			ctx = scope(ctx, req.Inputs, req.State)
+			resp, err := check(ctx, req)
+			redactFailures(ctx, resp.Failures)
+			return resp, redactErr(ctx, err)
+		}
+	}
+	if diff := prov.Diff; diff != nil {
+		prov.Diff = func(ctx context.Context, req p.DiffRequest) (p.DiffResponse, error) {
+			ctx = scope(ctx, req.Inputs, req.State)
+			resp, err := diff(ctx, req)
+			return resp, redactErr(ctx, err)
+		}
+	}
+	if create := prov.Create; create != nil {
+		prov.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			ctx = scope(ctx, req.Properties)
+			resp, err := create(ctx, req)
+			redactPartial(ctx, resp.PartialState)
+			return resp, redactErr(ctx, err)
+		}
+	}
+	if read := prov.Read; read != nil {
+		prov.Read = func(ctx context.Context, req p.ReadRequest) (p.ReadResponse, error) {
+			ctx = scope(ctx, req.Properties, req.Inputs)
+			resp, err := read(ctx, req)
+			redactPartial(ctx, resp.PartialState)
+			return resp, redactErr(ctx, err)
+		}
+	}
+	if update := prov.Update; update != nil {
+		prov.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			ctx = scope(ctx, req.Inputs, req.State)
+			resp, err := update(ctx, req)
+			redactPartial(ctx, resp.PartialState)
+			return resp, redactErr(ctx, err)
+		}
+	}
+	if del := prov.Delete; del != nil {
+		prov.Delete = func(ctx context.Context, req p.DeleteRequest) error {
+			ctx = scope(ctx, req.Properties)
+			return redactErr(ctx, del(ctx, req))
+		}
+	}
+	return prov
+}
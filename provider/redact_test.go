@@ -0,0 +1,112 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ds "github.com/deltastreaminc/go-deltastream"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+	ctx := withSecrets(context.Background(), []string{"hunter2", "hunter2-extended", "abc"})
+	ctx = withSecrets(ctx, []string{"sk-live-1234", "it's-s3cret"})
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "store events not found", want: "store events not found"},
+		{name: "single", in: "'kafka.sasl.password' = 'hunter2'", want: "'kafka.sasl.password' = '[secret]'"},
+		{name: "longest first", in: "pw hunter2-extended", want: "pw [secret]"},
+		{name: "nested context", in: "token sk-live-1234 rejected", want: "token [secret] rejected"},
+		{name: "short values kept", in: "abc", want: "abc"},
+		{name: "quote escaped", in: "'kafka.sasl.password' = " + quoteString("it's-s3cret"), want: "'kafka.sasl.password' = '[secret]'"},
+		{name: "quote raw", in: "password it's-s3cret rejected", want: "password [secret] rejected"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, redact(ctx, tt.in))
+		})
+	}
+}
+
+func TestRedactErrKeepsChain(t *testing.T) {
+	t.Parallel()
+	ctx := withSecrets(context.Background(), []string{"hunter2"})
+	cause := ds.ErrSQLError{SQLCode: ds.SqlStateInvalidStore, Message: "bad 'hunter2'"}
+	err := redactErr(ctx, fmt.Errorf("failed to create store: %w", cause))
+	assert.NotContains(t, err.Error(), "hunter2")
+	var sqlErr ds.ErrSQLError
+	require.True(t, errors.As(err, &sqlErr))
+	assert.Equal(t, ds.SqlStateInvalidStore, sqlErr.SQLCode)
+	assert.NoError(t, redactErr(ctx, nil))
+}
+
+func TestSecretValues(t *testing.T) {
+	t.Parallel()
+	m := property.NewMap(map[string]property.Value{
+		"name": property.New("events"),
+		"kafka": property.New(property.NewMap(map[string]property.Value{
+			"uris":         property.New("kafka:9092"),
+			"saslPassword": property.New("from-field-name"),
+			"saslUsername": property.New("marked").WithSecret(true),
+		})),
+		"tags": property.New([]property.Value{property.New("in-secret-array")}).WithSecret(true),
+	})
+	assert.ElementsMatch(t, []string{"from-field-name", "marked", "in-secret-array"}, secretValues(m))
+}
+
+func TestRedactSecretsProvider(t *testing.T) {
+	t.Parallel()
+	var seen []string
+	prov := redactSecrets(p.Provider{
+		Configure: func(context.Context, p.ConfigureRequest) error { return nil },
+		Create: func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			seen = secretsFrom(ctx)
+			return p.CreateResponse{PartialState: &p.InitializationFailed{Reasons: []string{"login as s3cr3t-key failed"}}},
+				fmt.Errorf(`CREATE STORE "s" WITH ('kafka.sasl.password' = 'pa55word') failed`)
+		},
+		Check: func(ctx context.Context, req p.CheckRequest) (p.CheckResponse, error) {
+			return p.CheckResponse{Failures: []p.CheckFailure{{Property: "value", Reason: "value pa55word is too short"}}}, nil
+		},
+	})
+	ctx := context.Background()
+	require.NoError(t, prov.Configure(ctx, p.ConfigureRequest{Args: property.NewMap(map[string]property.Value{
+		"apiKey": property.New("s3cr3t-key"),
+		"server": property.New("https://api.deltastream.io/v2"),
+	})}))
+	props := property.NewMap(map[string]property.Value{"value": property.New("pa55word")})
+
+	resp, err := prov.Create(ctx, p.CreateRequest{Properties: props})
+	require.Error(t, err)
+	assert.Equal(t, `CREATE STORE "s" WITH ('kafka.sasl.password' = '[secret]') failed`, err.Error())
+	assert.Equal(t, []string{"login as [secret] failed"}, resp.PartialState.Reasons)
+	assert.ElementsMatch(t, []string{"s3cr3t-key", "pa55word"}, seen)
+
+	check, err := prov.Check(ctx, p.CheckRequest{Inputs: props})
+	require.NoError(t, err)
+	assert.Equal(t, "value [secret] is too short", check.Failures[0].Reason)
+}
@@ -0,0 +1,286 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+)
+
+// Secret resource storing a credential server-side so it can be rotated independently.
+type Secret struct{}
+
+// Annotate sets descriptions on Secret for schema generation.
+func (s *Secret) Annotate(a infer.Annotator) {
+	a.Describe(s, "Secret resource storing a credential server-side. Rotating the value updates the secret in place.")
+}
+
+// SecretArgs defines user inputs for a secret.
+type SecretArgs struct {
+	Name         string  `pulumi:"name"`
+	Type         *string `pulumi:"type,optional"`
+	Value        string  `pulumi:"value" provider:"secret"`
+	Description  *string `pulumi:"description,optional"`
+	AccessRegion *string `pulumi:"accessRegion,optional"`
+	Owner        *string `pulumi:"owner,optional"`
+}
+
+// Annotate sets descriptions on SecretArgs fields for schema generation.
+func (a *SecretArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Name, "Name of the secret")
+	an.Describe(&a.Type, "Secret type (default GENERIC_STRING)")
+	an.Describe(&a.Value, "Secret value. Changing it rotates the secret in place.")
+	an.Describe(&a.Description, "Optional description. Updated in place.")
+	an.Describe(&a.AccessRegion, "Optional region in which the secret is stored")
+	an.Describe(&a.Owner, "Optional owning role. When set, statements execute as this role.")
+}
+
+// SecretState extends inputs with computed fields.
+type SecretState struct {
+	SecretArgs
+	OwnerOut  string `pulumi:"owner"`
+	CreatedAt string `pulumi:"createdAt"`
+	UpdatedAt string `pulumi:"updatedAt"`
+}
+
+// Check validates secret inputs.
+func (Secret) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[SecretArgs], error) {
+	args, failures, err := infer.DefaultCheck[SecretArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[SecretArgs]{}, err
+	}
+	if args.Name == "" {
+		failures = append(failures, p.CheckFailure{Property: "name", Reason: "name required"})
+	}
+	if !strings.EqualFold(secretType(&args), "GENERIC_STRING") {
+		failures = append(failures, p.CheckFailure{Property: "type", Reason: "only GENERIC_STRING secrets are supported"})
+	}
+	return infer.CheckResponse[SecretArgs]{Inputs: args, Failures: failures}, nil
+}
+
+// Diff updates value and description in place; other changes replace the secret.
+func (Secret) Diff(ctx context.Context, req infer.DiffRequest[SecretArgs, SecretState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	if req.State.Name != req.Inputs.Name {
+		diff["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if !strings.EqualFold(secretType(&req.State.SecretArgs), secretType(&req.Inputs)) {
+		diff["type"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if ptr.Deref(req.State.AccessRegion, "") != ptr.Deref(req.Inputs.AccessRegion, "") {
+		diff["accessRegion"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Owner != nil && ptr.Deref(req.State.Owner, "") != *req.Inputs.Owner {
+		diff["owner"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.State.Value != req.Inputs.Value {
+		diff["value"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if ptr.Deref(req.State.Description, "") != ptr.Deref(req.Inputs.Description, "") {
+		diff["description"] = p.PropertyDiff{Kind: p.Update}
+	}
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff, DeleteBeforeReplace: true}, nil
+}
+
+// Create issues CREATE SECRET.
+func (Secret) Create(ctx context.Context, req infer.CreateRequest[SecretArgs]) (infer.CreateResponse[SecretState], error) {
+	in := req.Inputs
+	if req.DryRun {
+		now := time.Now().UTC().Format(time.RFC3339)
+		return infer.CreateResponse[SecretState]{ID: in.Name, Output: SecretState{SecretArgs: in, CreatedAt: now, UpdatedAt: now}}, nil
+	}
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.CreateResponse[SecretState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(in.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.CreateResponse[SecretState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if _, err := conn.ExecContext(ctx, renderCreateSecret(&in)); err != nil {
+		return infer.CreateResponse[SecretState]{}, fmt.Errorf("failed to create secret %s: %w", in.Name, err)
+	}
+	row, err := lookupSecret(ctx, conn, in.Name)
+	if err != nil {
+		return infer.CreateResponse[SecretState]{}, fmt.Errorf("failed to verify secret creation: %w", err)
+	}
+	getLogger(ctx).Info(fmt.Sprintf("Secret created: %s", in.Name))
+	return infer.CreateResponse[SecretState]{ID: in.Name, Output: secretState(in, row)}, nil
+}
+
+// Read refreshes secret metadata from the system catalog. The value cannot be read back.
+func (Secret) Read(ctx context.Context, req infer.ReadRequest[SecretArgs, SecretState]) (infer.ReadResponse[SecretArgs, SecretState], error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.ReadResponse[SecretArgs, SecretState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.ReadResponse[SecretArgs, SecretState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	row, err := lookupSecret(ctx, conn, req.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return infer.ReadResponse[SecretArgs, SecretState]{}, nil
+		}
+		return infer.ReadResponse[SecretArgs, SecretState]{}, err
+	}
+	in := req.State.SecretArgs
+	in.Name = req.ID
+	st := secretState(in, row)
+	return infer.ReadResponse[SecretArgs, SecretState]{ID: req.ID, Inputs: st.SecretArgs, State: st}, nil
+}
+
+// Update rotates the value and/or description with UPDATE SECRET.
+func (Secret) Update(ctx context.Context, req infer.UpdateRequest[SecretArgs, SecretState]) (infer.UpdateResponse[SecretState], error) {
+	in := req.Inputs
+	if req.DryRun {
+		st := req.State
+		st.SecretArgs = in
+		return infer.UpdateResponse[SecretState]{Output: st}, nil
+	}
+	stmt := renderUpdateSecret(&req.State.SecretArgs, &in)
+	if stmt == "" {
+		st := req.State
+		st.SecretArgs = in
+		return infer.UpdateResponse[SecretState]{Output: st}, nil
+	}
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.UpdateResponse[SecretState]{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.UpdateResponse[SecretState]{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if _, err := conn.ExecContext(ctx, stmt); err != nil {
+		return infer.UpdateResponse[SecretState]{}, fmt.Errorf("failed updating secret %s: %w", req.ID, err)
+	}
+	row, err := lookupSecret(ctx, conn, req.ID)
+	if err != nil {
+		return infer.UpdateResponse[SecretState]{}, err
+	}
+	return infer.UpdateResponse[SecretState]{Output: secretState(in, row)}, nil
+}
+
+// Delete drops the secret.
+func (Secret) Delete(ctx context.Context, req infer.DeleteRequest[SecretState]) (infer.DeleteResponse, error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(req.State.Owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return infer.DeleteResponse{}, err
+	}
+	defer conn.Close() //nolint:errcheck
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("DROP SECRET %s;", quoteIdent(req.ID))); err != nil {
+		// A missing secret has no dedicated SQL state, so confirm it against the catalog.
+		if _, lerr := lookupSecret(ctx, conn, req.ID); errors.Is(lerr, sql.ErrNoRows) {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete secret: %w", err)
+	}
+	return infer.DeleteResponse{}, nil
+}
+
+// WireDependencies declares resource graph dependencies for Pulumi.
+func (Secret) WireDependencies(f infer.FieldSelector, args *SecretArgs, state *SecretState) {
+	f.OutputField(&state.UpdatedAt).DependsOn(f.InputField(&args.Value))
+	f.OutputField(&state.UpdatedAt).DependsOn(f.InputField(&args.Description))
+}
+
+// secretRow is a helper struct for querying secret metadata.
+type secretRow struct {
+	Owner                string
+	CreatedAt, UpdatedAt time.Time
+}
+
+// lookupSecret fetches secret metadata (never the value) from the catalog.
+func lookupSecret(ctx context.Context, conn *sql.Conn, name string) (secretRow, error) {
+	q := fmt.Sprintf(`SELECT "owner", created_at, updated_at FROM deltastream.sys."secrets" WHERE name = %s;`, quoteString(name))
+	var r secretRow
+	err := conn.QueryRowContext(ctx, q).Scan(&r.Owner, &r.CreatedAt, &r.UpdatedAt)
+	return r, err
+}
+
+func secretType(in *SecretArgs) string {
+	return strings.ToUpper(ptr.Deref(in.Type, "GENERIC_STRING"))
+}
+
+// renderCreateSecret renders the CREATE SECRET statement for the given inputs.
+func renderCreateSecret(in *SecretArgs) string {
+	props := []string{
+		fmt.Sprintf("'type' = %s", secretType(in)),
+		fmt.Sprintf("'generic_string' = %s", quoteString(in.Value)),
+	}
+	if in.Description != nil {
+		props = append(props, fmt.Sprintf("'description' = %s", quoteString(*in.Description)))
+	}
+	if in.AccessRegion != nil {
+		props = append(props, fmt.Sprintf("'access_region' = %s", quoteString(*in.AccessRegion)))
+	}
+	return fmt.Sprintf("CREATE SECRET %s WITH ( %s );", quoteIdent(in.Name), strings.Join(props, ", "))
+}
+
+// renderUpdateSecret renders UPDATE SECRET for changed value/description, or "" when unchanged.
+func renderUpdateSecret(old, curr *SecretArgs) string {
+	props := []string{}
+	if old.Value != curr.Value {
+		props = append(props, fmt.Sprintf("'generic_string' = %s", quoteString(curr.Value)))
+	}
+	if ptr.Deref(old.Description, "") != ptr.Deref(curr.Description, "") {
+		if curr.Description == nil {
+			props = append(props, "'description' = NULL")
+		} else {
+			props = append(props, fmt.Sprintf("'description' = %s", quoteString(*curr.Description)))
+		}
+	}
+	if len(props) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("UPDATE SECRET %s WITH ( %s );", quoteIdent(curr.Name), strings.Join(props, ", "))
+}
+
+func secretState(in SecretArgs, row secretRow) SecretState {
+	return SecretState{SecretArgs: in, OwnerOut: row.Owner, CreatedAt: row.CreatedAt.Format(time.RFC3339), UpdatedAt: row.UpdatedAt.Format(time.RFC3339)}
+}
@@ -0,0 +1,63 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestRenderSecretStatements(t *testing.T) {
+	t.Parallel()
+
+	old := SecretArgs{Name: "kafka_pw", Value: "it's-secret", Description: ptr.To("kafka password")}
+	want := `CREATE SECRET "kafka_pw" WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'it''s-secret', 'description' = 'kafka password' );`
+	if got := renderCreateSecret(&old); got != want {
+		t.Errorf("create:\ngot  %s\nwant %s", got, want)
+	}
+
+	rotated := old
+	rotated.Value = "rotated"
+	rotated.Description = nil
+	want = `UPDATE SECRET "kafka_pw" WITH ( 'generic_string' = 'rotated', 'description' = NULL );`
+	if got := renderUpdateSecret(&old, &rotated); got != want {
+		t.Errorf("update:\ngot  %s\nwant %s", got, want)
+	}
+	if got := renderUpdateSecret(&old, &old); got != "" {
+		t.Errorf("expected no statement for unchanged secret, got %s", got)
+	}
+}
+
+func TestSecretDeleteAlreadyGone(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("Secret"), "token")
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: property.NewMap(map[string]property.Value{
+		"name":  property.New("token"),
+		"type":  property.New("generic_string"),
+		"value": property.New("s3cr3t").WithSecret(true),
+	})})
+	require.NoError(t, err)
+	f.seed(t, `DROP SECRET "token"`)
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: created.ID, Urn: urn, Properties: created.Properties}),
+		"deleting a secret that no longer exists should succeed")
+}
@@ -33,6 +33,21 @@ func TestSecretFieldMetadata(t *testing.T) {
 	// PostgresInputs
 	pt := reflect.TypeOf(PostgresInputs{})
 	checkTag(t, pt, "Password")
+
+	// SecretArgs
+	sa := reflect.TypeOf(SecretArgs{})
+	checkTag(t, sa, "Value")
+
+	// Config
+	ct := reflect.TypeOf(Config{})
+	checkTag(t, ct, "APIKey")
+
+	// Every tagged field is scrubbed by the redaction layer.
+	for _, name := range []string{"saslUsername", "saslPassword", "clientKey", "password", "value", "apiKey"} {
+		if !secretFieldNames[name] {
+			t.Fatalf("expected %s to be a redacted field name", name)
+		}
+	}
 }
 
 func checkTag(t *testing.T, typ reflect.Type, field string) {
@@ -0,0 +1,172 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	ds "github.com/deltastreaminc/go-deltastream"
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// Planner errors are turned into check failures here. DeltaStream reports SQL errors with a
+// SQL state and a message; parser messages also carry the position of the offending token.
+// A failure names the state, marks the position in the statement with a caret and, for the
+// common mistakes, adds a hint.
+
+// sqlStateSyntaxError is the standard SQL state for a statement that does not parse.
+const sqlStateSyntaxError ds.SqlState = "42601"
+
+// describePrefix is sent ahead of every planned statement, so positions on the first line
+// are offset by its length.
+const describePrefix = "DESCRIBE "
+
+// sqlStateHints maps SQL states to hints for the mistakes users make most often.
+var sqlStateHints = map[ds.SqlState]string{
+	ds.SqlStateInvalidRelation: "unknown relation; did you declare the DeltaStreamObject first?",
+	ds.SqlStateInvalidDatabase: "unknown database; did you declare the Database first?",
+	ds.SqlStateInvalidSchema:   "unknown namespace; did you declare the Namespace first?",
+	ds.SqlStateInvalidStore:    "unknown store; did you declare the Store first?",
+	ds.SqlStateInvalidQuery:    "unknown query; check the query ID after RESUME FROM QUERY ID",
+	sqlStateSyntaxError:        "syntax error; check the statement near the marker",
+}
+
+// sqlPositionRe matches the positions parsers report: "line 2:14", where the column counts
+// from 0, and "line 2, column 15" or "line 2 col 15", where it counts from 1.
+var sqlPositionRe = regexp.MustCompile(`(?i)\bline\s+(\d+)(?:\s*:\s*(\d+)|\s*,?\s*col(?:umn)?\s+(\d+))`)
+
+// maxSnippetWidth bounds the statement excerpt shown above the caret.
+const maxSnippetWidth = 80
+
+// pendingRelation reports whether a DESCRIBE error is about one of the declared relations
+// not existing yet, and returns its name. Such a relation is usually created in the same
+// update, so during a preview it only exists as a planned output. An error about any other
+// relation, or about a declared relation the catalog already has, is not pending.
+func pendingRelation(ctx context.Context, conn *sql.Conn, err error, declared []string) (string, bool) {
+	var sqlErr ds.ErrSQLError
+	if !errors.As(err, &sqlErr) || sqlErr.SQLCode != ds.SqlStateInvalidRelation {
+		return "", false
+	}
+	msg := strings.ReplaceAll(sqlErr.Message, `"`, "")
+	for _, fqn := range declared {
+		path, perr := parseFQN(fqn)
+		if perr != nil {
+			continue
+		}
+		nameRe := regexp.MustCompile(`(?i)(^|[^\w.])` + regexp.QuoteMeta(strings.Join(path, ".")) + `($|[^\w])`)
+		if !nameRe.MatchString(msg) {
+			continue
+		}
+		if _, lerr := lookupRelation(ctx, conn, path); errors.Is(lerr, sql.ErrNoRows) {
+			return fqn, true
+		}
+	}
+	return "", false
+}
+
+// describeCheckFailure returns the check failure for an error planning statement with
+// DESCRIBE. DeltaStream SQL errors become a multi-line reason with the SQL state, the
+// offending line and a caret when the message reports a position, and a hint when one is
+// known for the state. Other errors are reported as they are.
+func describeCheckFailure(property, statement string, err error) p.CheckFailure {
+	var sqlErr ds.ErrSQLError
+	if !errors.As(err, &sqlErr) {
+		msg := err.Error()
+		if !strings.HasPrefix(msg, "describe failed: ") {
+			msg = "describe failed: " + msg
+		}
+		return p.CheckFailure{Property: property, Reason: msg}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "describe failed: %s", sqlErr.Message)
+	if sqlErr.SQLCode != "" {
+		fmt.Fprintf(&b, " (SQL state %s)", sqlErr.SQLCode)
+	}
+	if line, col, ok := sqlErrorPosition(sqlErr.Message); ok {
+		if snippet, ok := markSQLPosition(statement, line, col); ok {
+			fmt.Fprintf(&b, "\nat line %d, column %d:\n%s", line, col+1, snippet)
+		}
+	}
+	if hint, ok := sqlStateHints[sqlErr.SQLCode]; ok {
+		fmt.Fprintf(&b, "\nhint: %s", hint)
+	}
+	return p.CheckFailure{Property: property, Reason: b.String()}
+}
+
+// sqlErrorPosition returns the 1-based line and 0-based column a DESCRIBE error message points
+// at, relative to the planned statement rather than to the DESCRIBE command sent for it.
+func sqlErrorPosition(msg string) (line, col int, ok bool) {
+	m := sqlPositionRe.FindStringSubmatch(msg)
+	if m == nil {
+		return 0, 0, false
+	}
+	line, _ = strconv.Atoi(m[1])
+	if m[2] != "" {
+		col, _ = strconv.Atoi(m[2])
+	} else {
+		col, _ = strconv.Atoi(m[3])
+		col--
+	}
+	if line == 1 {
+		col -= len(describePrefix)
+	}
+	if line < 1 || col < 0 {
+		return 0, 0, false
+	}
+	return line, col, true
+}
+
+// markSQLPosition returns the given line of statement with a caret under column col. Long
+// lines are cut to a window around the column. Tabs before the column are kept in the marker
+// line so the caret lines up however the terminal renders them.
+func markSQLPosition(statement string, line, col int) (string, bool) {
+	lines := strings.Split(statement, "\n")
+	if line > len(lines) {
+		return "", false
+	}
+	text := []rune(strings.TrimRight(lines[line-1], "\r"))
+	if col > len(text) {
+		return "", false
+	}
+	start, end := 0, len(text)
+	prefix, suffix := "", ""
+	if end > maxSnippetWidth {
+		start = max(0, col-maxSnippetWidth/2)
+		end = min(len(text), start+maxSnippetWidth)
+		start = max(0, end-maxSnippetWidth)
+		if start > 0 {
+			prefix = "..."
+		}
+		if end < len(text) {
+			suffix = "..."
+		}
+	}
+	pad := []rune(strings.Repeat(" ", len(prefix)))
+	for _, r := range text[start:col] {
+		if r == '\t' {
+			pad = append(pad, '\t')
+		} else {
+			pad = append(pad, ' ')
+		}
+	}
+	return "  " + prefix + string(text[start:end]) + suffix + "\n  " + string(pad) + "^", true
+}
@@ -0,0 +1,97 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	ds "github.com/deltastreaminc/go-deltastream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeCheckFailure(t *testing.T) {
+	t.Parallel()
+
+	const query = `INSERT INTO "db"."public"."sink" SELECT * FORM "db"."public"."pageviews";`
+	tests := []struct {
+		name string
+		stmt string
+		err  error
+		want string
+	}{
+		{
+			name: "syntax error with parser position",
+			stmt: query,
+			err:  ds.ErrSQLError{SQLCode: sqlStateSyntaxError, Message: "line 1:51 mismatched input 'FORM' expecting {FROM, ';'}"},
+			want: "describe failed: line 1:51 mismatched input 'FORM' expecting {FROM, ';'} (SQL state 42601)\n" +
+				"at line 1, column 43:\n" +
+				"  " + query + "\n" +
+				"  " + strings.Repeat(" ", 42) + "^\n" +
+				"hint: syntax error; check the statement near the marker",
+		},
+		{
+			name: "position on a later line keeps tabs",
+			stmt: "INSERT INTO \"db\".\"public\".\"sink\"\n\tSELECT * FORM src;",
+			err:  ds.ErrSQLError{SQLCode: sqlStateSyntaxError, Message: "syntax error at line 2, column 11"},
+			want: "describe failed: syntax error at line 2, column 11 (SQL state 42601)\n" +
+				"at line 2, column 11:\n" +
+				"  \tSELECT * FORM src;\n" +
+				"  \t         ^\n" +
+				"hint: syntax error; check the statement near the marker",
+		},
+		{
+			name: "unknown relation without position",
+			stmt: query,
+			err:  fmt.Errorf("describe failed: %w", ds.ErrSQLError{SQLCode: ds.SqlStateInvalidRelation, Message: `relation "db"."public"."sink" not found`}),
+			want: `describe failed: relation "db"."public"."sink" not found (SQL state 42P01)` + "\n" +
+				"hint: unknown relation; did you declare the DeltaStreamObject first?",
+		},
+		{
+			name: "position past the statement is dropped",
+			stmt: "SELECT 1;",
+			err:  ds.ErrSQLError{SQLCode: "XX000", Message: "line 3:4 unexpected input"},
+			want: "describe failed: line 3:4 unexpected input (SQL state XX000)",
+		},
+		{
+			name: "other errors are kept",
+			stmt: query,
+			err:  errors.New("connection reset"),
+			want: "describe failed: connection reset",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := describeCheckFailure("sql", tt.stmt, tt.err)
+			assert.Equal(t, "sql", got.Property)
+			assert.Equal(t, tt.want, got.Reason)
+		})
+	}
+}
+
+func TestMarkSQLPositionLongLine(t *testing.T) {
+	t.Parallel()
+	line := strings.Repeat("a", 100) + "X" + strings.Repeat("b", 100)
+	got, ok := markSQLPosition(line, 1, 100)
+	assert.True(t, ok)
+	rows := strings.Split(got, "\n")
+	assert.Len(t, rows, 2)
+	assert.Equal(t, strings.Index(rows[0], "X"), strings.Index(rows[1], "^"))
+	assert.True(t, strings.HasPrefix(rows[0], "  ..."))
+	assert.True(t, strings.HasSuffix(rows[0], "..."))
+}
@@ -0,0 +1,190 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+)
+
+// simpleIdentRe matches quoted identifiers that are equivalent to their unquoted form.
+var simpleIdentRe = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// sqlEqual reports whether two SQL statements are equivalent after normalizeSQL.
+func sqlEqual(a, b string) bool {
+	return a == b || normalizeSQL(a) == normalizeSQL(b)
+}
+
+// normalizeSQL returns a canonical form of a statement used for change detection. Comments
+// are removed, whitespace is collapsed (and dropped around punctuation), unquoted text is
+// upper-cased, quoted identifiers that need no quoting are unquoted, and trailing semicolons
+// are trimmed. String literals and other quoted identifiers are preserved verbatim.
+func normalizeSQL(s string) string {
+	var b strings.Builder
+	pendingSpace := false
+	emit := func(tok string) {
+		if pendingSpace && b.Len() > 0 && !sqlPunct(lastByte(&b)) && !sqlPunct(tok[0]) {
+			b.WriteByte(' ')
+		}
+		pendingSpace = false
+		b.WriteString(tok)
+	}
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '-' && i+1 < len(s) && s[i+1] == '-':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+			pendingSpace = true
+		case c == '/' && i+1 < len(s) && s[i+1] == '*':
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				i = len(s)
+			} else {
+				i += end + 4
+			}
+			pendingSpace = true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+			pendingSpace = true
+		case c == '\'' || c == '"':
+			j := scanQuoted(s, i)
+			tok := s[i:j]
+			// An unterminated identifier is kept as it is.
+			if c == '"' && len(tok) >= 2 && tok[len(tok)-1] == c && simpleIdentRe.MatchString(tok[1:len(tok)-1]) {
+				tok = strings.ToUpper(tok[1 : len(tok)-1])
+			}
+			emit(tok)
+			i = j
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t\n\r\f'\"", rune(s[j])) &&
+				!(s[j] == '-' && j+1 < len(s) && s[j+1] == '-') &&
+				!(s[j] == '/' && j+1 < len(s) && s[j+1] == '*') {
+				if sqlPunct(s[j]) {
+					if j == i {
+						j++
+					}
+					break
+				}
+				j++
+			}
+			emit(strings.ToUpper(s[i:j]))
+			i = j
+		}
+	}
+	return strings.TrimRight(b.String(), ";")
+}
+
+// splitSQLStatements splits a script at semicolons outside quotes and comments and returns
+// each statement, without leading comments, with a trailing semicolon. A BEGIN APPLICATION
+// block is kept whole up to its END APPLICATION. Pieces holding only comments are dropped.
+func splitSQLStatements(s string) []string {
+	var out []string
+	start := 0
+	emit := func(end int) {
+		stmt := trimLeadingComments(s[start:end])
+		if normalizeSQL(stmt) != "" {
+			out = append(out, stmt+";")
+		}
+		start = end + 1
+	}
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '-' && i+1 < len(s) && s[i+1] == '-':
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(s) && s[i+1] == '*':
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				i = len(s)
+			} else {
+				i += end + 4
+			}
+		case c == '\'' || c == '"':
+			i = scanQuoted(s, i)
+		case c == ';':
+			cur := normalizeSQL(s[start:i])
+			if !strings.HasPrefix(cur, "BEGIN APPLICATION") || strings.HasSuffix(cur, "END APPLICATION") {
+				emit(i)
+			}
+			i++
+		default:
+			i++
+		}
+	}
+	if start < len(s) {
+		emit(len(s))
+	}
+	return out
+}
+
+// trimLeadingComments removes whitespace and comments before the first token of s, and
+// trailing whitespace.
+func trimLeadingComments(s string) string {
+	for {
+		s = strings.TrimSpace(s)
+		switch {
+		case strings.HasPrefix(s, "--"):
+			end := strings.IndexByte(s, '\n')
+			if end < 0 {
+				return ""
+			}
+			s = s[end+1:]
+		case strings.HasPrefix(s, "/*"):
+			end := strings.Index(s[2:], "*/")
+			if end < 0 {
+				return ""
+			}
+			s = s[end+4:]
+		default:
+			return s
+		}
+	}
+}
+
+// scanQuoted returns the index just past the quoted token starting at s[start]. A doubled
+// quote character inside the token is an escaped quote.
+func scanQuoted(s string, start int) int {
+	q := s[start]
+	for i := start + 1; i < len(s); i++ {
+		if s[i] != q {
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == q {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(s)
+}
+
+// sqlPunct reports whether whitespace around c is insignificant.
+func sqlPunct(c byte) bool {
+	switch c {
+	case '(', ')', ',', ';', '=', '.':
+		return true
+	}
+	return false
+}
+
+func lastByte(b *strings.Builder) byte {
+	s := b.String()
+	return s[len(s)-1]
+}
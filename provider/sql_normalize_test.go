@@ -0,0 +1,168 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLEqual(t *testing.T) {
+	t.Parallel()
+
+	base := `INSERT INTO "db"."public"."sink" SELECT userid, count(*) AS cnt FROM pageviews WHERE pageid = 'Home' GROUP BY userid;`
+
+	tests := []struct {
+		name  string
+		other string
+		want  bool
+	}{
+		{
+			name:  "identical",
+			other: base,
+			want:  true,
+		},
+		{
+			name: "reformatted with comments",
+			other: `-- aggregate page views
+INSERT INTO db.public.sink
+  SELECT userid, COUNT( * ) AS cnt /* per user */
+  FROM pageviews
+  WHERE pageid='Home'
+  GROUP BY userid`,
+			want: true,
+		},
+		{
+			name:  "keyword case",
+			other: `insert into "db"."public"."sink" select USERID, COUNT(*) as CNT from PAGEVIEWS where PAGEID = 'Home' group by USERID;`,
+			want:  true,
+		},
+		{
+			name:  "string literal case is significant",
+			other: `INSERT INTO "db"."public"."sink" SELECT userid, count(*) AS cnt FROM pageviews WHERE pageid = 'home' GROUP BY userid;`,
+			want:  false,
+		},
+		{
+			name:  "mixed case quoted identifier is significant",
+			other: `INSERT INTO "db"."public"."Sink" SELECT userid, count(*) AS cnt FROM pageviews WHERE pageid = 'Home' GROUP BY userid;`,
+			want:  false,
+		},
+		{
+			name:  "comment markers inside literals are preserved",
+			other: `INSERT INTO "db"."public"."sink" SELECT userid, count(*) AS cnt FROM pageviews WHERE pageid = 'Home--x' GROUP BY userid;`,
+			want:  false,
+		},
+		{
+			name:  "semantic change",
+			other: `INSERT INTO "db"."public"."sink" SELECT userid, count(*) AS cnt FROM pageviews GROUP BY userid;`,
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := sqlEqual(base, tt.other); got != tt.want {
+				t.Errorf("sqlEqual = %v, want %v\nbase:  %s\nother: %s", got, tt.want, normalizeSQL(base), normalizeSQL(tt.other))
+			}
+		})
+	}
+}
+
+func TestNormalizeSQLEscapedQuotes(t *testing.T) {
+	t.Parallel()
+
+	got := normalizeSQL(`select  'it''s -- fine' ,  "we""ird"  from  t ;;`)
+	want := `SELECT 'it''s -- fine',"we""ird" FROM T`
+	if got != want {
+		t.Fatalf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestSplitSQLStatements(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name:   "single statement without semicolon",
+			script: `CREATE DATABASE "a"`,
+			want:   []string{`CREATE DATABASE "a";`},
+		},
+		{
+			name: "comments and blank statements",
+			script: `-- setup; not a statement
+CREATE DATABASE "a";
+;
+/* b; too */ CREATE DATABASE "b";
+-- trailing comment`,
+			want: []string{`CREATE DATABASE "a";`, `CREATE DATABASE "b";`},
+		},
+		{
+			name:   "semicolons in literals and identifiers",
+			script: `UPDATE SECRET "s" WITH ( 'generic_string' = 'a;b''c' ); DROP DATABASE "x;y";`,
+			want:   []string{`UPDATE SECRET "s" WITH ( 'generic_string' = 'a;b''c' );`, `DROP DATABASE "x;y";`},
+		},
+		{
+			name: "application block kept whole",
+			script: `BEGIN APPLICATION app
+  CREATE VIRTUAL STREAM v AS SELECT * FROM pageviews;
+  INSERT INTO sink SELECT * FROM v;
+END APPLICATION;
+CREATE DATABASE "after";`,
+			want: []string{
+				"BEGIN APPLICATION app\n  CREATE VIRTUAL STREAM v AS SELECT * FROM pageviews;\n  INSERT INTO sink SELECT * FROM v;\nEND APPLICATION;",
+				`CREATE DATABASE "after";`,
+			},
+		},
+		{
+			name:   "only comments",
+			script: "-- nothing here\n/* or here */",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := splitSQLStatements(tt.script)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d statements %q, want %d %q", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("statement %d:\ngot  %s\nwant %s", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func FuzzNormalizeSQL(f *testing.F) {
+	for _, seed := range []string{`SELECT "a" FROM t;`, `"`, `'`, `x "abc`, `"a""`, "-- x", "/* x", `'it''s' "we""ird";;`} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		got := normalizeSQL(s)
+		assert.True(t, sqlEqual(s, s))
+		assert.False(t, strings.HasSuffix(got, ";"), "normalizeSQL(%q) = %q keeps a trailing semicolon", s, got)
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Every value the provider interpolates into a generated statement goes through this file.
+// Identifiers and role names are double-quoted with quoteIdent, relation paths with quoteFQN
+// and literals single-quoted with quoteString. The few tokens that must stay bare (keywords
+// such as a function language, type expressions and query IDs) are validated instead, so no
+// input can end a token early and change the shape of the statement. The raw sql inputs of
+// objects, queries and applications are sent as written; parameters are the way to splice
+// values into them.
+
+// quoteIdent returns a double-quoted SQL identifier with embedded quotes escaped.
+// It does not attempt full normalization beyond doubling internal quotes.
+func quoteIdent(in string) string {
+	return `"` + strings.ReplaceAll(in, `"`, `""`) + `"`
+}
+
+// quoteString returns a single-quoted SQL string literal with embedded quotes escaped.
+func quoteString(in string) string {
+	return "'" + strings.ReplaceAll(in, "'", "''") + "'"
+}
+
+// quoteFQN returns a dotted name with each part quoted by quoteIdent.
+func quoteFQN(parts ...string) string {
+	quoted := make([]string, len(parts))
+	for i, part := range parts {
+		quoted[i] = quoteIdent(part)
+	}
+	return strings.Join(quoted, ".")
+}
+
+// withProperty renders one 'key' = value pair of a WITH clause. value must already be a
+// literal: a quoteString result, a number, TRUE, FALSE, NULL or a secretRef result.
+func withProperty(key, value string) string {
+	return quoteString(key) + " = " + value
+}
+
+// secretRef renders a reference to the Secret named name as a WITH property value, so the
+// server resolves the credential and the statement carries no plaintext.
+func secretRef(name string) string {
+	return "SECRET " + quoteIdent(name)
+}
+
+// queryIDToken returns id for use as a bare query reference, as in TERMINATE QUERY. Query IDs
+// are UUIDs; anything else is rejected.
+func queryIDToken(id string) (string, error) {
+	if _, err := uuid.Parse(id); err != nil || strings.ContainsAny(id, "{}:") {
+		return "", fmt.Errorf("invalid query ID %q: expected a UUID", id)
+	}
+	return id, nil
+}
+
+var sqlKeywordRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// checkSQLKeyword reports an error unless v is a single bare word, such as a function
+// language or a relation kind.
+func checkSQLKeyword(v string) error {
+	if !sqlKeywordRe.MatchString(v) {
+		return fmt.Errorf("invalid keyword %q: only letters, digits and underscores are allowed", v)
+	}
+	return nil
+}
+
+// checkSQLType reports an error unless t is a well-formed type expression such as BIGINT,
+// DECIMAL(10, 2), ARRAY<VARCHAR> or STRUCT<"a b" INTEGER>. Quoted field names may contain
+// anything; outside them only words, digits, spaces, commas and balanced brackets are allowed.
+func checkSQLType(t string) error {
+	if strings.TrimSpace(t) == "" {
+		return fmt.Errorf("type is empty")
+	}
+	var open []byte
+	for i := 0; i < len(t); i++ {
+		c := t[i]
+		switch {
+		case c == '"':
+			j := i + 1
+			for ; j < len(t); j++ {
+				if t[j] == '"' {
+					if j+1 < len(t) && t[j+1] == '"' {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j >= len(t) {
+				return fmt.Errorf("invalid type %q: unterminated quoted name", t)
+			}
+			i = j
+		case c == '<' || c == '(':
+			open = append(open, c)
+		case c == '>' || c == ')':
+			want := byte('<')
+			if c == ')' {
+				want = '('
+			}
+			if len(open) == 0 || open[len(open)-1] != want {
+				return fmt.Errorf("invalid type %q: unbalanced %q", t, c)
+			}
+			open = open[:len(open)-1]
+		case c == '_' || c == ',' || c == ' ' || c == '\t' || c == '\n' || c == '\r',
+			c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		default:
+			return fmt.Errorf("invalid type %q: unexpected %q", t, c)
+		}
+	}
+	if len(open) > 0 {
+		return fmt.Errorf("invalid type %q: unclosed %q", t, open[len(open)-1])
+	}
+	return nil
+}
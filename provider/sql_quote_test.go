@@ -0,0 +1,153 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+// sqlSkeleton replaces every quoted token in stmt with ? so statements rendered from
+// different values can be compared structurally. ok is false if a quote is left open.
+func sqlSkeleton(stmt string) (skeleton string, ok bool) {
+	var b strings.Builder
+	for i := 0; i < len(stmt); {
+		c := stmt[i]
+		if c != '\'' && c != '"' {
+			b.WriteByte(c)
+			i++
+			continue
+		}
+		j := scanQuoted(stmt, i)
+		if j-i < 2 || stmt[j-1] != c || strings.Count(stmt[i+1:j-1], string(c))%2 != 0 {
+			return "", false
+		}
+		b.WriteByte('?')
+		i = j
+	}
+	return b.String(), true
+}
+
+// generatedStatements renders a representative statement of each kind from one value.
+func generatedStatements(v string) []string {
+	path := []string{v, v, v}
+	return []string{
+		renderCreateSecret(&SecretArgs{Name: v, Value: v, Description: &v, AccessRegion: &v}),
+		renderObjectDDL(&DeltaStreamObjectArgs{Database: v, Namespace: v, RelationName: &v, Kind: ptr.To("stream"),
+			Columns: []ObjectColumn{{Name: v, Type: "VARCHAR"}}, With: map[string]string{"topic": v}}),
+		renderEntityCreate(&EntityArgs{Name: v, Store: v, Configs: map[string]string{"cleanup.policy": v},
+			KeyDescriptor: &EntityDescriptor{Source: v, Message: v}}),
+		renderCreateFunction(&FunctionArgs{Name: v, Source: v, ClassName: v, ReturnType: "VARCHAR",
+			Parameters: []FunctionParameter{{Name: v, Type: "VARCHAR"}}}),
+		fmt.Sprintf("ALTER DATABASE %s OWNER TO %s;", quoteIdent(v), quoteIdent(v)),
+		fmt.Sprintf("ALTER SCHEMA %s OWNER TO %s;", quoteFQN(v, v), quoteIdent(v)),
+		fmt.Sprintf("DROP RELATION %s;", getFQN(path)),
+		fmt.Sprintf("UPDATE STORE %s WITH ( %s );", quoteIdent(v), withProperty("uris", quoteString(v))),
+		fmt.Sprintf(`SELECT "owner" FROM deltastream.sys."stores" WHERE name = %s;`, quoteString(v)),
+	}
+}
+
+func FuzzGeneratedStatements(f *testing.F) {
+	for _, seed := range []string{"x", "a'b", `a"b`, "x'; DROP DATABASE prod; --", `x"; DROP DATABASE "prod`, "a.b", "''", `""`, "\n/*"} {
+		f.Add(seed)
+	}
+	want := generatedStatements("x")
+	for i := range want {
+		want[i], _ = sqlSkeleton(want[i])
+	}
+	f.Fuzz(func(t *testing.T, v string) {
+		for i, stmt := range generatedStatements(v) {
+			got, ok := sqlSkeleton(stmt)
+			require.True(t, ok, "unterminated quote in %s", stmt)
+			assert.Equal(t, want[i], got, "value %q changed the structure of %s", v, stmt)
+		}
+	})
+}
+
+func FuzzQuoteFQN(f *testing.F) {
+	f.Add("db", "ns", "rel")
+	f.Add(`a"b`, "c.d", `"`)
+	f.Fuzz(func(t *testing.T, a, b, c string) {
+		if a == "" || b == "" || c == "" {
+			t.Skip()
+		}
+		parts, err := parseFQN(quoteFQN(a, b, c))
+		require.NoError(t, err)
+		assert.Equal(t, []string{a, b, c}, parts)
+	})
+}
+
+func FuzzCheckSQLType(f *testing.F) {
+	for _, seed := range []string{"BIGINT", "DECIMAL(10, 2)", `STRUCT<"a b" INTEGER>`, "VARCHAR); DROP", "INT -- x", "'"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, typ string) {
+		if checkSQLType(typ) != nil {
+			return
+		}
+		skeleton, ok := sqlSkeleton(typ)
+		require.True(t, ok, "accepted type %q leaves a quote open", typ)
+		for _, bad := range []string{";", "'", "--", "/*"} {
+			assert.NotContains(t, skeleton, bad, "accepted type %q", typ)
+		}
+	})
+}
+
+func TestCheckSQLType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		typ     string
+		wantErr bool
+	}{
+		{typ: "BIGINT"},
+		{typ: "DECIMAL(10, 2)"},
+		{typ: "ARRAY<VARCHAR>"},
+		{typ: "MAP<VARCHAR, ARRAY<INTEGER>>"},
+		{typ: `STRUCT<"a ""b""" INTEGER, c VARCHAR>`},
+		{typ: "", wantErr: true},
+		{typ: "VARCHAR); DROP STREAM s; --", wantErr: true},
+		{typ: "ARRAY<VARCHAR", wantErr: true},
+		{typ: "ARRAY<VARCHAR)", wantErr: true},
+		{typ: `STRUCT<"a INTEGER>`, wantErr: true},
+		{typ: "VARCHAR 'x'", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			t.Parallel()
+			err := checkSQLType(tt.typ)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestQueryIDToken(t *testing.T) {
+	t.Parallel()
+	id, err := queryIDToken("3f2b6c1e-8d4a-4c55-9b2e-0a1d2c3e4f50")
+	require.NoError(t, err)
+	assert.Equal(t, "3f2b6c1e-8d4a-4c55-9b2e-0a1d2c3e4f50", id)
+	for _, bad := range []string{"", "q1", "3f2b6c1e-8d4a-4c55-9b2e-0a1d2c3e4f50; DROP DATABASE prod", "{3f2b6c1e-8d4a-4c55-9b2e-0a1d2c3e4f50}"} {
+		_, err := queryIDToken(bad)
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// sqlPlaceholderRe matches typed template placeholders such as {{ident:table}}.
+var sqlPlaceholderRe = regexp.MustCompile(`\{\{\s*([a-z]+)\s*:\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// sqlNumberRe matches the numeric literals accepted for {{number:...}} placeholders.
+var sqlNumberRe = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// renderSQLTemplate substitutes typed placeholders in sqlText with quoted parameter values.
+// Supported placeholder types are:
+//
+//	{{ident:name}}  a single identifier, rendered with quoteIdent
+//	{{fqn:name}}    a database.namespace.relation name, each part rendered with quoteIdent
+//	{{string:name}} a string literal, rendered with quoteString
+//	{{number:name}} an integer or decimal literal, rendered verbatim after validation
+//
+// When params is empty the statement is returned unchanged so SQL without parameters keeps
+// its literal meaning. Missing, unused and malformed parameters are errors.
+func renderSQLTemplate(sqlText string, params map[string]string) (string, error) {
+	if len(params) == 0 {
+		return sqlText, nil
+	}
+	var errs []string
+	used := map[string]bool{}
+	out := sqlPlaceholderRe.ReplaceAllStringFunc(sqlText, func(m string) string {
+		sub := sqlPlaceholderRe.FindStringSubmatch(m)
+		typ, name := sub[1], sub[2]
+		val, ok := params[name]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("placeholder %s references undefined parameter %q", m, name))
+			return m
+		}
+		used[name] = true
+		rendered, err := renderSQLParameter(typ, val)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("parameter %q: %v", name, err))
+			return m
+		}
+		return rendered
+	})
+	// Look for leftovers in the template, not the output: rendered values may contain "{{".
+	if len(errs) == 0 && strings.Contains(sqlPlaceholderRe.ReplaceAllString(sqlText, ""), "{{") {
+		errs = append(errs, "malformed placeholder, expected {{<type>:<parameter>}}")
+	}
+	var unused []string
+	for name := range params {
+		if !used[name] {
+			unused = append(unused, name)
+		}
+	}
+	slices.Sort(unused)
+	for _, name := range unused {
+		errs = append(errs, fmt.Sprintf("parameter %q is not used by any placeholder", name))
+	}
+	if len(errs) > 0 {
+		return "", fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return out, nil
+}
+
+// renderSQLParameter quotes a single parameter value for the given placeholder type.
+func renderSQLParameter(typ, val string) (string, error) {
+	switch typ {
+	case "ident":
+		if val == "" {
+			return "", fmt.Errorf("identifier must not be empty")
+		}
+		return quoteIdent(val), nil
+	case "fqn":
+		parts, err := parseFQN(val)
+		if err != nil {
+			return "", err
+		}
+		return quoteIdent(parts[0]) + "." + quoteIdent(parts[1]) + "." + quoteIdent(parts[2]), nil
+	case "string":
+		return quoteString(val), nil
+	case "number":
+		if !sqlNumberRe.MatchString(val) {
+			return "", fmt.Errorf("%q is not a number", val)
+		}
+		return val, nil
+	default:
+		return "", fmt.Errorf("unknown placeholder type %q, expected ident, fqn, string or number", typ)
+	}
+}
+
+// renderSQLOrRaw renders sqlText, falling back to the raw text when the template is invalid.
+// It is meant for diffing, where Check has already reported template errors.
+func renderSQLOrRaw(sqlText string, params map[string]string) string {
+	out, err := renderSQLTemplate(sqlText, params)
+	if err != nil {
+		return sqlText
+	}
+	return out
+}
+
+// renderedStateSQL returns the statement a resource was created with. States written before
+// parameters existed have no rendered SQL and fall back to rendering the stored inputs.
+func renderedStateSQL(rendered, sqlText string, params map[string]string) string {
+	if rendered != "" {
+		return rendered
+	}
+	return renderSQLOrRaw(sqlText, params)
+}
@@ -0,0 +1,149 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderSQLTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		sql     string
+		params  map[string]string
+		want    string
+		wantErr string
+	}{
+		{name: "no parameters keeps braces literal", sql: "SELECT '{{x}}' FROM t;", want: "SELECT '{{x}}' FROM t;"},
+		{name: "identifier", sql: "SELECT * FROM {{ident:tbl}};", params: map[string]string{"tbl": `we"ird`}, want: `SELECT * FROM "we""ird";`},
+		{name: "fqn", sql: "INSERT INTO {{ fqn : sink }} SELECT 1;", params: map[string]string{"sink": "db.ns.out"}, want: `INSERT INTO "db"."ns"."out" SELECT 1;`},
+		{name: "string", sql: "WHERE name = {{string:who}}", params: map[string]string{"who": "O'Brien"}, want: "WHERE name = 'O''Brien'"},
+		{name: "value containing braces", sql: "WHERE note = {{string:x}}", params: map[string]string{"x": "a{{b"}, want: "WHERE note = 'a{{b'"},
+		{name: "number", sql: "LIMIT {{number:n}}", params: map[string]string{"n": "-1.5"}, want: "LIMIT -1.5"},
+		{name: "repeated placeholder", sql: "{{ident:c}}, {{ident:c}}", params: map[string]string{"c": "a"}, want: `"a", "a"`},
+		{name: "invalid number", sql: "LIMIT {{number:n}}", params: map[string]string{"n": "1; DROP"}, wantErr: `parameter "n": "1; DROP" is not a number`},
+		{name: "missing parameter", sql: "{{ident:a}}", params: map[string]string{"b": "x"}, wantErr: `placeholder {{ident:a}} references undefined parameter "a"; parameter "b" is not used by any placeholder`},
+		{name: "unknown type", sql: "{{raw:a}}", params: map[string]string{"a": "x"}, wantErr: `parameter "a": unknown placeholder type "raw", expected ident, fqn, string or number`},
+		{name: "untyped placeholder", sql: "{{a}}", params: map[string]string{"a": "x"}, wantErr: `malformed placeholder, expected {{<type>:<parameter>}}; parameter "a" is not used by any placeholder`},
+		{name: "invalid fqn", sql: "{{fqn:a}}", params: map[string]string{"a": "db.out"}, wantErr: `parameter "a": invalid relation name "db.out", expected <database>.<namespace>.<name>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := renderSQLTemplate(tt.sql, tt.params)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryDiffUsesRenderedSQL(t *testing.T) {
+	t.Parallel()
+
+	literal := `INSERT INTO "db"."ns"."out" SELECT * FROM "db"."ns"."in";`
+	state := QueryState{
+		QueryArgs:   QueryArgs{SQL: literal, SinkRelationFqn: `"db"."ns"."out"`, SourceRelationFqns: []string{`"db"."ns"."in"`}},
+		RenderedSQL: literal,
+	}
+	tests := []struct {
+		name   string
+		source string
+		want   bool
+	}{
+		{name: "template rendering the same statement", source: "db.ns.in", want: false},
+		{name: "parameter change", source: "db.ns.other", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			in := state.QueryArgs
+			in.SQL = "INSERT INTO {{fqn:sink}} SELECT * FROM {{fqn:source}};"
+			in.Parameters = map[string]string{"sink": "db.ns.out", "source": tt.source}
+			resp, err := Query{}.Diff(context.Background(), infer.DiffRequest[QueryArgs, QueryState]{State: state, Inputs: in})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := resp.DetailedDiff["sql"]; ok != tt.want {
+				t.Errorf("sql diff = %v, want %v", ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderedSQLIsSecretWithSecretParameters(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seedRelations(t)
+
+	secretParams := property.New(property.NewMap(map[string]property.Value{
+		"token": property.New("s3cr3t-value"),
+	})).WithSecret(true)
+	tests := []struct {
+		resource string
+		inputs   map[string]property.Value
+	}{
+		{resource: "Query", inputs: map[string]property.Value{
+			"sql":                property.New(`INSERT INTO "db"."public"."pageviews" SELECT * FROM "db"."public"."pageviews" WHERE token = {{string:token}};`),
+			"sinkRelationFqn":    property.New(`"db"."public"."pageviews"`),
+			"sourceRelationFqns": property.New([]property.Value{property.New(`"db"."public"."pageviews"`)}),
+		}},
+		{resource: "Application", inputs: map[string]property.Value{
+			"sql":                property.New(`BEGIN APPLICATION app INSERT INTO "db"."public"."pageviews" SELECT * FROM "db"."public"."pageviews" WHERE token = {{string:token}}; END APPLICATION;`),
+			"sinkRelationFqns":   property.New([]property.Value{property.New(`"db"."public"."pageviews"`)}),
+			"sourceRelationFqns": property.New([]property.Value{property.New(`"db"."public"."pageviews"`)}),
+		}},
+		{resource: "DeltaStreamObject", inputs: map[string]property.Value{
+			"database":  property.New("db"),
+			"namespace": property.New("public"),
+			"store":     property.New("events"),
+			"sql":       property.New(`CREATE STREAM "tokens" (v BIGINT) WITH ('topic' = {{string:token}});`),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.resource, func(t *testing.T) {
+			t.Parallel()
+			inputs := maps.Clone(tt.inputs)
+			inputs["parameters"] = secretParams
+			resp, err := server.Create(p.CreateRequest{
+				Urn:        resource.NewURN("test", "provider", "", resourceType(tt.resource), "r"),
+				Properties: property.NewMap(inputs),
+				DryRun:     true,
+			})
+			require.NoError(t, err)
+			assert.True(t, resp.Properties.Get("renderedSql").Secret(), "renderedSql should be secret")
+		})
+	}
+}
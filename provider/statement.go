@@ -0,0 +1,295 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"k8s.io/utils/ptr"
+)
+
+// Statement resource runs user-supplied SQL for object types the provider does not model yet.
+type Statement struct{}
+
+// Annotate sets descriptions on Statement for schema generation.
+func (s *Statement) Annotate(a infer.Annotator) {
+	a.Describe(s, "Statement resource running arbitrary DeltaStream SQL for objects without a dedicated resource. createSql runs on create, updateSql (if set) applies createSql changes in place, deleteSql runs on delete and readSql probes existence and drift.")
+}
+
+// StatementArgs defines the statements run over the resource lifecycle and their scope.
+type StatementArgs struct {
+	CreateSQL string  `pulumi:"createSql"`
+	UpdateSQL *string `pulumi:"updateSql,optional"`
+	DeleteSQL *string `pulumi:"deleteSql,optional"`
+	ReadSQL   *string `pulumi:"readSql,optional"`
+	Database  *string `pulumi:"database,optional"`
+	Namespace *string `pulumi:"namespace,optional"`
+	Store     *string `pulumi:"store,optional"`
+	Owner     *string `pulumi:"owner,optional"`
+}
+
+// Annotate sets descriptions on StatementArgs fields for schema generation.
+func (a *StatementArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.CreateSQL, "Statement run on create. Changes replace the resource unless updateSql is set.")
+	an.Describe(&a.UpdateSQL, "Optional statement run when createSql changes, instead of replacing the resource")
+	an.Describe(&a.DeleteSQL, "Optional statement run on delete. Without it deleting the resource only removes it from the stack.")
+	an.Describe(&a.ReadSQL, "Optional query run after create/update and on refresh. Its rows become the readResult output; no rows means the object no longer exists.")
+	an.Describe(&a.Database, "Optional database the statements run in")
+	an.Describe(&a.Namespace, "Optional namespace the statements run in")
+	an.Describe(&a.Store, "Optional default store for the statements")
+	an.Describe(&a.Owner, "Optional role the statements execute as")
+}
+
+// StatementState extends inputs with the rows returned by readSql.
+type StatementState struct {
+	StatementArgs
+	ReadResult []map[string]string `pulumi:"readResult"`
+}
+
+// Annotate sets descriptions on StatementState fields for schema generation.
+func (s *StatementState) Annotate(a infer.Annotator) {
+	a.Describe(&s.ReadResult, "Rows returned by readSql, keyed by lower-cased column name. Empty when readSql is not set.")
+}
+
+// Check validates statement inputs.
+func (Statement) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[StatementArgs], error) {
+	args, failures, err := infer.DefaultCheck[StatementArgs](ctx, req.NewInputs)
+	if err != nil {
+		return infer.CheckResponse[StatementArgs]{}, err
+	}
+	if strings.TrimSpace(args.CreateSQL) == "" {
+		failures = append(failures, p.CheckFailure{Property: "createSql", Reason: "createSql required"})
+	}
+	optional := []struct {
+		prop string
+		stmt *string
+	}{{"updateSql", args.UpdateSQL}, {"deleteSql", args.DeleteSQL}, {"readSql", args.ReadSQL}}
+	for _, o := range optional {
+		if o.stmt != nil && strings.TrimSpace(*o.stmt) == "" {
+			failures = append(failures, p.CheckFailure{Property: o.prop, Reason: o.prop + " must not be empty when set"})
+		}
+	}
+	if args.ReadSQL != nil && strings.TrimSpace(*args.ReadSQL) != "" && !isReadStatement(*args.ReadSQL) {
+		failures = append(failures, p.CheckFailure{Property: "readSql", Reason: "readSql must be a SELECT, SHOW, LIST or DESCRIBE statement"})
+	}
+	if args.Namespace != nil && args.Database == nil {
+		failures = append(failures, p.CheckFailure{Property: "namespace", Reason: "namespace requires database"})
+	}
+	return infer.CheckResponse[StatementArgs]{Inputs: args, Failures: failures}, nil
+}
+
+// Diff applies createSql changes with updateSql when set and replaces the resource otherwise.
+// Changes to the other statements only update state. SQL is compared after normalizeSQL.
+func (Statement) Diff(ctx context.Context, req infer.DiffRequest[StatementArgs, StatementState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	if !sqlEqual(req.State.CreateSQL, req.Inputs.CreateSQL) {
+		if req.Inputs.UpdateSQL != nil {
+			diff["createSql"] = p.PropertyDiff{Kind: p.Update}
+		} else {
+			diff["createSql"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		}
+	}
+	optionalSQL := map[string][2]*string{
+		"updateSql": {req.State.UpdateSQL, req.Inputs.UpdateSQL},
+		"deleteSql": {req.State.DeleteSQL, req.Inputs.DeleteSQL},
+		"readSql":   {req.State.ReadSQL, req.Inputs.ReadSQL},
+	}
+	for prop, v := range optionalSQL {
+		if (v[0] == nil) != (v[1] == nil) || (v[0] != nil && !sqlEqual(*v[0], *v[1])) {
+			diff[prop] = p.PropertyDiff{Kind: p.Update}
+		}
+	}
+	scope := map[string][2]*string{
+		"database":  {req.State.Database, req.Inputs.Database},
+		"namespace": {req.State.Namespace, req.Inputs.Namespace},
+		"store":     {req.State.Store, req.Inputs.Store},
+		"owner":     {req.State.Owner, req.Inputs.Owner},
+	}
+	for prop, v := range scope {
+		if ptr.Deref(v[0], "") != ptr.Deref(v[1], "") {
+			diff[prop] = p.PropertyDiff{Kind: p.UpdateReplace}
+		}
+	}
+	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff, DeleteBeforeReplace: true}, nil
+}
+
+// Create runs createSql and then readSql, if set, to capture the initial readResult. When
+// readSql fails after createSql succeeded, the resource is still created with a partial state.
+func (Statement) Create(ctx context.Context, req infer.CreateRequest[StatementArgs]) (infer.CreateResponse[StatementState], error) {
+	in := req.Inputs
+	if req.DryRun {
+		return infer.CreateResponse[StatementState]{ID: "preview-statement", Output: StatementState{StatementArgs: in}}, nil
+	}
+	id := uuid.NewString()
+	st := StatementState{StatementArgs: in, ReadResult: []map[string]string{}}
+	created := false
+	err := withStatementConn(ctx, &in, func(ctx context.Context, conn *sql.Conn) error {
+		if _, err := conn.ExecContext(ctx, in.CreateSQL); err != nil {
+			return fmt.Errorf("failed to run createSql: %w", err)
+		}
+		created = true
+		rows, _, err := readStatement(ctx, conn, &in)
+		if err != nil {
+			return fmt.Errorf("failed to run readSql after create: %w", err)
+		}
+		st.ReadResult = rows
+		return nil
+	})
+	if err != nil {
+		if !created {
+			return infer.CreateResponse[StatementState]{}, err
+		}
+		// createSql ran, so record the resource for the next update or destroy to act on.
+		return infer.CreateResponse[StatementState]{ID: id, Output: st}, infer.ResourceInitFailedError{Reasons: []string{err.Error()}}
+	}
+	getLogger(ctx).Info(fmt.Sprintf("Statement created: %s", id))
+	return infer.CreateResponse[StatementState]{ID: id, Output: st}, nil
+}
+
+// Read runs readSql to refresh readResult. A query returning no rows means the object is
+// gone. Without readSql the stored state is returned unchanged.
+func (Statement) Read(ctx context.Context, req infer.ReadRequest[StatementArgs, StatementState]) (infer.ReadResponse[StatementArgs, StatementState], error) {
+	st := req.State
+	if st.ReadSQL == nil {
+		return infer.ReadResponse[StatementArgs, StatementState]{ID: req.ID, Inputs: st.StatementArgs, State: st}, nil
+	}
+	var exists bool
+	err := withStatementConn(ctx, &st.StatementArgs, func(ctx context.Context, conn *sql.Conn) error {
+		var err error
+		st.ReadResult, exists, err = readStatement(ctx, conn, &st.StatementArgs)
+		return err
+	})
+	if err != nil {
+		return infer.ReadResponse[StatementArgs, StatementState]{}, fmt.Errorf("failed to run readSql: %w", err)
+	}
+	if !exists {
+		return infer.ReadResponse[StatementArgs, StatementState]{}, nil
+	}
+	return infer.ReadResponse[StatementArgs, StatementState]{ID: req.ID, Inputs: st.StatementArgs, State: st}, nil
+}
+
+// Update runs updateSql when createSql changed, then refreshes readResult.
+func (Statement) Update(ctx context.Context, req infer.UpdateRequest[StatementArgs, StatementState]) (infer.UpdateResponse[StatementState], error) {
+	in := req.Inputs
+	st := req.State
+	st.StatementArgs = in
+	if req.DryRun {
+		return infer.UpdateResponse[StatementState]{Output: st}, nil
+	}
+	runUpdate := in.UpdateSQL != nil && !sqlEqual(req.State.CreateSQL, in.CreateSQL)
+	if !runUpdate && in.ReadSQL == nil {
+		st.ReadResult = nil
+		return infer.UpdateResponse[StatementState]{Output: st}, nil
+	}
+	err := withStatementConn(ctx, &in, func(ctx context.Context, conn *sql.Conn) error {
+		if runUpdate {
+			if _, err := conn.ExecContext(ctx, *in.UpdateSQL); err != nil {
+				return fmt.Errorf("failed to run updateSql: %w", err)
+			}
+		}
+		rows, _, err := readStatement(ctx, conn, &in)
+		if err != nil {
+			return fmt.Errorf("failed to run readSql after update: %w", err)
+		}
+		st.ReadResult = rows
+		return nil
+	})
+	if err != nil {
+		return infer.UpdateResponse[StatementState]{}, err
+	}
+	return infer.UpdateResponse[StatementState]{Output: st}, nil
+}
+
+// Delete runs deleteSql. Without it the resource is only removed from the stack.
+func (Statement) Delete(ctx context.Context, req infer.DeleteRequest[StatementState]) (infer.DeleteResponse, error) {
+	if req.State.DeleteSQL == nil {
+		getLogger(ctx).Warning(fmt.Sprintf("Statement %s has no deleteSql; the object it created is left in place", req.ID))
+		return infer.DeleteResponse{}, nil
+	}
+	err := withStatementConn(ctx, &req.State.StatementArgs, func(ctx context.Context, conn *sql.Conn) error {
+		if _, err := conn.ExecContext(ctx, *req.State.DeleteSQL); err != nil {
+			return fmt.Errorf("failed to run deleteSql: %w", err)
+		}
+		return nil
+	})
+	return infer.DeleteResponse{}, err
+}
+
+// WireDependencies declares resource graph dependencies for Pulumi.
+func (Statement) WireDependencies(f infer.FieldSelector, args *StatementArgs, state *StatementState) {
+	f.OutputField(&state.ReadResult).DependsOn(f.InputField(&args.CreateSQL))
+	f.OutputField(&state.ReadResult).DependsOn(f.InputField(&args.ReadSQL))
+}
+
+// withStatementConn opens a connection as the statement's owner (or the configured role) and
+// scopes it to the statement's database, namespace and store before calling fn.
+func withStatementConn(ctx context.Context, in *StatementArgs, fn func(context.Context, *sql.Conn) error) error {
+	return withScopedConn(ctx, in.Owner, in.Database, in.Namespace, in.Store, fn)
+}
+
+// withScopedConn opens a connection as owner (or the configured role), scopes it to the
+// given database, namespace and store and calls fn with it.
+func withScopedConn(ctx context.Context, owner, database, namespace, store *string, fn func(context.Context, *sql.Conn) error) error {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close() //nolint:errcheck
+	role := ptr.Deref(owner, ptr.Deref(cfg.Role, ""))
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return err
+	}
+	defer conn.Close() //nolint:errcheck
+	if err := setSQLContext(conn, ptr.Deref(database, ""), ptr.Deref(namespace, ""), ptr.Deref(store, "")); err != nil {
+		return err
+	}
+	return fn(ctx, conn)
+}
+
+// readStatement runs readSql and reports its rows and whether any were returned. Without
+// readSql it returns no rows and reports the object as existing.
+func readStatement(ctx context.Context, conn *sql.Conn, in *StatementArgs) ([]map[string]string, bool, error) {
+	if in.ReadSQL == nil {
+		return nil, true, nil
+	}
+	rows, err := conn.QueryContext(ctx, *in.ReadSQL)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close() //nolint:errcheck
+	list, err := scanRowMaps(rows)
+	if err != nil {
+		return nil, false, err
+	}
+	return list, len(list) > 0, nil
+}
+
+// isReadStatement reports whether stmt is a query that does not change anything.
+func isReadStatement(stmt string) bool {
+	switch strings.Fields(statementVerb(stmt))[0] {
+	case "SELECT", "SHOW", "LIST", "DESCRIBE":
+		return true
+	}
+	return false
+}
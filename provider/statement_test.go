@@ -0,0 +1,187 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestStatementDiff(t *testing.T) {
+	t.Parallel()
+	base := StatementArgs{
+		CreateSQL: `CREATE SECRET "token" WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'a' );`,
+		DeleteSQL: ptr.To(`DROP SECRET "token";`),
+	}
+	tests := []struct {
+		name   string
+		change func(*StatementArgs)
+		want   map[string]p.DiffKind
+	}{
+		{"unchanged", func(*StatementArgs) {}, map[string]p.DiffKind{}},
+		{"formatting only", func(a *StatementArgs) {
+			a.CreateSQL = "create secret \"token\"\n  WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'a' );"
+		}, map[string]p.DiffKind{}},
+		{"create without update replaces", func(a *StatementArgs) {
+			a.CreateSQL = `CREATE SECRET "token" WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'b' );`
+		}, map[string]p.DiffKind{"createSql": p.UpdateReplace}},
+		{"create with update", func(a *StatementArgs) {
+			a.CreateSQL = `CREATE SECRET "token" WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'b' );`
+			a.UpdateSQL = ptr.To(`UPDATE SECRET "token" WITH ( 'generic_string' = 'b' );`)
+		}, map[string]p.DiffKind{"createSql": p.Update, "updateSql": p.Update}},
+		{"delete and read", func(a *StatementArgs) {
+			a.DeleteSQL = nil
+			a.ReadSQL = ptr.To(`SELECT name FROM deltastream.sys."secrets";`)
+		}, map[string]p.DiffKind{"deleteSql": p.Update, "readSql": p.Update}},
+		{"scope", func(a *StatementArgs) {
+			a.Database = ptr.To("analytics")
+			a.Owner = ptr.To("deployer")
+		}, map[string]p.DiffKind{"database": p.UpdateReplace, "owner": p.UpdateReplace}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			in := base
+			tt.change(&in)
+			resp, err := Statement{}.Diff(context.Background(), infer.DiffRequest[StatementArgs, StatementState]{
+				State:  StatementState{StatementArgs: base},
+				Inputs: in,
+			})
+			require.NoError(t, err)
+			got := map[string]p.DiffKind{}
+			for k, d := range resp.DetailedDiff {
+				got[k] = d.Kind
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, len(tt.want) > 0, resp.HasChanges)
+		})
+	}
+}
+
+func TestIsReadStatement(t *testing.T) {
+	t.Parallel()
+	for stmt, want := range map[string]bool{
+		`SELECT name FROM deltastream.sys."secrets";`: true,
+		`  describe store "events";`:                  true,
+		`LIST ENTITIES IN STORE "events";`:            true,
+		`SHOW TASKS;`:                                 true,
+		`DROP SECRET "token";`:                        false,
+		``:                                            false,
+	} {
+		assert.Equal(t, want, isReadStatement(stmt), stmt)
+	}
+}
+
+func statementInputs(value string) property.Map {
+	return property.NewMap(map[string]property.Value{
+		"createSql": property.New(`CREATE SECRET "token" WITH ( 'type' = GENERIC_STRING, 'generic_string' = '` + value + `' );`),
+		"updateSql": property.New(`UPDATE SECRET "token" WITH ( 'generic_string' = '` + value + `' );`),
+		"deleteSql": property.New(`DROP SECRET "token";`),
+		"readSql":   property.New(`SELECT name, "owner" FROM deltastream.sys."secrets" WHERE name = 'token';`),
+		"owner":     property.New("deployer"),
+	})
+}
+
+func TestLifecycleStatement(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+
+	readResult := func(out property.Map) []property.Value {
+		return out.Get("readResult").AsArray().AsSlice()
+	}
+	integration.LifeCycleTest{
+		Resource: resourceType("Statement"),
+		Create: integration.Operation{
+			Inputs: statementInputs("a"),
+			Hook: func(_, out property.Map) {
+				require.NotNil(t, f.Named["SECRET/token"])
+				assert.Equal(t, "a", f.Named["SECRET/token"].Props["generic_string"])
+				rows := readResult(out)
+				require.Len(t, rows, 1)
+				assert.Equal(t, "token", rows[0].AsMap().Get("name").AsString())
+				assert.Equal(t, "deployer", rows[0].AsMap().Get("owner").AsString())
+			},
+		},
+		Updates: []integration.Operation{{
+			Inputs: statementInputs("b"),
+			Hook: func(_, out property.Map) {
+				require.NotNil(t, f.Named["SECRET/token"])
+				assert.Equal(t, "b", f.Named["SECRET/token"].Props["generic_string"])
+				assert.Len(t, readResult(out), 1)
+			},
+		}},
+	}.Run(t, server)
+	assert.Nil(t, f.Named["SECRET/token"])
+	assert.Contains(t, f.Executed(), `UPDATE SECRET "token" WITH ( 'generic_string' = 'b' )`)
+}
+
+func TestStatementReadGone(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("Statement"), "token")
+
+	resp, err := server.Create(p.CreateRequest{Urn: urn, Properties: statementInputs("a")})
+	require.NoError(t, err)
+	f.seed(t, `DROP SECRET "token";`)
+
+	read, err := server.Read(p.ReadRequest{ID: resp.ID, Urn: urn, Properties: resp.Properties})
+	require.NoError(t, err)
+	assert.Empty(t, read.ID, "readSql returning no rows should mark the statement deleted")
+}
+
+func TestStatementCreateKeepsStateWhenReadFails(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("Statement"), "token")
+
+	inputs := statementInputs("a").Set("readSql", property.New(`SELECT name FROM deltastream.sys."missing";`))
+	resp, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.Error(t, err)
+	require.NotNil(t, resp.PartialState, "a statement whose createSql ran should be recorded")
+	assert.Contains(t, resp.PartialState.Reasons[0], "failed to run readSql after create")
+	assert.NotEmpty(t, resp.ID)
+	require.NotNil(t, f.Named["SECRET/token"])
+
+	require.NoError(t, server.Delete(p.DeleteRequest{ID: resp.ID, Urn: urn, Properties: resp.Properties}))
+	assert.Nil(t, f.Named["SECRET/token"], "deleteSql should run for the partially created statement")
+}
+
+func TestStatementScope(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seedRelations(t)
+	urn := resource.NewURN("test", "provider", "", resourceType("Statement"), "scoped")
+
+	_, err := server.Create(p.CreateRequest{Urn: urn, Properties: property.NewMap(map[string]property.Value{
+		"createSql": property.New(`CREATE STREAM "clicks" (id VARCHAR) WITH ( 'topic' = 'clicks', 'value.format' = 'json' );`),
+		"deleteSql": property.New(`DROP RELATION "clicks";`),
+		"database":  property.New("db"),
+		"namespace": property.New("public"),
+		"store":     property.New("events"),
+	})})
+	require.NoError(t, err)
+	rel := f.Relations["db/public/clicks"]
+	require.NotNil(t, rel)
+	assert.Equal(t, "events", rel.Store)
+}
@@ -27,6 +27,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	ds "github.com/deltastreaminc/go-deltastream"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // readiness polling constants (spec D-012)
@@ -50,6 +51,14 @@ type StoreArgs struct {
 	Kafka     *KafkaInputs     `pulumi:"kafka,optional"`
 	Snowflake *SnowflakeInputs `pulumi:"snowflake,optional"`
 	Postgres  *PostgresInputs  `pulumi:"postgres,optional"`
+	// Terminate queries and drop relations backed by the store on delete (optional)
+	ForceDestroy *bool `pulumi:"forceDestroy,optional"`
+}
+
+// Annotate sets descriptions on StoreArgs fields for schema generation.
+func (a *StoreArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Owner, "Optional owning role. When set, statements execute as this role; changing it transfers ownership in place.")
+	an.Describe(&a.ForceDestroy, "When true, deleting the store first terminates running queries that use relations backed by it and drops those relations. When false (default), delete fails and lists them.")
 }
 
 // KafkaInputs moved to store_kafka.go
@@ -101,13 +110,16 @@ func (Store) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResp
 			failures = append(failures, validatePostgresInputs(args.Postgres)...)
 		}
 	}
+	if old, _, oerr := infer.DefaultCheck[StoreArgs](ctx, req.OldInputs); oerr == nil && old.Name != "" && old.Name != args.Name {
+		checkRename(ctx, old.Owner, "store "+old.Name, args.Name, "store_name = "+quoteString(old.Name))
+	}
 	return infer.CheckResponse[StoreArgs]{Inputs: args, Failures: failures}, nil
 }
 
 // Create provisions the external store and waits until ready.
 func (Store) Create(ctx context.Context, req infer.CreateRequest[StoreArgs]) (infer.CreateResponse[StoreState], error) {
 	input := req.Inputs
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	logger.Debug(fmt.Sprintf("Creating store %s", input.Name))
 	if req.DryRun {
 		st := StoreState{StoreArgs: input, Type: "", CreatedAt: time.Now().UTC().Format(time.RFC3339)}
@@ -181,6 +193,15 @@ func (Store) Read(ctx context.Context, req infer.ReadRequest[StoreArgs, StoreSta
 		return infer.ReadResponse[StoreArgs, StoreState]{}, err
 	}
 	st := req.State
+	if st.Kafka == nil && st.Snowflake == nil && st.Postgres == nil {
+		// Import: rebuild the subtype block from DESCRIBE STORE. Credentials are never
+		// returned by the catalog and must be supplied in the program.
+		props, derr := describeStore(ctx, conn, req.ID)
+		if derr != nil {
+			return infer.ReadResponse[StoreArgs, StoreState]{}, fmt.Errorf("failed to describe store: %w", derr)
+		}
+		st.StoreArgs = storeArgsFromDescribe(req.ID, sr.Type, props)
+	}
 	st.Type = sr.Type
 	st.State = sr.State
 	st.CreatedAt = sr.CreatedAt.Format(time.RFC3339)
@@ -189,8 +210,65 @@ func (Store) Read(ctx context.Context, req infer.ReadRequest[StoreArgs, StoreSta
 	return infer.ReadResponse[StoreArgs, StoreState]{ID: req.ID, Inputs: st.StoreArgs, State: st}, nil
 }
 
-// Update delegates to subtype update logic when supported.
+// Update transfers ownership when the owner changed and then delegates to the subtype update.
 func (Store) Update(ctx context.Context, req infer.UpdateRequest[StoreArgs, StoreState]) (infer.UpdateResponse[StoreState], error) {
+	if req.Inputs.Owner == nil || *req.Inputs.Owner == req.State.OwnerOut {
+		return storeSubtypeUpdate(ctx, req)
+	}
+	if req.DryRun {
+		st := req.State
+		st.StoreArgs = req.Inputs
+		st.OwnerOut = *req.Inputs.Owner
+		return infer.UpdateResponse[StoreState]{Output: st}, nil
+	}
+	owner, err := transferStoreOwner(ctx, req.ID, req.State.OwnerOut, *req.Inputs.Owner)
+	if err != nil {
+		return infer.UpdateResponse[StoreState]{}, err
+	}
+	// Subtype updates run as the new owner.
+	req.State.Owner = &owner
+	req.State.OwnerOut = owner
+	resp, err := storeSubtypeUpdate(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	resp.Output.OwnerOut = owner
+	return resp, nil
+}
+
+// transferStoreOwner runs ALTER STORE ... OWNER TO as the current owner and returns the
+// owner reported by the catalog afterwards.
+func transferStoreOwner(ctx context.Context, name, currOwner, newOwner string) (string, error) {
+	cfg := infer.GetConfig[Config](ctx)
+	db, err := openDB(ctx, &cfg)
+	if err != nil {
+		return "", err
+	}
+	defer db.Close() //nolint:errcheck
+	role := currOwner
+	if role == "" {
+		role = ptr.Deref(cfg.Role, "")
+	}
+	org := ptr.Deref(cfg.Organization, "")
+	ctx, conn, err := withOrgRole(ctx, db, org, role)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close() //nolint:errcheck
+	stmt := fmt.Sprintf("ALTER STORE %s OWNER TO %s;", quoteIdent(name), quoteIdent(newOwner))
+	if _, err := conn.ExecContext(ctx, stmt); err != nil {
+		return "", fmt.Errorf("failed altering owner: %w", err)
+	}
+	sr, err := lookupStore(ctx, conn, name)
+	if err != nil {
+		return "", err
+	}
+	getLogger(ctx).Info(fmt.Sprintf("Store %s owner changed to %s", name, sr.Owner))
+	return sr.Owner, nil
+}
+
+// storeSubtypeUpdate delegates to subtype update logic when supported.
+func storeSubtypeUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs, StoreState]) (infer.UpdateResponse[StoreState], error) {
 	if req.Inputs.Kafka != nil && req.State.Kafka != nil && req.Inputs.Snowflake == nil && req.State.Snowflake == nil {
 		return storeKafkaUpdate(ctx, req)
 	}
@@ -218,9 +296,20 @@ func (Store) Delete(ctx context.Context, req infer.DeleteRequest[StoreState]) (i
 		return infer.DeleteResponse{}, err
 	}
 	defer conn.Close() //nolint:errcheck
+	rels, err := listRelationPaths(ctx, conn, "store_name = "+quoteString(req.ID))
+	if err != nil {
+		return infer.DeleteResponse{}, fmt.Errorf("failed to list relations backed by store %s: %w", req.ID, err)
+	}
+	if err := clearDependents(ctx, conn, "store "+req.ID, rels, true, req.State.ForceDestroy); err != nil {
+		return infer.DeleteResponse{}, err
+	}
 	stmt := fmt.Sprintf("DROP STORE %s;", quoteIdent(req.ID))
 	if _, err := conn.ExecContext(ctx, stmt); err != nil {
-		return infer.DeleteResponse{}, err
+		var sqlErr ds.ErrSQLError
+		if errors.As(err, &sqlErr) && sqlErr.SQLCode == ds.SqlStateInvalidStore {
+			return infer.DeleteResponse{}, nil
+		}
+		return infer.DeleteResponse{}, fmt.Errorf("failed to delete store %s: %w", req.ID, err)
 	}
 	// Verify disappearance (best-effort with small timeout)
 	ctxCheck, cancel := context.WithTimeout(ctx, time.Minute)
@@ -235,14 +324,14 @@ func (Store) Delete(ctx context.Context, req infer.DeleteRequest[StoreState]) (i
 				// gone
 				return infer.DeleteResponse{}, nil
 			}
-			logger := p.GetLogger(ctx)
+			logger := getLogger(ctx)
 			logger.Debug(fmt.Sprintf("unexpected error while verifying deletion of store %s: %v", req.ID, err))
 		}
 		select {
 		case <-t.C:
 		case <-ctxCheck.Done():
-			// timeout - return anyway
-			return infer.DeleteResponse{}, nil
+			err := fmt.Errorf("store %s still exists one minute after DROP STORE; it may still be deleting, re-run the delete to confirm", req.ID)
+			return infer.DeleteResponse{}, lenientDeleteErr(ctx, &cfg, err)
 		}
 	}
 
@@ -254,12 +343,17 @@ type storeRow struct {
 	CreatedAt, UpdatedAt time.Time
 }
 
-// lookupStore fetches basic store metadata from the catalog.
+// lookupStore fetches basic store metadata from the catalog. A store with no catalog row is
+// reported as ds.SqlStateInvalidStore, the error Read and Delete treat as "gone"; the catalog
+// query itself returns no rows rather than that error.
 func lookupStore(ctx context.Context, conn *sql.Conn, name string) (storeRow, error) {
-	q := fmt.Sprintf("SELECT type, status, \"owner\", created_at, updated_at FROM deltastream.sys.\"stores\" WHERE name = '%s';", strings.ReplaceAll(name, "'", "''"))
+	q := fmt.Sprintf("SELECT type, status, \"owner\", created_at, updated_at FROM deltastream.sys.\"stores\" WHERE name = %s;", quoteString(name))
 	row := conn.QueryRowContext(ctx, q)
 	var r storeRow
 	if err := row.Scan(&r.Type, &r.State, &r.Owner, &r.CreatedAt, &r.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return r, ds.ErrSQLError{SQLCode: ds.SqlStateInvalidStore, Message: fmt.Sprintf("store %s not found", name)}
+		}
 		return r, err
 	}
 	return r, nil
@@ -267,21 +361,24 @@ func lookupStore(ctx context.Context, conn *sql.Conn, name string) (storeRow, er
 
 // waitForStoreReady polls until status=ready or errored.
 func waitForStoreReady(ctx context.Context, conn *sql.Conn, name string) (storeRow, error) {
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	deadline := time.Now().Add(storeReadinessTimeout)
 	var last storeRow
 	t := time.NewTicker(storePollInterval)
 	defer t.Stop()
-	for {
+	for attempt := 1; ; attempt++ {
 		if time.Now().After(deadline) {
 			// attempt to fetch last status_message for richer timeout diagnostics
 			msg := ""
-			mrow := conn.QueryRowContext(ctx, fmt.Sprintf("SELECT status_message FROM deltastream.sys.\"stores\" WHERE name = '%s';", strings.ReplaceAll(name, "'", "''")))
+			mrow := conn.QueryRowContext(ctx, fmt.Sprintf("SELECT status_message FROM deltastream.sys.\"stores\" WHERE name = %s;", quoteString(name)))
 			_ = mrow.Scan(&msg)
 			return last, fmt.Errorf("timeout waiting for store %s to become ready (last state=%s, lastStatusMessage=%s)", name, last.State, msg)
 		}
-		row := conn.QueryRowContext(ctx, fmt.Sprintf("SELECT type, status, \"owner\", created_at, updated_at FROM deltastream.sys.\"stores\" WHERE name = '%s';", strings.ReplaceAll(name, "'", "''")))
-		if err := row.Scan(&last.Type, &last.State, &last.Owner, &last.CreatedAt, &last.UpdatedAt); err != nil {
+		pctx, span := startPollSpan(ctx, "store ready", attempt, attribute.String("deltastream.store", name))
+		row := conn.QueryRowContext(pctx, fmt.Sprintf("SELECT type, status, \"owner\", created_at, updated_at FROM deltastream.sys.\"stores\" WHERE name = %s;", quoteString(name)))
+		err := row.Scan(&last.Type, &last.State, &last.Owner, &last.CreatedAt, &last.UpdatedAt)
+		endPollSpan(pctx, span, last.State, err)
+		if err != nil {
 			return last, err
 		}
 		stateLower := strings.ToLower(last.State)
@@ -290,7 +387,7 @@ func waitForStoreReady(ctx context.Context, conn *sql.Conn, name string) (storeR
 			return last, nil
 		case "errored":
 			msg := ""
-			mrow := conn.QueryRowContext(ctx, fmt.Sprintf("SELECT status_message FROM deltastream.sys.\"stores\" WHERE name = '%s';", strings.ReplaceAll(name, "'", "''")))
+			mrow := conn.QueryRowContext(ctx, fmt.Sprintf("SELECT status_message FROM deltastream.sys.\"stores\" WHERE name = %s;", quoteString(name)))
 			_ = mrow.Scan(&msg)
 			return last, fmt.Errorf("store %s errored: %s", name, msg)
 		}
@@ -303,6 +400,127 @@ func waitForStoreReady(ctx context.Context, conn *sql.Conn, name string) (storeR
 	}
 }
 
+// describeStore returns the first DESCRIBE STORE row keyed by normalized column name.
+func describeStore(ctx context.Context, conn *sql.Conn, name string) (map[string]string, error) {
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("DESCRIBE STORE %s;", quoteIdent(name)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+	list, err := scanRowMaps(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return map[string]string{}, nil
+	}
+	return list[0], nil
+}
+
+// storeProp looks up a DESCRIBE STORE value by its WITH property name, also accepting the
+// column spelling with dots as underscores and the name without its store-type prefix.
+func storeProp(props map[string]string, key string) string {
+	candidates := []string{key, strings.ReplaceAll(key, ".", "_")}
+	if _, short, ok := strings.Cut(key, "."); ok {
+		candidates = append(candidates, short, strings.ReplaceAll(short, ".", "_"))
+	}
+	for _, c := range candidates {
+		if v := strings.TrimSpace(props[c]); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func storePropPtr(props map[string]string, key string) *string {
+	if v := storeProp(props, key); v != "" {
+		return &v
+	}
+	return nil
+}
+
+func storePropBool(props map[string]string, key string) *bool {
+	switch strings.ToLower(storeProp(props, key)) {
+	case "true":
+		return ptr.To(true)
+	case "false":
+		return ptr.To(false)
+	}
+	return nil
+}
+
+// storePropSecret returns the secret named by a property DESCRIBE STORE reports as a secret
+// reference, or nil when the property holds no reference.
+func storePropSecret(props map[string]string, key string) *string {
+	rest, ok := strings.CutPrefix(storeProp(props, key), "SECRET ")
+	if !ok {
+		return nil
+	}
+	name := strings.TrimSpace(rest)
+	if len(name) >= 2 && name[0] == '"' && name[len(name)-1] == '"' {
+		name = strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+	}
+	return &name
+}
+
+// credentialChange returns the WITH value for a credential given either literally or as a
+// reference to a Secret, and whether it differs from the previous inputs. A reference wins
+// over a literal value; clearing both sends NULL.
+func credentialChange(newVal, newSecret, oldVal, oldSecret *string) (string, bool) {
+	if ref := ptr.Deref(newSecret, ""); ref != "" {
+		if ref == ptr.Deref(oldSecret, "") {
+			return "", false
+		}
+		return secretRef(ref), true
+	}
+	val := ptr.Deref(newVal, "")
+	if val == ptr.Deref(oldVal, "") && ptr.Deref(oldSecret, "") == "" {
+		return "", false
+	}
+	if val == "" {
+		return "NULL", true
+	}
+	return quoteString(val), true
+}
+
+// storeArgsFromDescribe rebuilds store inputs from DESCRIBE STORE output. Secret fields
+// (SASL credentials, passwords, client keys) are left empty unless they reference a Secret.
+func storeArgsFromDescribe(name, typ string, props map[string]string) StoreArgs {
+	in := StoreArgs{Name: name}
+	switch strings.ToUpper(typ) {
+	case "KAFKA":
+		in.Kafka = &KafkaInputs{
+			Uris:                    storeProp(props, "uris"),
+			SaslHashFunction:        storeProp(props, "kafka.sasl.hash_function"),
+			SchemaRegistryName:      storePropPtr(props, "kafka.schema_registry_name"),
+			MskIamRoleArn:           storePropPtr(props, "kafka.msk.iam_role_arn"),
+			MskAwsRegion:            storePropPtr(props, "kafka.msk.aws_region"),
+			SaslPasswordSecret:      storePropSecret(props, "kafka.sasl.password"),
+			TlsDisabled:             storePropBool(props, "tls.disabled"),
+			TlsVerifyServerHostname: storePropBool(props, "tls.verify_server_hostname"),
+		}
+	case "SNOWFLAKE":
+		in.Snowflake = &SnowflakeInputs{
+			Uris:            storeProp(props, "uris"),
+			AccountId:       storeProp(props, "snowflake.account_id"),
+			RoleName:        storeProp(props, "snowflake.role_name"),
+			Username:        storeProp(props, "snowflake.username"),
+			WarehouseName:   storeProp(props, "snowflake.warehouse_name"),
+			CloudRegion:     storeProp(props, "snowflake.cloud.region"),
+			ClientKeySecret: storePropSecret(props, "snowflake.client.key_file"),
+		}
+	case "POSTGRESQL", "POSTGRES":
+		in.Postgres = &PostgresInputs{
+			Uris:                    storeProp(props, "uris"),
+			Username:                storeProp(props, "postgres.username"),
+			PasswordSecret:          storePropSecret(props, "postgres.password"),
+			TlsDisabled:             storePropBool(props, "tls.disabled"),
+			TlsVerifyServerHostname: storePropBool(props, "tls.verify_server_hostname"),
+		}
+	}
+	return in
+}
+
 func boolToSql(b bool) string {
 	if b {
 		return "TRUE"
@@ -312,6 +530,35 @@ func boolToSql(b bool) string {
 
 // Diff determines replacement vs in-place update across supported subtypes.
 func (Store) Diff(ctx context.Context, req infer.DiffRequest[StoreArgs, StoreState]) (infer.DiffResponse, error) {
+	resp, err := storeSubtypeDiff(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	extra := map[string]p.PropertyDiff{}
+	if forceDestroyChanged(req.State.ForceDestroy, req.Inputs.ForceDestroy) {
+		extra["forceDestroy"] = p.PropertyDiff{Kind: p.Update}
+	}
+	// Compare against the server-side owner so out-of-band transfers are reverted.
+	if req.Inputs.Owner != nil && *req.Inputs.Owner != req.State.OwnerOut {
+		extra["owner"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if len(extra) == 0 {
+		return resp, nil
+	}
+	if resp.DetailedDiff == nil {
+		resp.DetailedDiff = map[string]p.PropertyDiff{}
+	}
+	for k, v := range extra {
+		if _, ok := resp.DetailedDiff[k]; !ok {
+			resp.DetailedDiff[k] = v
+		}
+	}
+	resp.HasChanges = true
+	return resp, nil
+}
+
+// storeSubtypeDiff dispatches to the Kafka, Snowflake or Postgres diff.
+func storeSubtypeDiff(ctx context.Context, req infer.DiffRequest[StoreArgs, StoreState]) (infer.DiffResponse, error) {
 	if (req.State.Kafka != nil) != (req.Inputs.Kafka != nil) || (req.State.Snowflake != nil) != (req.Inputs.Snowflake != nil) || (req.State.Postgres != nil) != (req.Inputs.Postgres != nil) {
 		diff := map[string]p.PropertyDiff{}
 		diff["kafka"] = p.PropertyDiff{Kind: p.UpdateReplace}
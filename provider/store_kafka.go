@@ -37,6 +37,7 @@ type KafkaInputs struct {
 	SaslHashFunction        string  `pulumi:"saslHashFunction"`
 	SaslUsername            *string `pulumi:"saslUsername,optional" provider:"secret"`
 	SaslPassword            *string `pulumi:"saslPassword,optional" provider:"secret"`
+	SaslPasswordSecret      *string `pulumi:"saslPasswordSecret,optional"` // name of a Secret holding the SASL password
 	MskIamRoleArn           *string `pulumi:"mskIamRoleArn,optional"`
 	MskAwsRegion            *string `pulumi:"mskAwsRegion,optional"`
 	TlsDisabled             *bool   `pulumi:"tlsDisabled,optional"`
@@ -69,15 +70,20 @@ func validateKafkaInputs(k *KafkaInputs) []p.CheckFailure {
 		if k.MskAwsRegion == nil || *k.MskAwsRegion == "" {
 			failures = append(failures, p.CheckFailure{Property: "kafka.mskAwsRegion", Reason: "mskAwsRegion required when saslHashFunction=AWS_MSK_IAM"})
 		}
-		if (k.SaslUsername != nil && *k.SaslUsername != "") || (k.SaslPassword != nil && *k.SaslPassword != "") {
+		if (k.SaslUsername != nil && *k.SaslUsername != "") || (k.SaslPassword != nil && *k.SaslPassword != "") || (k.SaslPasswordSecret != nil && *k.SaslPasswordSecret != "") {
 			failures = append(failures, p.CheckFailure{Property: "kafka.saslUsername", Reason: "saslUsername/password not allowed for AWS_MSK_IAM"})
 		}
 	} else if isSASL {
 		if k.SaslUsername == nil || *k.SaslUsername == "" {
 			failures = append(failures, p.CheckFailure{Property: "kafka.saslUsername", Reason: "saslUsername required for SCRAM mode"})
 		}
-		if k.SaslPassword == nil || *k.SaslPassword == "" {
-			failures = append(failures, p.CheckFailure{Property: "kafka.saslPassword", Reason: "saslPassword required for SCRAM mode"})
+		hasPassword := k.SaslPassword != nil && *k.SaslPassword != ""
+		hasSecret := k.SaslPasswordSecret != nil && *k.SaslPasswordSecret != ""
+		if !hasPassword && !hasSecret {
+			failures = append(failures, p.CheckFailure{Property: "kafka.saslPassword", Reason: "saslPassword or saslPasswordSecret required for SCRAM mode"})
+		}
+		if hasPassword && hasSecret {
+			failures = append(failures, p.CheckFailure{Property: "kafka.saslPasswordSecret", Reason: "saslPassword and saslPasswordSecret are mutually exclusive"})
 		}
 	}
 	if k.TlsDisabled != nil && *k.TlsDisabled {
@@ -91,8 +97,8 @@ func validateKafkaInputs(k *KafkaInputs) []p.CheckFailure {
 func storeKafkaCreate(ctx context.Context, conn *sql.Conn, input *StoreArgs) error {
 	k := input.Kafka
 	params := map[string]string{
-		"kafka.sasl.hash_function": k.SaslHashFunction,
-		"uris":                     fmt.Sprintf("'%s'", k.Uris),
+		"kafka.sasl.hash_function": quoteString(k.SaslHashFunction),
+		"uris":                     quoteString(k.Uris),
 	}
 	if k.TlsDisabled != nil {
 		params["tls.disabled"] = boolToSql(*k.TlsDisabled)
@@ -105,21 +111,23 @@ func storeKafkaCreate(ctx context.Context, conn *sql.Conn, input *StoreArgs) err
 	}
 	if strings.EqualFold(k.SaslHashFunction, "AWS_MSK_IAM") {
 		if k.MskIamRoleArn != nil {
-			params["kafka.msk.iam_role_arn"] = fmt.Sprintf("'%s'", *k.MskIamRoleArn)
+			params["kafka.msk.iam_role_arn"] = quoteString(*k.MskIamRoleArn)
 		}
 		if k.MskAwsRegion != nil {
-			params["kafka.msk.aws_region"] = fmt.Sprintf("'%s'", *k.MskAwsRegion)
+			params["kafka.msk.aws_region"] = quoteString(*k.MskAwsRegion)
 		}
 	} else if strings.EqualFold(k.SaslHashFunction, "PLAIN") || strings.EqualFold(k.SaslHashFunction, "SHA512") || strings.EqualFold(k.SaslHashFunction, "SHA256") {
 		if k.SaslUsername != nil {
-			params["kafka.sasl.username"] = fmt.Sprintf("'%s'", *k.SaslUsername)
+			params["kafka.sasl.username"] = quoteString(*k.SaslUsername)
 		}
-		if k.SaslPassword != nil {
-			params["kafka.sasl.password"] = fmt.Sprintf("'%s'", *k.SaslPassword)
+		if k.SaslPasswordSecret != nil && *k.SaslPasswordSecret != "" {
+			params["kafka.sasl.password"] = secretRef(*k.SaslPasswordSecret)
+		} else if k.SaslPassword != nil {
+			params["kafka.sasl.password"] = quoteString(*k.SaslPassword)
 		}
 	}
 	if k.SchemaRegistryName != nil {
-		params["kafka.schema_registry_name"] = fmt.Sprintf("'%s'", *k.SchemaRegistryName)
+		params["kafka.schema_registry_name"] = quoteString(*k.SchemaRegistryName)
 	}
 	if k.TlsCaCertFile != nil && (k.TlsDisabled == nil || !*k.TlsDisabled) {
 		content, err := os.ReadFile(*k.TlsCaCertFile)
@@ -134,11 +142,7 @@ func storeKafkaCreate(ctx context.Context, conn *sql.Conn, input *StoreArgs) err
 		if v == "" {
 			continue
 		}
-		if v == "TRUE" || v == "FALSE" {
-			pairs = append(pairs, fmt.Sprintf("'%s' = %s", kkey, v))
-		} else {
-			pairs = append(pairs, fmt.Sprintf("'%s' = %s", kkey, v))
-		}
+		pairs = append(pairs, withProperty(kkey, v))
 	}
 	stmt := fmt.Sprintf("CREATE STORE %s WITH ( 'type' = KAFKA, %s );", quoteIdent(input.Name), strings.Join(pairs, ", "))
 	if _, err := conn.ExecContext(ctx, stmt); err != nil {
@@ -177,18 +181,15 @@ func storeKafkaUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs, St
 			if newVal == "" {
 				changes[key] = "NULL"
 			} else {
-				esc := strings.ReplaceAll(newVal, "'", "''")
-				changes[key] = fmt.Sprintf("'%s'", esc)
+				changes[key] = quoteString(newVal)
 			}
 		}
 	}
 	if curr.Uris != old.Uris {
-		esc := strings.ReplaceAll(curr.Uris, "'", "''")
-		changes["uris"] = fmt.Sprintf("'%s'", esc)
+		changes["uris"] = quoteString(curr.Uris)
 	}
 	if curr.SaslHashFunction != old.SaslHashFunction {
-		esc := strings.ReplaceAll(curr.SaslHashFunction, "'", "''")
-		changes["kafka.sasl.hash_function"] = fmt.Sprintf("'%s'", esc)
+		changes["kafka.sasl.hash_function"] = quoteString(curr.SaslHashFunction)
 	}
 	isMSKNew := strings.EqualFold(curr.SaslHashFunction, "AWS_MSK_IAM")
 	isMSKOld := strings.EqualFold(old.SaslHashFunction, "AWS_MSK_IAM")
@@ -197,7 +198,7 @@ func storeKafkaUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs, St
 			if old.SaslUsername != nil {
 				changes["kafka.sasl.username"] = "NULL"
 			}
-			if old.SaslPassword != nil {
+			if old.SaslPassword != nil || old.SaslPasswordSecret != nil {
 				changes["kafka.sasl.password"] = "NULL"
 			}
 		}
@@ -213,7 +214,9 @@ func storeKafkaUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs, St
 			}
 		}
 		setIfChanged("kafka.sasl.username", curr.SaslUsername, old.SaslUsername)
-		setIfChanged("kafka.sasl.password", curr.SaslPassword, old.SaslPassword)
+		if v, changed := credentialChange(curr.SaslPassword, curr.SaslPasswordSecret, old.SaslPassword, old.SaslPasswordSecret); changed {
+			changes["kafka.sasl.password"] = v
+		}
 	}
 	setIfChanged("kafka.schema_registry_name", curr.SchemaRegistryName, old.SchemaRegistryName)
 	if (curr.TlsDisabled == nil) != (old.TlsDisabled == nil) || (curr.TlsDisabled != nil && old.TlsDisabled != nil && *curr.TlsDisabled != *old.TlsDisabled) {
@@ -243,7 +246,10 @@ func storeKafkaUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs, St
 		}
 	}
 	if len(changes) == 0 {
-		return infer.UpdateResponse[StoreState]{}, nil
+		// Nothing to send (e.g. only forceDestroy changed); keep state in step with inputs.
+		newState := req.State
+		newState.StoreArgs = input
+		return infer.UpdateResponse[StoreState]{Output: newState}, nil
 	}
 	cfg := infer.GetConfig[Config](ctx)
 	db, err := openDB(ctx, &cfg)
@@ -260,7 +266,7 @@ func storeKafkaUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs, St
 	defer conn.Close() //nolint:errcheck
 	parts := make([]string, 0, len(changes))
 	for k, v := range changes {
-		parts = append(parts, fmt.Sprintf("'%s' = %s", k, v))
+		parts = append(parts, withProperty(k, v))
 	}
 	stmt := fmt.Sprintf("UPDATE STORE %s WITH ( %s );", quoteIdent(req.ID), strings.Join(parts, ", "))
 	if _, err := conn.ExecContext(ctx, stmt); err != nil {
@@ -301,6 +307,9 @@ func storeKafkaDiff(ctx context.Context, req infer.DiffRequest[StoreArgs, StoreS
 		if (s.SaslPassword == nil) != (n.SaslPassword == nil) || (s.SaslPassword != nil && n.SaslPassword != nil && *s.SaslPassword != *n.SaslPassword) {
 			diff["kafka.saslPassword"] = p.PropertyDiff{Kind: p.Update}
 		}
+		if ptr.Deref(s.SaslPasswordSecret, "") != ptr.Deref(n.SaslPasswordSecret, "") {
+			diff["kafka.saslPasswordSecret"] = p.PropertyDiff{Kind: p.Update}
+		}
 		if (s.MskIamRoleArn == nil) != (n.MskIamRoleArn == nil) || (s.MskIamRoleArn != nil && n.MskIamRoleArn != nil && *s.MskIamRoleArn != *n.MskIamRoleArn) {
 			diff["kafka.mskIamRoleArn"] = p.PropertyDiff{Kind: p.Update}
 		}
@@ -30,11 +30,12 @@ import (
 // a comma-separated list of hosts (with or without explicit scheme/port). Ports are
 // normalized to 5432 where absent.
 type PostgresInputs struct {
-	Uris                    string `pulumi:"uris"`
-	Username                string `pulumi:"username"`
-	Password                string `pulumi:"password" provider:"secret"`
-	TlsDisabled             *bool  `pulumi:"tlsDisabled,optional"`
-	TlsVerifyServerHostname *bool  `pulumi:"tlsVerifyServerHostname,optional"`
+	Uris                    string  `pulumi:"uris"`
+	Username                string  `pulumi:"username"`
+	Password                string  `pulumi:"password,optional" provider:"secret"`
+	PasswordSecret          *string `pulumi:"passwordSecret,optional"` // name of a Secret holding the password
+	TlsDisabled             *bool   `pulumi:"tlsDisabled,optional"`
+	TlsVerifyServerHostname *bool   `pulumi:"tlsVerifyServerHostname,optional"`
 }
 
 // validatePostgresInputs performs input validation returning Pulumi check failures.
@@ -49,8 +50,12 @@ func validatePostgresInputs(pg *PostgresInputs) []p.CheckFailure {
 	if pg.Username == "" {
 		failures = append(failures, p.CheckFailure{Property: "postgres.username", Reason: "username is required"})
 	}
-	if pg.Password == "" {
-		failures = append(failures, p.CheckFailure{Property: "postgres.password", Reason: "password is required"})
+	hasSecret := pg.PasswordSecret != nil && *pg.PasswordSecret != ""
+	if pg.Password == "" && !hasSecret {
+		failures = append(failures, p.CheckFailure{Property: "postgres.password", Reason: "password or passwordSecret is required"})
+	}
+	if pg.Password != "" && hasSecret {
+		failures = append(failures, p.CheckFailure{Property: "postgres.passwordSecret", Reason: "password and passwordSecret are mutually exclusive"})
 	}
 	return failures
 }
@@ -66,7 +71,11 @@ func storePostgresCreate(ctx context.Context, conn *sql.Conn, input *StoreArgs)
 	}
 	params := []string{"'type' = POSTGRESQL"}
 	params = append(params, fmt.Sprintf("'postgres.username' = %s", quoteString(pg.Username)))
-	params = append(params, fmt.Sprintf("'postgres.password' = %s", quoteString(pg.Password)))
+	if pg.PasswordSecret != nil && *pg.PasswordSecret != "" {
+		params = append(params, fmt.Sprintf("'postgres.password' = %s", secretRef(*pg.PasswordSecret)))
+	} else {
+		params = append(params, fmt.Sprintf("'postgres.password' = %s", quoteString(pg.Password)))
+	}
 	params = append(params, fmt.Sprintf("'uris' = %s", quoteString(pg.Uris)))
 	if pg.TlsDisabled != nil {
 		if *pg.TlsDisabled {
@@ -113,8 +122,8 @@ func storePostgresUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs,
 	if req.Inputs.Postgres.Username != req.State.Postgres.Username {
 		changes["postgres.username"] = quoteString(req.Inputs.Postgres.Username)
 	}
-	if req.Inputs.Postgres.Password != req.State.Postgres.Password {
-		changes["postgres.password"] = quoteString(req.Inputs.Postgres.Password)
+	if v, changed := credentialChange(&req.Inputs.Postgres.Password, req.Inputs.Postgres.PasswordSecret, &req.State.Postgres.Password, req.State.Postgres.PasswordSecret); changed {
+		changes["postgres.password"] = v
 	}
 	// normalize both sides for fair comparison
 	newUris, err := normalizePostgresUris(ctx, req.Inputs.Postgres.Uris)
@@ -159,7 +168,7 @@ func storePostgresUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs,
 	if len(changes) > 0 {
 		parts := []string{}
 		for k, v := range changes {
-			parts = append(parts, fmt.Sprintf("'%s' = %s", k, v))
+			parts = append(parts, withProperty(k, v))
 		}
 		stmt := fmt.Sprintf("UPDATE STORE %s WITH (%s);", quoteIdent(req.ID), joinComma(parts))
 		if _, err := conn.ExecContext(ctx, stmt); err != nil {
@@ -185,6 +194,9 @@ func storePostgresDiff(ctx context.Context, req infer.DiffRequest[StoreArgs, Sto
 	if req.Inputs.Postgres.Password != req.State.Postgres.Password {
 		diff["postgres.password"] = p.PropertyDiff{Kind: p.Update}
 	}
+	if ptr.Deref(req.Inputs.Postgres.PasswordSecret, "") != ptr.Deref(req.State.Postgres.PasswordSecret, "") {
+		diff["postgres.passwordSecret"] = p.PropertyDiff{Kind: p.Update}
+	}
 	old, err := normalizePostgresUris(ctx, req.State.Postgres.Uris)
 	if err != nil {
 		return infer.DiffResponse{}, err
@@ -222,7 +234,7 @@ func joinComma(parts []string) string {
 }
 
 func normalizePostgresUris(ctx context.Context, in string) (string, error) {
-	logger := p.GetLogger(ctx)
+	logger := getLogger(ctx)
 	if strings.TrimSpace(in) == "" {
 		return "", fmt.Errorf("postgres uris cannot be empty")
 	}
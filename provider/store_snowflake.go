@@ -31,16 +31,18 @@ import (
 )
 
 // SnowflakeInputs holds Snowflake-specific store configuration. All fields are
-// required and ClientKey must be a base64 encoded private key material which is
-// attached out-of-band using an in-memory attachment labelled @keyfile.
+// required except that the private key is given either as ClientKey, base64 encoded key
+// material attached out-of-band using an in-memory attachment labelled @keyfile, or as
+// ClientKeySecret, the name of a Secret holding it.
 type SnowflakeInputs struct {
-	Uris          string `pulumi:"uris"`
-	AccountId     string `pulumi:"accountId"`
-	RoleName      string `pulumi:"roleName"`
-	Username      string `pulumi:"username"`
-	WarehouseName string `pulumi:"warehouseName"`
-	CloudRegion   string `pulumi:"cloudRegion"`
-	ClientKey     string `pulumi:"clientKey" provider:"secret"` // base64 encoded private key
+	Uris            string  `pulumi:"uris"`
+	AccountId       string  `pulumi:"accountId"`
+	RoleName        string  `pulumi:"roleName"`
+	Username        string  `pulumi:"username"`
+	WarehouseName   string  `pulumi:"warehouseName"`
+	CloudRegion     string  `pulumi:"cloudRegion"`
+	ClientKey       string  `pulumi:"clientKey,optional" provider:"secret"` // base64 encoded private key
+	ClientKeySecret *string `pulumi:"clientKeySecret,optional"`
 }
 
 // validateSnowflakeInputs ensures required Snowflake attributes are present and
@@ -69,8 +71,14 @@ func validateSnowflakeInputs(s *SnowflakeInputs) []p.CheckFailure {
 	if s.CloudRegion == "" {
 		failures = append(failures, p.CheckFailure{Property: "snowflake.cloudRegion", Reason: "cloudRegion required"})
 	}
+	hasSecret := s.ClientKeySecret != nil && *s.ClientKeySecret != ""
+	if s.ClientKey != "" && hasSecret {
+		failures = append(failures, p.CheckFailure{Property: "snowflake.clientKeySecret", Reason: "clientKey and clientKeySecret are mutually exclusive"})
+	}
 	if s.ClientKey == "" {
-		failures = append(failures, p.CheckFailure{Property: "snowflake.clientKey", Reason: "clientKey (base64) required"})
+		if !hasSecret {
+			failures = append(failures, p.CheckFailure{Property: "snowflake.clientKey", Reason: "clientKey (base64) or clientKeySecret required"})
+		}
 	} else {
 		// basic base64 validation
 		if _, err := base64.StdEncoding.DecodeString(s.ClientKey); err != nil {
@@ -87,25 +95,29 @@ func storeSnowflakeCreate(ctx context.Context, conn *sql.Conn, input *StoreArgs)
 	if s == nil {
 		return fmt.Errorf("snowflake inputs missing")
 	}
-	// Decode base64 client key and attach in-memory; always use symbolic @keyfile reference.
-	decoded, err := base64.StdEncoding.DecodeString(s.ClientKey)
-	if err != nil {
-		return fmt.Errorf("invalid snowflake clientKey base64: %w", err)
+	// Reference the key's Secret, or decode the base64 client key and attach it in-memory under
+	// the symbolic @keyfile reference.
+	keyFile := quoteString("@keyfile")
+	if s.ClientKeySecret != nil && *s.ClientKeySecret != "" {
+		keyFile = secretRef(*s.ClientKeySecret)
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(s.ClientKey)
+		if err != nil {
+			return fmt.Errorf("invalid snowflake clientKey base64: %w", err)
+		}
+		ctx = godeltastream.WithAttachment(ctx, "@keyfile", io.NopCloser(strings.NewReader(string(decoded))))
 	}
-	keyFileVal := "@keyfile"
-	esc := func(v string) string { return strings.ReplaceAll(v, "'", "''") }
 	pairs := []string{
 		"'type' = SNOWFLAKE",
-		fmt.Sprintf("'uris' = '%s'", esc(s.Uris)),
-		fmt.Sprintf("'snowflake.account_id' = '%s'", esc(s.AccountId)),
-		fmt.Sprintf("'snowflake.role_name' = '%s'", esc(s.RoleName)),
-		fmt.Sprintf("'snowflake.username' = '%s'", esc(s.Username)),
-		fmt.Sprintf("'snowflake.warehouse_name' = '%s'", esc(s.WarehouseName)),
-		fmt.Sprintf("'snowflake.cloud.region' = '%s'", esc(s.CloudRegion)),
-		fmt.Sprintf("'snowflake.client.key_file' = '%s'", esc(keyFileVal)),
+		withProperty("uris", quoteString(s.Uris)),
+		withProperty("snowflake.account_id", quoteString(s.AccountId)),
+		withProperty("snowflake.role_name", quoteString(s.RoleName)),
+		withProperty("snowflake.username", quoteString(s.Username)),
+		withProperty("snowflake.warehouse_name", quoteString(s.WarehouseName)),
+		withProperty("snowflake.cloud.region", quoteString(s.CloudRegion)),
+		withProperty("snowflake.client.key_file", keyFile),
 	}
 	stmt := fmt.Sprintf("CREATE STORE %s WITH ( %s );", quoteIdent(input.Name), strings.Join(pairs, ", "))
-	ctx = godeltastream.WithAttachment(ctx, keyFileVal, io.NopCloser(strings.NewReader(string(decoded))))
 	if _, err := conn.ExecContext(ctx, stmt); err != nil {
 		return fmt.Errorf("failed to create snowflake store: %w", err)
 	}
@@ -126,26 +138,29 @@ func storeSnowflakeUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs
 	curr := input.Snowflake
 	old := prev.Snowflake
 	changes := map[string]string{}
-	esc := func(v string) string { return strings.ReplaceAll(v, "'", "''") }
 	if curr.Uris != old.Uris {
-		changes["uris"] = fmt.Sprintf("'%s'", esc(curr.Uris))
+		changes["uris"] = quoteString(curr.Uris)
 	}
 	if curr.AccountId != old.AccountId {
-		changes["snowflake.account_id"] = fmt.Sprintf("'%s'", esc(curr.AccountId))
+		changes["snowflake.account_id"] = quoteString(curr.AccountId)
 	}
 	if curr.RoleName != old.RoleName {
-		changes["snowflake.role_name"] = fmt.Sprintf("'%s'", esc(curr.RoleName))
+		changes["snowflake.role_name"] = quoteString(curr.RoleName)
 	}
 	if curr.Username != old.Username {
-		changes["snowflake.username"] = fmt.Sprintf("'%s'", esc(curr.Username))
+		changes["snowflake.username"] = quoteString(curr.Username)
 	}
 	if curr.WarehouseName != old.WarehouseName {
-		changes["snowflake.warehouse_name"] = fmt.Sprintf("'%s'", esc(curr.WarehouseName))
+		changes["snowflake.warehouse_name"] = quoteString(curr.WarehouseName)
 	}
 	if curr.CloudRegion != old.CloudRegion {
-		changes["snowflake.cloud.region"] = fmt.Sprintf("'%s'", esc(curr.CloudRegion))
+		changes["snowflake.cloud.region"] = quoteString(curr.CloudRegion)
 	}
-	if curr.ClientKey != old.ClientKey {
+	if ref := ptr.Deref(curr.ClientKeySecret, ""); ref != "" {
+		if ref != ptr.Deref(old.ClientKeySecret, "") {
+			changes["snowflake.client.key_file"] = secretRef(ref)
+		}
+	} else if curr.ClientKey != old.ClientKey || ptr.Deref(old.ClientKeySecret, "") != "" {
 		decoded, err := base64.StdEncoding.DecodeString(curr.ClientKey)
 		if err != nil {
 			return infer.UpdateResponse[StoreState]{}, fmt.Errorf("invalid snowflake clientKey base64: %w", err)
@@ -154,7 +169,10 @@ func storeSnowflakeUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs
 		ctx = godeltastream.WithAttachment(ctx, "@keyfile", io.NopCloser(strings.NewReader(string(decoded))))
 	}
 	if len(changes) == 0 {
-		return infer.UpdateResponse[StoreState]{}, nil
+		// Nothing to send (e.g. only forceDestroy changed); keep state in step with inputs.
+		newState := req.State
+		newState.StoreArgs = input
+		return infer.UpdateResponse[StoreState]{Output: newState}, nil
 	}
 	cfg := infer.GetConfig[Config](ctx)
 	db, err := openDB(ctx, &cfg)
@@ -171,7 +189,7 @@ func storeSnowflakeUpdate(ctx context.Context, req infer.UpdateRequest[StoreArgs
 	defer conn.Close() //nolint:errcheck
 	parts := make([]string, 0, len(changes))
 	for k, v := range changes {
-		parts = append(parts, fmt.Sprintf("'%s' = %s", k, v))
+		parts = append(parts, withProperty(k, v))
 	}
 	stmt := fmt.Sprintf("UPDATE STORE %s WITH ( %s );", quoteIdent(req.ID), strings.Join(parts, ", "))
 	if _, err := conn.ExecContext(ctx, stmt); err != nil {
@@ -221,6 +239,9 @@ func storeSnowflakeDiff(ctx context.Context, req infer.DiffRequest[StoreArgs, St
 		if s.ClientKey != n.ClientKey {
 			diff["snowflake.clientKey"] = p.PropertyDiff{Kind: p.Update}
 		}
+		if ptr.Deref(s.ClientKeySecret, "") != ptr.Deref(n.ClientKeySecret, "") {
+			diff["snowflake.clientKeySecret"] = p.PropertyDiff{Kind: p.Update}
+		}
 	}
 	return infer.DiffResponse{HasChanges: len(diff) > 0, DetailedDiff: diff}, nil
 }
@@ -0,0 +1,296 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	ds "github.com/deltastreaminc/go-deltastream"
+)
+
+func TestStoreArgsFromDescribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		typ   string
+		props map[string]string
+		want  StoreArgs
+	}{
+		{
+			name: "kafka with property-named columns",
+			typ:  "KAFKA",
+			props: map[string]string{
+				"uris":                       "b-1:9092",
+				"kafka.sasl.hash_function":   "SHA512",
+				"kafka.schema_registry_name": "sr",
+				"tls.disabled":               "false",
+			},
+			want: StoreArgs{Name: "k", Kafka: &KafkaInputs{Uris: "b-1:9092", SaslHashFunction: "SHA512", SchemaRegistryName: ptr.To("sr"), TlsDisabled: ptr.To(false)}},
+		},
+		{
+			name:  "kafka with short column names",
+			typ:   "kafka",
+			props: map[string]string{"uris": "b-1:9098", "sasl_hash_function": "AWS_MSK_IAM", "msk_aws_region": "us-east-1"},
+			want:  StoreArgs{Name: "k", Kafka: &KafkaInputs{Uris: "b-1:9098", SaslHashFunction: "AWS_MSK_IAM", MskAwsRegion: ptr.To("us-east-1")}},
+		},
+		{
+			name:  "kafka password held in a secret",
+			typ:   "KAFKA",
+			props: map[string]string{"uris": "b-1:9096", "kafka.sasl.hash_function": "SHA512", "kafka.sasl.password": `SECRET "kafka_pw"`},
+			want:  StoreArgs{Name: "k", Kafka: &KafkaInputs{Uris: "b-1:9096", SaslHashFunction: "SHA512", SaslPasswordSecret: ptr.To("kafka_pw")}},
+		},
+		{
+			name:  "postgres omits password",
+			typ:   "POSTGRESQL",
+			props: map[string]string{"uris": "postgresql://h:5432/db", "postgres_username": "app"},
+			want:  StoreArgs{Name: "k", Postgres: &PostgresInputs{Uris: "postgresql://h:5432/db", Username: "app"}},
+		},
+		{
+			name:  "unknown type leaves subtype empty",
+			typ:   "S3",
+			props: map[string]string{"uris": "s3://bucket"},
+			want:  StoreArgs{Name: "k"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := storeArgsFromDescribe("k", tt.typ, tt.props)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStoreOwnerDiff(t *testing.T) {
+	t.Parallel()
+
+	kafka := &KafkaInputs{Uris: "broker:9092", SaslHashFunction: "PLAIN"}
+	tests := []struct {
+		name       string
+		owner      *string
+		serverSide string
+		want       bool
+	}{
+		{name: "unset owner is not managed", owner: nil, serverSide: "sysadmin", want: false},
+		{name: "matching owner", owner: ptr.To("sysadmin"), serverSide: "sysadmin", want: false},
+		{name: "declared owner changed", owner: ptr.To("analyst"), serverSide: "sysadmin", want: true},
+		{name: "owner drifted out of band", owner: ptr.To("sysadmin"), serverSide: "analyst", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp, err := Store{}.Diff(context.Background(), infer.DiffRequest[StoreArgs, StoreState]{
+				State:  StoreState{StoreArgs: StoreArgs{Name: "s", Owner: ptr.To("sysadmin"), Kafka: kafka}, OwnerOut: tt.serverSide},
+				Inputs: StoreArgs{Name: "s", Owner: tt.owner, Kafka: kafka},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.HasChanges != tt.want {
+				t.Fatalf("HasChanges = %v, want %v (%v)", resp.HasChanges, tt.want, resp.DetailedDiff)
+			}
+			if tt.want && resp.DetailedDiff["owner"].Kind != p.Update {
+				t.Errorf("owner should update in place, got %v", resp.DetailedDiff["owner"].Kind)
+			}
+		})
+	}
+}
+
+func TestStoreDeleteVerifiesDisappearance(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t, `CREATE STORE "events" WITH ('type' = KAFKA, 'uris' = 'kafka:9092')`)
+
+	// The catalog has no row for a dropped store; Delete must treat that as gone rather than
+	// polling until its verification timeout.
+	err := server.Delete(p.DeleteRequest{
+		ID:         "events",
+		Urn:        resource.NewURN("test", "provider", "", resourceType("Store"), "events"),
+		Properties: strMap(map[string]string{"name": "events", "type": "KAFKA", "state": "ready", "owner": "deployer", "createdAt": "", "updatedAt": ""}),
+	})
+	require.NoError(t, err)
+	assert.Nil(t, f.Stores["events"])
+}
+
+func TestLookupStoreReportsMissingStoreAsInvalidStore(t *testing.T) {
+	t.Parallel()
+	f, _ := newFakeDS(t)
+	f.seed(t, `CREATE STORE "events" WITH ('type' = KAFKA, 'uris' = 'kafka:9092')`)
+	db := sql.OpenDB(f)
+	defer db.Close()
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	row, err := lookupStore(context.Background(), conn, "events")
+	require.NoError(t, err)
+	assert.Equal(t, "KAFKA", row.Type)
+
+	_, err = lookupStore(context.Background(), conn, "missing")
+	var sqlErr ds.ErrSQLError
+	require.True(t, errors.As(err, &sqlErr), "got %v", err)
+	assert.Equal(t, ds.SqlStateInvalidStore, sqlErr.SQLCode)
+	assert.Contains(t, sqlErr.Message, "missing")
+}
+
+func TestStoreCredentialSecretChecks(t *testing.T) {
+	t.Parallel()
+	reasons := func(failures []p.CheckFailure) []string {
+		var out []string
+		for _, f := range failures {
+			out = append(out, f.Property+": "+f.Reason)
+		}
+		return out
+	}
+
+	assert.Empty(t, validateKafkaInputs(&KafkaInputs{Uris: "k:9096", SaslHashFunction: "SHA512", SaslUsername: ptr.To("u"), SaslPasswordSecret: ptr.To("pw")}))
+	assert.Equal(t, []string{"kafka.saslPasswordSecret: saslPassword and saslPasswordSecret are mutually exclusive"},
+		reasons(validateKafkaInputs(&KafkaInputs{Uris: "k:9096", SaslHashFunction: "SHA512", SaslUsername: ptr.To("u"), SaslPassword: ptr.To("p"), SaslPasswordSecret: ptr.To("pw")})))
+	assert.Equal(t, []string{"kafka.saslUsername: saslUsername/password not allowed for AWS_MSK_IAM"},
+		reasons(validateKafkaInputs(&KafkaInputs{Uris: "k:9098", SaslHashFunction: "AWS_MSK_IAM", MskIamRoleArn: ptr.To("arn"), MskAwsRegion: ptr.To("us-east-1"), SaslPasswordSecret: ptr.To("pw")})))
+
+	assert.Empty(t, validatePostgresInputs(&PostgresInputs{Uris: "h/db", Username: "u", PasswordSecret: ptr.To("pg_pw")}))
+	assert.Equal(t, []string{"postgres.password: password or passwordSecret is required"},
+		reasons(validatePostgresInputs(&PostgresInputs{Uris: "h/db", Username: "u"})))
+
+	sf := SnowflakeInputs{Uris: "u", AccountId: "a", RoleName: "r", Username: "u", WarehouseName: "w", CloudRegion: "c", ClientKeySecret: ptr.To("sf_key")}
+	assert.Empty(t, validateSnowflakeInputs(&sf))
+	sf.ClientKey = "a2V5"
+	assert.Equal(t, []string{"snowflake.clientKeySecret: clientKey and clientKeySecret are mutually exclusive"}, reasons(validateSnowflakeInputs(&sf)))
+}
+
+func TestLifecycleStoreWithSecretReference(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t,
+		`CREATE SECRET "kafka_pw" WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'plaintext-v1' )`,
+		`CREATE SECRET "kafka_pw_v2" WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'plaintext-v2' )`,
+	)
+	inputs := func(secret string) property.Map {
+		return property.NewMap(map[string]property.Value{
+			"name": property.New("events"),
+			"kafka": property.New(strMap(map[string]string{
+				"uris":               "kafka:9096",
+				"saslHashFunction":   "SHA512",
+				"saslUsername":       "user",
+				"saslPasswordSecret": secret,
+			})),
+		})
+	}
+
+	integration.LifeCycleTest{
+		Resource: resourceType("Store"),
+		Create: integration.Operation{
+			Inputs: inputs("kafka_pw"),
+			Hook: func(_, _ property.Map) {
+				assert.Equal(t, `SECRET "kafka_pw"`, f.Stores["events"].Props["kafka.sasl.password"])
+			},
+		},
+		Updates: []integration.Operation{{
+			Inputs: inputs("kafka_pw_v2"),
+			Hook: func(_, _ property.Map) {
+				assert.Equal(t, `SECRET "kafka_pw_v2"`, f.Stores["events"].Props["kafka.sasl.password"])
+			},
+		}},
+	}.Run(t, server)
+
+	var update string
+	for _, stmt := range f.Executed() {
+		if strings.Contains(stmt, " STORE ") {
+			assert.NotContains(t, stmt, "plaintext-v", "store statements must not carry the secret value")
+		}
+		if strings.HasPrefix(stmt, "UPDATE STORE") {
+			update = stmt
+		}
+	}
+	assert.Equal(t, `UPDATE STORE "events" WITH ( 'kafka.sasl.password' = SECRET "kafka_pw_v2" )`, update)
+}
+
+func TestStoreCreateReferencesSecrets(t *testing.T) {
+	t.Parallel()
+	server, f := newFakeServer(t)
+	f.seed(t,
+		`CREATE SECRET "pg_pw" WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'pg-plaintext' )`,
+		`CREATE SECRET "sf_key" WITH ( 'type' = GENERIC_STRING, 'generic_string' = 'sf-plaintext' )`,
+	)
+
+	_, err := server.Create(p.CreateRequest{
+		Urn: resource.NewURN("test", "provider", "", resourceType("Store"), "pg"),
+		Properties: property.NewMap(map[string]property.Value{
+			"name": property.New("pg"),
+			"postgres": property.New(strMap(map[string]string{
+				"uris":           "postgresql://pg:5432/app",
+				"username":       "app",
+				"passwordSecret": "pg_pw",
+			})),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `SECRET "pg_pw"`, f.Stores["pg"].Props["postgres.password"])
+
+	_, err = server.Create(p.CreateRequest{
+		Urn: resource.NewURN("test", "provider", "", resourceType("Store"), "sf"),
+		Properties: property.NewMap(map[string]property.Value{
+			"name": property.New("sf"),
+			"snowflake": property.New(strMap(map[string]string{
+				"uris":            "https://acct.snowflakecomputing.com",
+				"accountId":       "acct",
+				"roleName":        "loader",
+				"username":        "loader",
+				"warehouseName":   "wh",
+				"cloudRegion":     "AWS us-east-1",
+				"clientKeySecret": "sf_key",
+			})),
+		}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `SECRET "sf_key"`, f.Stores["sf"].Props["snowflake.client.key_file"])
+
+	_, err = server.Create(p.CreateRequest{
+		Urn: resource.NewURN("test", "provider", "", resourceType("Store"), "other"),
+		Properties: property.NewMap(map[string]property.Value{
+			"name": property.New("other"),
+			"postgres": property.New(strMap(map[string]string{
+				"uris":           "postgresql://pg:5432/app",
+				"username":       "app",
+				"passwordSecret": "missing",
+			})),
+		}),
+	})
+	assert.ErrorContains(t, err, "secret missing")
+
+	for _, stmt := range f.Executed() {
+		if strings.HasPrefix(stmt, "CREATE STORE") {
+			assert.NotContains(t, stmt, "plaintext")
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	presource "github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/deltastreaminc/pulumi-deltastream/provider"
+
+// SetupTracing installs an OTLP/gRPC trace exporter when the standard OTEL_EXPORTER_OTLP_*
+// endpoint variables are set, and the W3C trace context propagator used to pass the trace to
+// the DeltaStream API. The exporter reads its remaining settings (headers, TLS, timeouts)
+// from the same variables. Without an endpoint it does nothing. Call the returned function
+// before exiting to flush pending spans.
+func SetupTracing(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	noop := func(context.Context) error { return nil }
+	if strings.EqualFold(os.Getenv("OTEL_SDK_DISABLED"), "true") || os.Getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return noop, nil
+	}
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return noop, nil
+	}
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_PROTOCOL")
+	if protocol == "" {
+		protocol = os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	}
+	if protocol != "" && protocol != "grpc" {
+		return noop, fmt.Errorf("unsupported OTLP protocol %q: only grpc is supported", protocol)
+	}
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return noop, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "pulumi-resource-"+Name),
+			attribute.String("service.version", Version),
+		),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return noop, fmt.Errorf("failed to build trace resource: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// endSpan records err, with secrets scrubbed, and ends span.
+func endSpan(ctx context.Context, span trace.Span, err error) {
+	if err != nil {
+		msg := redact(ctx, err.Error())
+		span.RecordError(fmt.Errorf("%s", msg))
+		span.SetStatus(codes.Error, msg)
+	}
+	span.End()
+}
+
+// statementVerb returns the leading keywords of a statement, such as CREATE STORE or SELECT,
+// for use as a span name.
+func statementVerb(stmt string) string {
+	fields := strings.Fields(stmt)
+	if len(fields) == 0 {
+		return "statement"
+	}
+	verb := strings.ToUpper(fields[0])
+	switch verb {
+	case "CREATE", "DROP", "ALTER", "UPDATE", "DESCRIBE", "TERMINATE", "START", "STOP", "USE", "BEGIN":
+		if len(fields) > 1 && !strings.ContainsAny(fields[1], `"'(;`) {
+			return verb + " " + strings.ToUpper(strings.TrimSuffix(fields[1], ";"))
+		}
+	}
+	return verb
+}
+
+// startStatementSpan starts the span for one statement sent to DeltaStream.
+func startStatementSpan(ctx context.Context, stmt, org, role string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system.name", "deltastream"),
+		attribute.String("db.query.text", redact(ctx, stmt)),
+	}
+	if org != "" {
+		attrs = append(attrs, attribute.String("deltastream.organization", org))
+	}
+	if role != "" {
+		attrs = append(attrs, attribute.String("deltastream.role", role))
+	}
+	return tracer().Start(ctx, statementVerb(stmt), trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// startPollSpan starts the span for one iteration of a readiness loop.
+func startPollSpan(ctx context.Context, loop string, attempt int, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, attribute.Int("deltastream.poll.attempt", attempt))
+	return tracer().Start(ctx, loop+" poll", trace.WithAttributes(attrs...))
+}
+
+// endPollSpan records the state observed by a poll iteration and ends its span.
+func endPollSpan(ctx context.Context, span trace.Span, state string, err error) {
+	if state != "" {
+		span.SetAttributes(attribute.String("deltastream.state", state))
+	}
+	endSpan(ctx, span, err)
+}
+
+// traceRequests wraps a provider so each resource operation and function invocation runs in
+// its own span.
+func traceRequests(prov p.Provider) p.Provider {
+	start := func(ctx context.Context, op string, urn presource.URN, dryRun bool) (context.Context, trace.Span) {
+		attrs := []attribute.KeyValue{attribute.String("pulumi.operation", op)}
+		name := "deltastream " + op
+		if urn != "" {
+			attrs = append(attrs, attribute.String("pulumi.urn", string(urn)))
+		}
+		if dryRun {
+			attrs = append(attrs, attribute.Bool("pulumi.dry_run", true))
+		}
+		return tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+	}
+	if invoke := prov.Invoke; invoke != nil {
+		prov.Invoke = func(ctx context.Context, req p.InvokeRequest) (p.InvokeResponse, error) {
+			ctx, span := start(ctx, "invoke", "", false)
+			span.SetAttributes(attribute.String("pulumi.function", string(req.Token)))
+			resp, err := invoke(ctx, req)
+			endSpan(ctx, span, err)
+			return resp, err
+		}
+	}
+	if check := prov.Check; check != nil {
+		prov.Check = func(ctx context.Context, req p.CheckRequest) (p.CheckResponse, error) {
+			ctx, span := start(ctx, "check", req.Urn, false)
+			resp, err := check(ctx, req)
+			endSpan(ctx, span, err)
+			return resp, err
+		}
+	}
+	if diff := prov.Diff; diff != nil {
+		prov.Diff = func(ctx context.Context, req p.DiffRequest) (p.DiffResponse, error) {
+			ctx, span := start(ctx, "diff", req.Urn, false)
+			resp, err := diff(ctx, req)
+			endSpan(ctx, span, err)
+			return resp, err
+		}
+	}
+	if create := prov.Create; create != nil {
+		prov.Create = func(ctx context.Context, req p.CreateRequest) (p.CreateResponse, error) {
+			ctx, span := start(ctx, "create", req.Urn, req.DryRun)
+			resp, err := create(ctx, req)
+			endSpan(ctx, span, err)
+			return resp, err
+		}
+	}
+	if read := prov.Read; read != nil {
+		prov.Read = func(ctx context.Context, req p.ReadRequest) (p.ReadResponse, error) {
+			ctx, span := start(ctx, "read", req.Urn, false)
+			resp, err := read(ctx, req)
+			endSpan(ctx, span, err)
+			return resp, err
+		}
+	}
+	if update := prov.Update; update != nil {
+		prov.Update = func(ctx context.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+			ctx, span := start(ctx, "update", req.Urn, req.DryRun)
+			resp, err := update(ctx, req)
+			endSpan(ctx, span, err)
+			return resp, err
+		}
+	}
+	if del := prov.Delete; del != nil {
+		prov.Delete = func(ctx context.Context, req p.DeleteRequest) error {
+			ctx, span := start(ctx, "delete", req.Urn, false)
+			err := del(ctx, req)
+			endSpan(ctx, span, err)
+			return err
+		}
+	}
+	return prov
+}
@@ -0,0 +1,180 @@
+// Copyright 2025, DeltaStream Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans installs a recording tracer provider for the rest of the test. Tests using it
+// must not run in parallel, as the tracer provider is global.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+		_ = tp.Shutdown(context.Background())
+	})
+	return rec
+}
+
+func spanAttr(s sdktrace.ReadOnlySpan, key string) (attribute.Value, bool) {
+	for _, kv := range s.Attributes() {
+		if string(kv.Key) == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+// tracedStoreInputs are Kafka store inputs with credentials long enough to be scrubbed from
+// span attributes without mangling the surrounding SQL.
+func tracedStoreInputs() property.Map {
+	return property.NewMap(map[string]property.Value{
+		"name": property.New("events"),
+		"kafka": property.New(strMap(map[string]string{
+			"uris":             "kafka-1:9092",
+			"saslHashFunction": "PLAIN",
+			"saslUsername":     "svc-reader",
+			"saslPassword":     "pa55-w0rd",
+		})),
+	})
+}
+
+func TestTraceStoreCreate(t *testing.T) {
+	rec := recordSpans(t)
+	server, _ := newFakeServer(t)
+
+	urn := resource.NewURN("test", "provider", "", resourceType("Store"), "events")
+	_, err := server.Create(p.CreateRequest{Urn: urn, Properties: tracedStoreInputs()})
+	require.NoError(t, err)
+
+	spans := map[string][]sdktrace.ReadOnlySpan{}
+	for _, s := range rec.Ended() {
+		spans[s.Name()] = append(spans[s.Name()], s)
+	}
+	require.Len(t, spans["deltastream create"], 1)
+	op := spans["deltastream create"][0]
+	v, _ := spanAttr(op, "pulumi.urn")
+	assert.Equal(t, string(urn), v.AsString())
+
+	require.Len(t, spans["CREATE STORE"], 1)
+	stmt := spans["CREATE STORE"][0]
+	assert.Equal(t, op.SpanContext().TraceID(), stmt.SpanContext().TraceID())
+	assert.Equal(t, trace.SpanKindClient, stmt.SpanKind())
+	text, _ := spanAttr(stmt, "db.query.text")
+	assert.Contains(t, text.AsString(), "'kafka.sasl.password' = '[secret]'")
+	assert.NotContains(t, text.AsString(), "pa55-w0rd")
+
+	require.NotEmpty(t, spans["store ready poll"])
+	poll := spans["store ready poll"][0]
+	state, _ := spanAttr(poll, "deltastream.state")
+	assert.Equal(t, "ready", strings.ToLower(state.AsString()))
+	attempt, _ := spanAttr(poll, "deltastream.poll.attempt")
+	assert.Equal(t, int64(1), attempt.AsInt64())
+}
+
+func TestTraceFailedStatement(t *testing.T) {
+	rec := recordSpans(t)
+	server, _ := newFakeServer(t)
+
+	urn := resource.NewURN("test", "provider", "", resourceType("Store"), "events")
+	inputs := tracedStoreInputs()
+	_, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.NoError(t, err)
+	_, err = server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	require.Error(t, err)
+
+	var failed []sdktrace.ReadOnlySpan
+	for _, s := range rec.Ended() {
+		if s.Status().Code == codes.Error {
+			failed = append(failed, s)
+		}
+	}
+	names := make([]string, 0, len(failed))
+	for _, s := range failed {
+		names = append(names, s.Name())
+		assert.NotContains(t, s.Status().Description, "pa55-w0rd")
+	}
+	assert.Contains(t, names, "CREATE STORE")
+	assert.Contains(t, names, "deltastream create")
+}
+
+func TestRoundTripperPropagatesTrace(t *testing.T) {
+	recordSpans(t)
+	var got http.Header
+	rt := roundTripperWithUA{r: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		got = r.Header.Clone()
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+	})}
+
+	ctx, span := tracer().Start(context.Background(), "parent")
+	defer span.End()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.example.com/v2/statements", nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.NoError(t, err)
+
+	traceparent := got.Get("traceparent")
+	require.NotEmpty(t, traceparent)
+	assert.Contains(t, traceparent, span.SpanContext().TraceID().String())
+	assert.Equal(t, "pulumi-provider-deltastream", got.Get("User-Agent"))
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestStatementVerb(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		stmt, want string
+	}{
+		{`CREATE STORE "events" WITH ('type' = KAFKA);`, "CREATE STORE"},
+		{`select * from deltastream.sys."stores";`, "SELECT"},
+		{`DESCRIBE RELATION "db"."s"."r";`, "DESCRIBE RELATION"},
+		{`DROP DATABASE "x";`, "DROP DATABASE"},
+		{`TERMINATE QUERY 0b9c1a4e-6f0b-4c55-a1a3-0d2f1f9e8a11;`, "TERMINATE QUERY"},
+		{`CREATE "odd";`, "CREATE"},
+		{"   ", "statement"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, statementVerb(tt.stmt))
+		})
+	}
+}
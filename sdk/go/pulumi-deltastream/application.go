@@ -17,11 +17,15 @@ type Application struct {
 	pulumi.CustomResourceState
 
 	// System-generated application identifier
-	ApplicationId      pulumi.StringOutput      `pulumi:"applicationId"`
-	CreatedAt          pulumi.StringOutput      `pulumi:"createdAt"`
-	Owner              pulumi.StringOutput      `pulumi:"owner"`
-	QueryName          pulumi.StringPtrOutput   `pulumi:"queryName"`
-	QueryVersion       pulumi.IntPtrOutput      `pulumi:"queryVersion"`
+	ApplicationId pulumi.StringOutput    `pulumi:"applicationId"`
+	ComputePool   pulumi.StringPtrOutput `pulumi:"computePool"`
+	CreatedAt     pulumi.StringOutput    `pulumi:"createdAt"`
+	Owner         pulumi.StringOutput    `pulumi:"owner"`
+	Parameters    pulumi.StringMapOutput `pulumi:"parameters"`
+	QueryName     pulumi.StringPtrOutput `pulumi:"queryName"`
+	QueryVersion  pulumi.IntPtrOutput    `pulumi:"queryVersion"`
+	// SQL statement after substituting parameters; used for change detection
+	RenderedSql        pulumi.StringOutput      `pulumi:"renderedSql"`
 	SinkRelationFqns   pulumi.StringArrayOutput `pulumi:"sinkRelationFqns"`
 	SourceRelationFqns pulumi.StringArrayOutput `pulumi:"sourceRelationFqns"`
 	Sql                pulumi.StringOutput      `pulumi:"sql"`
@@ -79,15 +83,19 @@ func (ApplicationState) ElementType() reflect.Type {
 }
 
 type applicationArgs struct {
-	Owner              *string  `pulumi:"owner"`
-	SinkRelationFqns   []string `pulumi:"sinkRelationFqns"`
-	SourceRelationFqns []string `pulumi:"sourceRelationFqns"`
-	Sql                string   `pulumi:"sql"`
+	ComputePool        *string           `pulumi:"computePool"`
+	Owner              *string           `pulumi:"owner"`
+	Parameters         map[string]string `pulumi:"parameters"`
+	SinkRelationFqns   []string          `pulumi:"sinkRelationFqns"`
+	SourceRelationFqns []string          `pulumi:"sourceRelationFqns"`
+	Sql                string            `pulumi:"sql"`
 }
 
 // The set of arguments for constructing a Application resource.
 type ApplicationArgs struct {
+	ComputePool        pulumi.StringPtrInput
 	Owner              pulumi.StringPtrInput
+	Parameters         pulumi.StringMapInput
 	SinkRelationFqns   pulumi.StringArrayInput
 	SourceRelationFqns pulumi.StringArrayInput
 	Sql                pulumi.StringInput
@@ -185,6 +193,10 @@ func (o ApplicationOutput) ApplicationId() pulumi.StringOutput {
 	return o.ApplyT(func(v *Application) pulumi.StringOutput { return v.ApplicationId }).(pulumi.StringOutput)
 }
 
+func (o ApplicationOutput) ComputePool() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Application) pulumi.StringPtrOutput { return v.ComputePool }).(pulumi.StringPtrOutput)
+}
+
 func (o ApplicationOutput) CreatedAt() pulumi.StringOutput {
 	return o.ApplyT(func(v *Application) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
 }
@@ -193,6 +205,10 @@ func (o ApplicationOutput) Owner() pulumi.StringOutput {
 	return o.ApplyT(func(v *Application) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
 }
 
+func (o ApplicationOutput) Parameters() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Application) pulumi.StringMapOutput { return v.Parameters }).(pulumi.StringMapOutput)
+}
+
 func (o ApplicationOutput) QueryName() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Application) pulumi.StringPtrOutput { return v.QueryName }).(pulumi.StringPtrOutput)
 }
@@ -201,6 +217,11 @@ func (o ApplicationOutput) QueryVersion() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *Application) pulumi.IntPtrOutput { return v.QueryVersion }).(pulumi.IntPtrOutput)
 }
 
+// SQL statement after substituting parameters; used for change detection
+func (o ApplicationOutput) RenderedSql() pulumi.StringOutput {
+	return o.ApplyT(func(v *Application) pulumi.StringOutput { return v.RenderedSql }).(pulumi.StringOutput)
+}
+
 func (o ApplicationOutput) SinkRelationFqns() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *Application) pulumi.StringArrayOutput { return v.SinkRelationFqns }).(pulumi.StringArrayOutput)
 }
@@ -0,0 +1,276 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Compute pool resource providing dedicated compute on which queries and applications run
+type ComputePool struct {
+	pulumi.CustomResourceState
+
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// Name of the compute pool
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringOutput `pulumi:"owner"`
+	// Desired run state. true starts the pool, false stops it; unset leaves it as is.
+	Running pulumi.BoolPtrOutput `pulumi:"running"`
+	// Pool size (small, medium or large). Resized in place.
+	Size pulumi.StringPtrOutput `pulumi:"size"`
+	// Status of the compute pool as reported by the catalog
+	State pulumi.StringOutput `pulumi:"state"`
+	// Idle minutes before the pool stops automatically. Updated in place.
+	TimeoutMinutes pulumi.IntPtrOutput `pulumi:"timeoutMinutes"`
+	UpdatedAt      pulumi.StringOutput `pulumi:"updatedAt"`
+}
+
+// NewComputePool registers a new resource with the given unique name, arguments, and options.
+func NewComputePool(ctx *pulumi.Context,
+	name string, args *ComputePoolArgs, opts ...pulumi.ResourceOption) (*ComputePool, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource ComputePool
+	err := ctx.RegisterResource("deltastream:index:ComputePool", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetComputePool gets an existing ComputePool resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetComputePool(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *ComputePoolState, opts ...pulumi.ResourceOption) (*ComputePool, error) {
+	var resource ComputePool
+	err := ctx.ReadResource("deltastream:index:ComputePool", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering ComputePool resources.
+type computePoolState struct {
+}
+
+type ComputePoolState struct {
+}
+
+func (ComputePoolState) ElementType() reflect.Type {
+	return reflect.TypeOf((*computePoolState)(nil)).Elem()
+}
+
+type computePoolArgs struct {
+	// Name of the compute pool
+	Name string `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner *string `pulumi:"owner"`
+	// Desired run state. true starts the pool, false stops it; unset leaves it as is.
+	Running *bool `pulumi:"running"`
+	// Pool size (small, medium or large). Resized in place.
+	Size *string `pulumi:"size"`
+	// Idle minutes before the pool stops automatically. Updated in place.
+	TimeoutMinutes *int `pulumi:"timeoutMinutes"`
+}
+
+// The set of arguments for constructing a ComputePool resource.
+type ComputePoolArgs struct {
+	// Name of the compute pool
+	Name pulumi.StringInput
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringPtrInput
+	// Desired run state. true starts the pool, false stops it; unset leaves it as is.
+	Running pulumi.BoolPtrInput
+	// Pool size (small, medium or large). Resized in place.
+	Size pulumi.StringPtrInput
+	// Idle minutes before the pool stops automatically. Updated in place.
+	TimeoutMinutes pulumi.IntPtrInput
+}
+
+func (ComputePoolArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*computePoolArgs)(nil)).Elem()
+}
+
+type ComputePoolInput interface {
+	pulumi.Input
+
+	ToComputePoolOutput() ComputePoolOutput
+	ToComputePoolOutputWithContext(ctx context.Context) ComputePoolOutput
+}
+
+func (*ComputePool) ElementType() reflect.Type {
+	return reflect.TypeOf((**ComputePool)(nil)).Elem()
+}
+
+func (i *ComputePool) ToComputePoolOutput() ComputePoolOutput {
+	return i.ToComputePoolOutputWithContext(context.Background())
+}
+
+func (i *ComputePool) ToComputePoolOutputWithContext(ctx context.Context) ComputePoolOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComputePoolOutput)
+}
+
+// ComputePoolArrayInput is an input type that accepts ComputePoolArray and ComputePoolArrayOutput values.
+// You can construct a concrete instance of `ComputePoolArrayInput` via:
+//
+//	ComputePoolArray{ ComputePoolArgs{...} }
+type ComputePoolArrayInput interface {
+	pulumi.Input
+
+	ToComputePoolArrayOutput() ComputePoolArrayOutput
+	ToComputePoolArrayOutputWithContext(context.Context) ComputePoolArrayOutput
+}
+
+type ComputePoolArray []ComputePoolInput
+
+func (ComputePoolArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*ComputePool)(nil)).Elem()
+}
+
+func (i ComputePoolArray) ToComputePoolArrayOutput() ComputePoolArrayOutput {
+	return i.ToComputePoolArrayOutputWithContext(context.Background())
+}
+
+func (i ComputePoolArray) ToComputePoolArrayOutputWithContext(ctx context.Context) ComputePoolArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComputePoolArrayOutput)
+}
+
+// ComputePoolMapInput is an input type that accepts ComputePoolMap and ComputePoolMapOutput values.
+// You can construct a concrete instance of `ComputePoolMapInput` via:
+//
+//	ComputePoolMap{ "key": ComputePoolArgs{...} }
+type ComputePoolMapInput interface {
+	pulumi.Input
+
+	ToComputePoolMapOutput() ComputePoolMapOutput
+	ToComputePoolMapOutputWithContext(context.Context) ComputePoolMapOutput
+}
+
+type ComputePoolMap map[string]ComputePoolInput
+
+func (ComputePoolMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*ComputePool)(nil)).Elem()
+}
+
+func (i ComputePoolMap) ToComputePoolMapOutput() ComputePoolMapOutput {
+	return i.ToComputePoolMapOutputWithContext(context.Background())
+}
+
+func (i ComputePoolMap) ToComputePoolMapOutputWithContext(ctx context.Context) ComputePoolMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ComputePoolMapOutput)
+}
+
+type ComputePoolOutput struct{ *pulumi.OutputState }
+
+func (ComputePoolOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ComputePool)(nil)).Elem()
+}
+
+func (o ComputePoolOutput) ToComputePoolOutput() ComputePoolOutput {
+	return o
+}
+
+func (o ComputePoolOutput) ToComputePoolOutputWithContext(ctx context.Context) ComputePoolOutput {
+	return o
+}
+
+func (o ComputePoolOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *ComputePool) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// Name of the compute pool
+func (o ComputePoolOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *ComputePool) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// Optional owning role. When set, statements execute as this role.
+func (o ComputePoolOutput) Owner() pulumi.StringOutput {
+	return o.ApplyT(func(v *ComputePool) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
+}
+
+// Desired run state. true starts the pool, false stops it; unset leaves it as is.
+func (o ComputePoolOutput) Running() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *ComputePool) pulumi.BoolPtrOutput { return v.Running }).(pulumi.BoolPtrOutput)
+}
+
+// Pool size (small, medium or large). Resized in place.
+func (o ComputePoolOutput) Size() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ComputePool) pulumi.StringPtrOutput { return v.Size }).(pulumi.StringPtrOutput)
+}
+
+// Status of the compute pool as reported by the catalog
+func (o ComputePoolOutput) State() pulumi.StringOutput {
+	return o.ApplyT(func(v *ComputePool) pulumi.StringOutput { return v.State }).(pulumi.StringOutput)
+}
+
+// Idle minutes before the pool stops automatically. Updated in place.
+func (o ComputePoolOutput) TimeoutMinutes() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ComputePool) pulumi.IntPtrOutput { return v.TimeoutMinutes }).(pulumi.IntPtrOutput)
+}
+
+func (o ComputePoolOutput) UpdatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *ComputePool) pulumi.StringOutput { return v.UpdatedAt }).(pulumi.StringOutput)
+}
+
+type ComputePoolArrayOutput struct{ *pulumi.OutputState }
+
+func (ComputePoolArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*ComputePool)(nil)).Elem()
+}
+
+func (o ComputePoolArrayOutput) ToComputePoolArrayOutput() ComputePoolArrayOutput {
+	return o
+}
+
+func (o ComputePoolArrayOutput) ToComputePoolArrayOutputWithContext(ctx context.Context) ComputePoolArrayOutput {
+	return o
+}
+
+func (o ComputePoolArrayOutput) Index(i pulumi.IntInput) ComputePoolOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *ComputePool {
+		return vs[0].([]*ComputePool)[vs[1].(int)]
+	}).(ComputePoolOutput)
+}
+
+type ComputePoolMapOutput struct{ *pulumi.OutputState }
+
+func (ComputePoolMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*ComputePool)(nil)).Elem()
+}
+
+func (o ComputePoolMapOutput) ToComputePoolMapOutput() ComputePoolMapOutput {
+	return o
+}
+
+func (o ComputePoolMapOutput) ToComputePoolMapOutputWithContext(ctx context.Context) ComputePoolMapOutput {
+	return o
+}
+
+func (o ComputePoolMapOutput) MapIndex(k pulumi.StringInput) ComputePoolOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *ComputePool {
+		return vs[0].(map[string]*ComputePool)[vs[1].(string)]
+	}).(ComputePoolOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ComputePoolInput)(nil)).Elem(), &ComputePool{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ComputePoolArrayInput)(nil)).Elem(), ComputePoolArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ComputePoolMapInput)(nil)).Elem(), ComputePoolMap{})
+	pulumi.RegisterOutputType(ComputePoolOutput{})
+	pulumi.RegisterOutputType(ComputePoolArrayOutput{})
+	pulumi.RegisterOutputType(ComputePoolMapOutput{})
+}
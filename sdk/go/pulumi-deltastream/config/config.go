@@ -14,9 +14,15 @@ var _ = internal.GetEnvOrDefault
 func GetApiKey(ctx *pulumi.Context) string {
 	return config.Get(ctx, "deltastream:apiKey")
 }
+func GetAuditLogPath(ctx *pulumi.Context) string {
+	return config.Get(ctx, "deltastream:auditLogPath")
+}
 func GetInsecureSkipVerify(ctx *pulumi.Context) bool {
 	return config.GetBool(ctx, "deltastream:insecureSkipVerify")
 }
+func GetLenientDelete(ctx *pulumi.Context) bool {
+	return config.GetBool(ctx, "deltastream:lenientDelete")
+}
 func GetOrganization(ctx *pulumi.Context) string {
 	return config.Get(ctx, "deltastream:organization")
 }
@@ -18,6 +18,8 @@ type Database struct {
 
 	// The timestamp when the database was created
 	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// When true, deleting the database first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy pulumi.BoolPtrOutput `pulumi:"forceDestroy"`
 	// The name of the database to create. If the name is case sensitive, wrap it in quotes.
 	Name pulumi.StringOutput `pulumi:"name"`
 	// The owner of the database
@@ -67,17 +69,21 @@ func (DatabaseState) ElementType() reflect.Type {
 }
 
 type databaseArgs struct {
+	// When true, deleting the database first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy *bool `pulumi:"forceDestroy"`
 	// The name of the database to create. If the name is case sensitive, wrap it in quotes.
 	Name string `pulumi:"name"`
-	// Optional owning role. When set, statements execute as this role during create.
+	// Optional owning role. When set, statements execute as this role during create; changing it transfers ownership in place.
 	Owner *string `pulumi:"owner"`
 }
 
 // The set of arguments for constructing a Database resource.
 type DatabaseArgs struct {
+	// When true, deleting the database first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy pulumi.BoolPtrInput
 	// The name of the database to create. If the name is case sensitive, wrap it in quotes.
 	Name pulumi.StringInput
-	// Optional owning role. When set, statements execute as this role during create.
+	// Optional owning role. When set, statements execute as this role during create; changing it transfers ownership in place.
 	Owner pulumi.StringPtrInput
 }
 
@@ -173,6 +179,11 @@ func (o DatabaseOutput) CreatedAt() pulumi.StringOutput {
 	return o.ApplyT(func(v *Database) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
 }
 
+// When true, deleting the database first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.
+func (o DatabaseOutput) ForceDestroy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Database) pulumi.BoolPtrOutput { return v.ForceDestroy }).(pulumi.BoolPtrOutput)
+}
+
 // The name of the database to create. If the name is case sensitive, wrap it in quotes.
 func (o DatabaseOutput) Name() pulumi.StringOutput {
 	return o.ApplyT(func(v *Database) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
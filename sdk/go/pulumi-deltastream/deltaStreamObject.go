@@ -16,22 +16,40 @@ import (
 type DeltaStreamObject struct {
 	pulumi.CustomResourceState
 
-	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
-	Database  pulumi.StringOutput `pulumi:"database"`
+	// Ordered column definitions for structured definitions
+	Columns   ObjectColumnArrayOutput `pulumi:"columns"`
+	CreatedAt pulumi.StringOutput     `pulumi:"createdAt"`
+	Database  pulumi.StringOutput     `pulumi:"database"`
+	// When true, deleting the relation first terminates running queries that read or write it. When false (default), delete fails and lists them.
+	ForceDestroy pulumi.BoolPtrOutput `pulumi:"forceDestroy"`
 	// Fully qualified name of the relation as database.namespace.name
-	Fqn       pulumi.StringOutput `pulumi:"fqn"`
+	Fqn pulumi.StringOutput `pulumi:"fqn"`
+	// Relation kind for structured definitions (stream|changelog|table)
+	Kind pulumi.StringPtrOutput `pulumi:"kind"`
+	// Name of the relation
 	Name      pulumi.StringOutput `pulumi:"name"`
 	Namespace pulumi.StringOutput `pulumi:"namespace"`
 	Owner     pulumi.StringOutput `pulumi:"owner"`
+	// Values for typed placeholders in sql: {{ident:name}}, {{fqn:name}}, {{string:name}} or {{number:name}}. Each value is quoted for its type before substitution.
+	Parameters pulumi.StringMapOutput `pulumi:"parameters"`
 	// Path of the relation as [database, namespace, name]
 	Path pulumi.StringArrayOutput `pulumi:"path"`
-	Sql  pulumi.StringOutput      `pulumi:"sql"`
+	// Primary key columns; required for changelogs and not allowed otherwise
+	PrimaryKey pulumi.StringArrayOutput `pulumi:"primaryKey"`
+	// Relation name for structured definitions
+	RelationName pulumi.StringPtrOutput `pulumi:"relationName"`
+	// DDL statement after substituting parameters or rendering the structured definition; used for change detection
+	RenderedSql pulumi.StringOutput `pulumi:"renderedSql"`
+	// CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with kind, relationName, columns, primaryKey and with.
+	Sql pulumi.StringPtrOutput `pulumi:"sql"`
 	// Provisioning state of the relation
 	State pulumi.StringOutput `pulumi:"state"`
 	Store pulumi.StringOutput `pulumi:"store"`
 	// Type of the relation (stream|changelog|table)
 	Type      pulumi.StringOutput `pulumi:"type"`
 	UpdatedAt pulumi.StringOutput `pulumi:"updatedAt"`
+	// WITH properties for structured definitions, e.g. topic, value.format, key.format, timestamp
+	With pulumi.StringMapOutput `pulumi:"with"`
 }
 
 // NewDeltaStreamObject registers a new resource with the given unique name, arguments, and options.
@@ -47,9 +65,6 @@ func NewDeltaStreamObject(ctx *pulumi.Context,
 	if args.Namespace == nil {
 		return nil, errors.New("invalid value for required argument 'Namespace'")
 	}
-	if args.Sql == nil {
-		return nil, errors.New("invalid value for required argument 'Sql'")
-	}
 	if args.Store == nil {
 		return nil, errors.New("invalid value for required argument 'Store'")
 	}
@@ -86,20 +101,50 @@ func (DeltaStreamObjectState) ElementType() reflect.Type {
 }
 
 type deltaStreamObjectArgs struct {
-	Database  string  `pulumi:"database"`
+	// Ordered column definitions for structured definitions
+	Columns  []ObjectColumn `pulumi:"columns"`
+	Database string         `pulumi:"database"`
+	// When true, deleting the relation first terminates running queries that read or write it. When false (default), delete fails and lists them.
+	ForceDestroy *bool `pulumi:"forceDestroy"`
+	// Relation kind for structured definitions (stream|changelog|table)
+	Kind      *string `pulumi:"kind"`
 	Namespace string  `pulumi:"namespace"`
 	Owner     *string `pulumi:"owner"`
-	Sql       string  `pulumi:"sql"`
-	Store     string  `pulumi:"store"`
+	// Values for typed placeholders in sql: {{ident:name}}, {{fqn:name}}, {{string:name}} or {{number:name}}. Each value is quoted for its type before substitution.
+	Parameters map[string]string `pulumi:"parameters"`
+	// Primary key columns; required for changelogs and not allowed otherwise
+	PrimaryKey []string `pulumi:"primaryKey"`
+	// Relation name for structured definitions
+	RelationName *string `pulumi:"relationName"`
+	// CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with kind, relationName, columns, primaryKey and with.
+	Sql   *string `pulumi:"sql"`
+	Store string  `pulumi:"store"`
+	// WITH properties for structured definitions, e.g. topic, value.format, key.format, timestamp
+	With map[string]string `pulumi:"with"`
 }
 
 // The set of arguments for constructing a DeltaStreamObject resource.
 type DeltaStreamObjectArgs struct {
-	Database  pulumi.StringInput
+	// Ordered column definitions for structured definitions
+	Columns  ObjectColumnArrayInput
+	Database pulumi.StringInput
+	// When true, deleting the relation first terminates running queries that read or write it. When false (default), delete fails and lists them.
+	ForceDestroy pulumi.BoolPtrInput
+	// Relation kind for structured definitions (stream|changelog|table)
+	Kind      pulumi.StringPtrInput
 	Namespace pulumi.StringInput
 	Owner     pulumi.StringPtrInput
-	Sql       pulumi.StringInput
-	Store     pulumi.StringInput
+	// Values for typed placeholders in sql: {{ident:name}}, {{fqn:name}}, {{string:name}} or {{number:name}}. Each value is quoted for its type before substitution.
+	Parameters pulumi.StringMapInput
+	// Primary key columns; required for changelogs and not allowed otherwise
+	PrimaryKey pulumi.StringArrayInput
+	// Relation name for structured definitions
+	RelationName pulumi.StringPtrInput
+	// CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with kind, relationName, columns, primaryKey and with.
+	Sql   pulumi.StringPtrInput
+	Store pulumi.StringInput
+	// WITH properties for structured definitions, e.g. topic, value.format, key.format, timestamp
+	With pulumi.StringMapInput
 }
 
 func (DeltaStreamObjectArgs) ElementType() reflect.Type {
@@ -189,6 +234,11 @@ func (o DeltaStreamObjectOutput) ToDeltaStreamObjectOutputWithContext(ctx contex
 	return o
 }
 
+// Ordered column definitions for structured definitions
+func (o DeltaStreamObjectOutput) Columns() ObjectColumnArrayOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) ObjectColumnArrayOutput { return v.Columns }).(ObjectColumnArrayOutput)
+}
+
 func (o DeltaStreamObjectOutput) CreatedAt() pulumi.StringOutput {
 	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
 }
@@ -197,11 +247,22 @@ func (o DeltaStreamObjectOutput) Database() pulumi.StringOutput {
 	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringOutput { return v.Database }).(pulumi.StringOutput)
 }
 
+// When true, deleting the relation first terminates running queries that read or write it. When false (default), delete fails and lists them.
+func (o DeltaStreamObjectOutput) ForceDestroy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) pulumi.BoolPtrOutput { return v.ForceDestroy }).(pulumi.BoolPtrOutput)
+}
+
 // Fully qualified name of the relation as database.namespace.name
 func (o DeltaStreamObjectOutput) Fqn() pulumi.StringOutput {
 	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringOutput { return v.Fqn }).(pulumi.StringOutput)
 }
 
+// Relation kind for structured definitions (stream|changelog|table)
+func (o DeltaStreamObjectOutput) Kind() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringPtrOutput { return v.Kind }).(pulumi.StringPtrOutput)
+}
+
+// Name of the relation
 func (o DeltaStreamObjectOutput) Name() pulumi.StringOutput {
 	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
 }
@@ -214,13 +275,34 @@ func (o DeltaStreamObjectOutput) Owner() pulumi.StringOutput {
 	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
 }
 
+// Values for typed placeholders in sql: {{ident:name}}, {{fqn:name}}, {{string:name}} or {{number:name}}. Each value is quoted for its type before substitution.
+func (o DeltaStreamObjectOutput) Parameters() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringMapOutput { return v.Parameters }).(pulumi.StringMapOutput)
+}
+
 // Path of the relation as [database, namespace, name]
 func (o DeltaStreamObjectOutput) Path() pulumi.StringArrayOutput {
 	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringArrayOutput { return v.Path }).(pulumi.StringArrayOutput)
 }
 
-func (o DeltaStreamObjectOutput) Sql() pulumi.StringOutput {
-	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringOutput { return v.Sql }).(pulumi.StringOutput)
+// Primary key columns; required for changelogs and not allowed otherwise
+func (o DeltaStreamObjectOutput) PrimaryKey() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringArrayOutput { return v.PrimaryKey }).(pulumi.StringArrayOutput)
+}
+
+// Relation name for structured definitions
+func (o DeltaStreamObjectOutput) RelationName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringPtrOutput { return v.RelationName }).(pulumi.StringPtrOutput)
+}
+
+// DDL statement after substituting parameters or rendering the structured definition; used for change detection
+func (o DeltaStreamObjectOutput) RenderedSql() pulumi.StringOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringOutput { return v.RenderedSql }).(pulumi.StringOutput)
+}
+
+// CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with kind, relationName, columns, primaryKey and with.
+func (o DeltaStreamObjectOutput) Sql() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringPtrOutput { return v.Sql }).(pulumi.StringPtrOutput)
 }
 
 // Provisioning state of the relation
@@ -241,6 +323,11 @@ func (o DeltaStreamObjectOutput) UpdatedAt() pulumi.StringOutput {
 	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringOutput { return v.UpdatedAt }).(pulumi.StringOutput)
 }
 
+// WITH properties for structured definitions, e.g. topic, value.format, key.format, timestamp
+func (o DeltaStreamObjectOutput) With() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *DeltaStreamObject) pulumi.StringMapOutput { return v.With }).(pulumi.StringMapOutput)
+}
+
 type DeltaStreamObjectArrayOutput struct{ *pulumi.OutputState }
 
 func (DeltaStreamObjectArrayOutput) ElementType() reflect.Type {
@@ -0,0 +1,260 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Descriptor source resource uploading a compiled protobuf descriptor set (.desc) for use with value.format = protobuf
+type DescriptorSource struct {
+	pulumi.CustomResourceState
+
+	// Base64 encoded descriptor set bytes. Mutually exclusive with file.
+	Content pulumi.StringPtrOutput `pulumi:"content"`
+	// SHA-256 of the uploaded descriptor set; a change replaces the descriptor source
+	ContentHash pulumi.StringOutput `pulumi:"contentHash"`
+	CreatedAt   pulumi.StringOutput `pulumi:"createdAt"`
+	// Path to a compiled descriptor set (protoc --descriptor_set_out). Mutually exclusive with content.
+	File pulumi.StringPtrOutput `pulumi:"file"`
+	// Name of the descriptor source
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringOutput `pulumi:"owner"`
+}
+
+// NewDescriptorSource registers a new resource with the given unique name, arguments, and options.
+func NewDescriptorSource(ctx *pulumi.Context,
+	name string, args *DescriptorSourceArgs, opts ...pulumi.ResourceOption) (*DescriptorSource, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource DescriptorSource
+	err := ctx.RegisterResource("deltastream:index:DescriptorSource", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetDescriptorSource gets an existing DescriptorSource resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetDescriptorSource(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *DescriptorSourceState, opts ...pulumi.ResourceOption) (*DescriptorSource, error) {
+	var resource DescriptorSource
+	err := ctx.ReadResource("deltastream:index:DescriptorSource", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering DescriptorSource resources.
+type descriptorSourceState struct {
+}
+
+type DescriptorSourceState struct {
+}
+
+func (DescriptorSourceState) ElementType() reflect.Type {
+	return reflect.TypeOf((*descriptorSourceState)(nil)).Elem()
+}
+
+type descriptorSourceArgs struct {
+	// Base64 encoded descriptor set bytes. Mutually exclusive with file.
+	Content *string `pulumi:"content"`
+	// Path to a compiled descriptor set (protoc --descriptor_set_out). Mutually exclusive with content.
+	File *string `pulumi:"file"`
+	// Name of the descriptor source
+	Name string `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner *string `pulumi:"owner"`
+}
+
+// The set of arguments for constructing a DescriptorSource resource.
+type DescriptorSourceArgs struct {
+	// Base64 encoded descriptor set bytes. Mutually exclusive with file.
+	Content pulumi.StringPtrInput
+	// Path to a compiled descriptor set (protoc --descriptor_set_out). Mutually exclusive with content.
+	File pulumi.StringPtrInput
+	// Name of the descriptor source
+	Name pulumi.StringInput
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringPtrInput
+}
+
+func (DescriptorSourceArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*descriptorSourceArgs)(nil)).Elem()
+}
+
+type DescriptorSourceInput interface {
+	pulumi.Input
+
+	ToDescriptorSourceOutput() DescriptorSourceOutput
+	ToDescriptorSourceOutputWithContext(ctx context.Context) DescriptorSourceOutput
+}
+
+func (*DescriptorSource) ElementType() reflect.Type {
+	return reflect.TypeOf((**DescriptorSource)(nil)).Elem()
+}
+
+func (i *DescriptorSource) ToDescriptorSourceOutput() DescriptorSourceOutput {
+	return i.ToDescriptorSourceOutputWithContext(context.Background())
+}
+
+func (i *DescriptorSource) ToDescriptorSourceOutputWithContext(ctx context.Context) DescriptorSourceOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DescriptorSourceOutput)
+}
+
+// DescriptorSourceArrayInput is an input type that accepts DescriptorSourceArray and DescriptorSourceArrayOutput values.
+// You can construct a concrete instance of `DescriptorSourceArrayInput` via:
+//
+//	DescriptorSourceArray{ DescriptorSourceArgs{...} }
+type DescriptorSourceArrayInput interface {
+	pulumi.Input
+
+	ToDescriptorSourceArrayOutput() DescriptorSourceArrayOutput
+	ToDescriptorSourceArrayOutputWithContext(context.Context) DescriptorSourceArrayOutput
+}
+
+type DescriptorSourceArray []DescriptorSourceInput
+
+func (DescriptorSourceArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DescriptorSource)(nil)).Elem()
+}
+
+func (i DescriptorSourceArray) ToDescriptorSourceArrayOutput() DescriptorSourceArrayOutput {
+	return i.ToDescriptorSourceArrayOutputWithContext(context.Background())
+}
+
+func (i DescriptorSourceArray) ToDescriptorSourceArrayOutputWithContext(ctx context.Context) DescriptorSourceArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DescriptorSourceArrayOutput)
+}
+
+// DescriptorSourceMapInput is an input type that accepts DescriptorSourceMap and DescriptorSourceMapOutput values.
+// You can construct a concrete instance of `DescriptorSourceMapInput` via:
+//
+//	DescriptorSourceMap{ "key": DescriptorSourceArgs{...} }
+type DescriptorSourceMapInput interface {
+	pulumi.Input
+
+	ToDescriptorSourceMapOutput() DescriptorSourceMapOutput
+	ToDescriptorSourceMapOutputWithContext(context.Context) DescriptorSourceMapOutput
+}
+
+type DescriptorSourceMap map[string]DescriptorSourceInput
+
+func (DescriptorSourceMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DescriptorSource)(nil)).Elem()
+}
+
+func (i DescriptorSourceMap) ToDescriptorSourceMapOutput() DescriptorSourceMapOutput {
+	return i.ToDescriptorSourceMapOutputWithContext(context.Background())
+}
+
+func (i DescriptorSourceMap) ToDescriptorSourceMapOutputWithContext(ctx context.Context) DescriptorSourceMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DescriptorSourceMapOutput)
+}
+
+type DescriptorSourceOutput struct{ *pulumi.OutputState }
+
+func (DescriptorSourceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DescriptorSource)(nil)).Elem()
+}
+
+func (o DescriptorSourceOutput) ToDescriptorSourceOutput() DescriptorSourceOutput {
+	return o
+}
+
+func (o DescriptorSourceOutput) ToDescriptorSourceOutputWithContext(ctx context.Context) DescriptorSourceOutput {
+	return o
+}
+
+// Base64 encoded descriptor set bytes. Mutually exclusive with file.
+func (o DescriptorSourceOutput) Content() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DescriptorSource) pulumi.StringPtrOutput { return v.Content }).(pulumi.StringPtrOutput)
+}
+
+// SHA-256 of the uploaded descriptor set; a change replaces the descriptor source
+func (o DescriptorSourceOutput) ContentHash() pulumi.StringOutput {
+	return o.ApplyT(func(v *DescriptorSource) pulumi.StringOutput { return v.ContentHash }).(pulumi.StringOutput)
+}
+
+func (o DescriptorSourceOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *DescriptorSource) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// Path to a compiled descriptor set (protoc --descriptor_set_out). Mutually exclusive with content.
+func (o DescriptorSourceOutput) File() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DescriptorSource) pulumi.StringPtrOutput { return v.File }).(pulumi.StringPtrOutput)
+}
+
+// Name of the descriptor source
+func (o DescriptorSourceOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *DescriptorSource) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// Optional owning role. When set, statements execute as this role.
+func (o DescriptorSourceOutput) Owner() pulumi.StringOutput {
+	return o.ApplyT(func(v *DescriptorSource) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
+}
+
+type DescriptorSourceArrayOutput struct{ *pulumi.OutputState }
+
+func (DescriptorSourceArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*DescriptorSource)(nil)).Elem()
+}
+
+func (o DescriptorSourceArrayOutput) ToDescriptorSourceArrayOutput() DescriptorSourceArrayOutput {
+	return o
+}
+
+func (o DescriptorSourceArrayOutput) ToDescriptorSourceArrayOutputWithContext(ctx context.Context) DescriptorSourceArrayOutput {
+	return o
+}
+
+func (o DescriptorSourceArrayOutput) Index(i pulumi.IntInput) DescriptorSourceOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *DescriptorSource {
+		return vs[0].([]*DescriptorSource)[vs[1].(int)]
+	}).(DescriptorSourceOutput)
+}
+
+type DescriptorSourceMapOutput struct{ *pulumi.OutputState }
+
+func (DescriptorSourceMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*DescriptorSource)(nil)).Elem()
+}
+
+func (o DescriptorSourceMapOutput) ToDescriptorSourceMapOutput() DescriptorSourceMapOutput {
+	return o
+}
+
+func (o DescriptorSourceMapOutput) ToDescriptorSourceMapOutputWithContext(ctx context.Context) DescriptorSourceMapOutput {
+	return o
+}
+
+func (o DescriptorSourceMapOutput) MapIndex(k pulumi.StringInput) DescriptorSourceOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *DescriptorSource {
+		return vs[0].(map[string]*DescriptorSource)[vs[1].(string)]
+	}).(DescriptorSourceOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DescriptorSourceInput)(nil)).Elem(), &DescriptorSource{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DescriptorSourceArrayInput)(nil)).Elem(), DescriptorSourceArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DescriptorSourceMapInput)(nil)).Elem(), DescriptorSourceMap{})
+	pulumi.RegisterOutputType(DescriptorSourceOutput{})
+	pulumi.RegisterOutputType(DescriptorSourceArrayOutput{})
+	pulumi.RegisterOutputType(DescriptorSourceMapOutput{})
+}
@@ -0,0 +1,291 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Entity resource creating and dropping a store-native entity, such as a Kafka topic, within a Store
+type Entity struct {
+	pulumi.CustomResourceState
+
+	// Topic configuration, e.g. {"retention.ms": "86400000"}. Keys are sent as 'kafka.topic.<key>' and are updated in place.
+	Configs pulumi.StringMapOutput `pulumi:"configs"`
+	// Whether the entity is a leaf (holds data) rather than a container of other entities
+	IsLeaf pulumi.BoolOutput `pulumi:"isLeaf"`
+	// Protobuf descriptor for record keys. Updated in place.
+	KeyDescriptor EntityDescriptorPtrOutput `pulumi:"keyDescriptor"`
+	// Entity name (topic name for Kafka stores)
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Number of partitions (Kafka). Changing it replaces the entity.
+	Partitions pulumi.IntPtrOutput `pulumi:"partitions"`
+	// Replication factor (Kafka). Changing it replaces the entity.
+	Replicas pulumi.IntPtrOutput `pulumi:"replicas"`
+	// Name of the store containing the entity
+	Store pulumi.StringOutput `pulumi:"store"`
+	// Protobuf descriptor for record values, allowing relations to use value.format = protobuf. Updated in place.
+	ValueDescriptor EntityDescriptorPtrOutput `pulumi:"valueDescriptor"`
+}
+
+// NewEntity registers a new resource with the given unique name, arguments, and options.
+func NewEntity(ctx *pulumi.Context,
+	name string, args *EntityArgs, opts ...pulumi.ResourceOption) (*Entity, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.Store == nil {
+		return nil, errors.New("invalid value for required argument 'Store'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Entity
+	err := ctx.RegisterResource("deltastream:index:Entity", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetEntity gets an existing Entity resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetEntity(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *EntityState, opts ...pulumi.ResourceOption) (*Entity, error) {
+	var resource Entity
+	err := ctx.ReadResource("deltastream:index:Entity", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Entity resources.
+type entityState struct {
+}
+
+type EntityState struct {
+}
+
+func (EntityState) ElementType() reflect.Type {
+	return reflect.TypeOf((*entityState)(nil)).Elem()
+}
+
+type entityArgs struct {
+	// Topic configuration, e.g. {"retention.ms": "86400000"}. Keys are sent as 'kafka.topic.<key>' and are updated in place.
+	Configs map[string]string `pulumi:"configs"`
+	// Protobuf descriptor for record keys. Updated in place.
+	KeyDescriptor *EntityDescriptor `pulumi:"keyDescriptor"`
+	// Entity name (topic name for Kafka stores)
+	Name string `pulumi:"name"`
+	// Number of partitions (Kafka). Changing it replaces the entity.
+	Partitions *int `pulumi:"partitions"`
+	// Replication factor (Kafka). Changing it replaces the entity.
+	Replicas *int `pulumi:"replicas"`
+	// Name of the store containing the entity
+	Store string `pulumi:"store"`
+	// Protobuf descriptor for record values, allowing relations to use value.format = protobuf. Updated in place.
+	ValueDescriptor *EntityDescriptor `pulumi:"valueDescriptor"`
+}
+
+// The set of arguments for constructing a Entity resource.
+type EntityArgs struct {
+	// Topic configuration, e.g. {"retention.ms": "86400000"}. Keys are sent as 'kafka.topic.<key>' and are updated in place.
+	Configs pulumi.StringMapInput
+	// Protobuf descriptor for record keys. Updated in place.
+	KeyDescriptor EntityDescriptorPtrInput
+	// Entity name (topic name for Kafka stores)
+	Name pulumi.StringInput
+	// Number of partitions (Kafka). Changing it replaces the entity.
+	Partitions pulumi.IntPtrInput
+	// Replication factor (Kafka). Changing it replaces the entity.
+	Replicas pulumi.IntPtrInput
+	// Name of the store containing the entity
+	Store pulumi.StringInput
+	// Protobuf descriptor for record values, allowing relations to use value.format = protobuf. Updated in place.
+	ValueDescriptor EntityDescriptorPtrInput
+}
+
+func (EntityArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*entityArgs)(nil)).Elem()
+}
+
+type EntityInput interface {
+	pulumi.Input
+
+	ToEntityOutput() EntityOutput
+	ToEntityOutputWithContext(ctx context.Context) EntityOutput
+}
+
+func (*Entity) ElementType() reflect.Type {
+	return reflect.TypeOf((**Entity)(nil)).Elem()
+}
+
+func (i *Entity) ToEntityOutput() EntityOutput {
+	return i.ToEntityOutputWithContext(context.Background())
+}
+
+func (i *Entity) ToEntityOutputWithContext(ctx context.Context) EntityOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EntityOutput)
+}
+
+// EntityArrayInput is an input type that accepts EntityArray and EntityArrayOutput values.
+// You can construct a concrete instance of `EntityArrayInput` via:
+//
+//	EntityArray{ EntityArgs{...} }
+type EntityArrayInput interface {
+	pulumi.Input
+
+	ToEntityArrayOutput() EntityArrayOutput
+	ToEntityArrayOutputWithContext(context.Context) EntityArrayOutput
+}
+
+type EntityArray []EntityInput
+
+func (EntityArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Entity)(nil)).Elem()
+}
+
+func (i EntityArray) ToEntityArrayOutput() EntityArrayOutput {
+	return i.ToEntityArrayOutputWithContext(context.Background())
+}
+
+func (i EntityArray) ToEntityArrayOutputWithContext(ctx context.Context) EntityArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EntityArrayOutput)
+}
+
+// EntityMapInput is an input type that accepts EntityMap and EntityMapOutput values.
+// You can construct a concrete instance of `EntityMapInput` via:
+//
+//	EntityMap{ "key": EntityArgs{...} }
+type EntityMapInput interface {
+	pulumi.Input
+
+	ToEntityMapOutput() EntityMapOutput
+	ToEntityMapOutputWithContext(context.Context) EntityMapOutput
+}
+
+type EntityMap map[string]EntityInput
+
+func (EntityMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Entity)(nil)).Elem()
+}
+
+func (i EntityMap) ToEntityMapOutput() EntityMapOutput {
+	return i.ToEntityMapOutputWithContext(context.Background())
+}
+
+func (i EntityMap) ToEntityMapOutputWithContext(ctx context.Context) EntityMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EntityMapOutput)
+}
+
+type EntityOutput struct{ *pulumi.OutputState }
+
+func (EntityOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Entity)(nil)).Elem()
+}
+
+func (o EntityOutput) ToEntityOutput() EntityOutput {
+	return o
+}
+
+func (o EntityOutput) ToEntityOutputWithContext(ctx context.Context) EntityOutput {
+	return o
+}
+
+// Topic configuration, e.g. {"retention.ms": "86400000"}. Keys are sent as 'kafka.topic.<key>' and are updated in place.
+func (o EntityOutput) Configs() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Entity) pulumi.StringMapOutput { return v.Configs }).(pulumi.StringMapOutput)
+}
+
+// Whether the entity is a leaf (holds data) rather than a container of other entities
+func (o EntityOutput) IsLeaf() pulumi.BoolOutput {
+	return o.ApplyT(func(v *Entity) pulumi.BoolOutput { return v.IsLeaf }).(pulumi.BoolOutput)
+}
+
+// Protobuf descriptor for record keys. Updated in place.
+func (o EntityOutput) KeyDescriptor() EntityDescriptorPtrOutput {
+	return o.ApplyT(func(v *Entity) EntityDescriptorPtrOutput { return v.KeyDescriptor }).(EntityDescriptorPtrOutput)
+}
+
+// Entity name (topic name for Kafka stores)
+func (o EntityOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *Entity) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// Number of partitions (Kafka). Changing it replaces the entity.
+func (o EntityOutput) Partitions() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Entity) pulumi.IntPtrOutput { return v.Partitions }).(pulumi.IntPtrOutput)
+}
+
+// Replication factor (Kafka). Changing it replaces the entity.
+func (o EntityOutput) Replicas() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Entity) pulumi.IntPtrOutput { return v.Replicas }).(pulumi.IntPtrOutput)
+}
+
+// Name of the store containing the entity
+func (o EntityOutput) Store() pulumi.StringOutput {
+	return o.ApplyT(func(v *Entity) pulumi.StringOutput { return v.Store }).(pulumi.StringOutput)
+}
+
+// Protobuf descriptor for record values, allowing relations to use value.format = protobuf. Updated in place.
+func (o EntityOutput) ValueDescriptor() EntityDescriptorPtrOutput {
+	return o.ApplyT(func(v *Entity) EntityDescriptorPtrOutput { return v.ValueDescriptor }).(EntityDescriptorPtrOutput)
+}
+
+type EntityArrayOutput struct{ *pulumi.OutputState }
+
+func (EntityArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Entity)(nil)).Elem()
+}
+
+func (o EntityArrayOutput) ToEntityArrayOutput() EntityArrayOutput {
+	return o
+}
+
+func (o EntityArrayOutput) ToEntityArrayOutputWithContext(ctx context.Context) EntityArrayOutput {
+	return o
+}
+
+func (o EntityArrayOutput) Index(i pulumi.IntInput) EntityOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Entity {
+		return vs[0].([]*Entity)[vs[1].(int)]
+	}).(EntityOutput)
+}
+
+type EntityMapOutput struct{ *pulumi.OutputState }
+
+func (EntityMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Entity)(nil)).Elem()
+}
+
+func (o EntityMapOutput) ToEntityMapOutput() EntityMapOutput {
+	return o
+}
+
+func (o EntityMapOutput) ToEntityMapOutputWithContext(ctx context.Context) EntityMapOutput {
+	return o
+}
+
+func (o EntityMapOutput) MapIndex(k pulumi.StringInput) EntityOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Entity {
+		return vs[0].(map[string]*Entity)[vs[1].(string)]
+	}).(EntityOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*EntityInput)(nil)).Elem(), &Entity{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EntityArrayInput)(nil)).Elem(), EntityArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EntityMapInput)(nil)).Elem(), EntityMap{})
+	pulumi.RegisterOutputType(EntityOutput{})
+	pulumi.RegisterOutputType(EntityArrayOutput{})
+	pulumi.RegisterOutputType(EntityMapOutput{})
+}
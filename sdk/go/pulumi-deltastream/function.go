@@ -0,0 +1,302 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// User-defined function resource (CREATE FUNCTION) implemented by a class in a FunctionSource
+type Function struct {
+	pulumi.CustomResourceState
+
+	// Fully qualified implementing class name
+	ClassName pulumi.StringOutput `pulumi:"className"`
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// Implementation language (default JAVA)
+	Language pulumi.StringPtrOutput `pulumi:"language"`
+	// Function name used in SQL
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringOutput `pulumi:"owner"`
+	// Ordered function parameters
+	Parameters FunctionParameterArrayOutput `pulumi:"parameters"`
+	// SQL return type, e.g. VARCHAR
+	ReturnType pulumi.StringOutput `pulumi:"returnType"`
+	// Function signature, e.g. toUpper(VARCHAR)
+	Signature pulumi.StringOutput `pulumi:"signature"`
+	// Name of the function source (JAR) implementing the function
+	Source pulumi.StringOutput `pulumi:"source"`
+}
+
+// NewFunction registers a new resource with the given unique name, arguments, and options.
+func NewFunction(ctx *pulumi.Context,
+	name string, args *FunctionArgs, opts ...pulumi.ResourceOption) (*Function, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.ClassName == nil {
+		return nil, errors.New("invalid value for required argument 'ClassName'")
+	}
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.ReturnType == nil {
+		return nil, errors.New("invalid value for required argument 'ReturnType'")
+	}
+	if args.Source == nil {
+		return nil, errors.New("invalid value for required argument 'Source'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Function
+	err := ctx.RegisterResource("deltastream:index:Function", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetFunction gets an existing Function resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetFunction(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *FunctionState, opts ...pulumi.ResourceOption) (*Function, error) {
+	var resource Function
+	err := ctx.ReadResource("deltastream:index:Function", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Function resources.
+type functionState struct {
+}
+
+type FunctionState struct {
+}
+
+func (FunctionState) ElementType() reflect.Type {
+	return reflect.TypeOf((*functionState)(nil)).Elem()
+}
+
+type functionArgs struct {
+	// Fully qualified implementing class name
+	ClassName string `pulumi:"className"`
+	// Implementation language (default JAVA)
+	Language *string `pulumi:"language"`
+	// Function name used in SQL
+	Name string `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner *string `pulumi:"owner"`
+	// Ordered function parameters
+	Parameters []FunctionParameter `pulumi:"parameters"`
+	// SQL return type, e.g. VARCHAR
+	ReturnType string `pulumi:"returnType"`
+	// Name of the function source (JAR) implementing the function
+	Source string `pulumi:"source"`
+}
+
+// The set of arguments for constructing a Function resource.
+type FunctionArgs struct {
+	// Fully qualified implementing class name
+	ClassName pulumi.StringInput
+	// Implementation language (default JAVA)
+	Language pulumi.StringPtrInput
+	// Function name used in SQL
+	Name pulumi.StringInput
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringPtrInput
+	// Ordered function parameters
+	Parameters FunctionParameterArrayInput
+	// SQL return type, e.g. VARCHAR
+	ReturnType pulumi.StringInput
+	// Name of the function source (JAR) implementing the function
+	Source pulumi.StringInput
+}
+
+func (FunctionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*functionArgs)(nil)).Elem()
+}
+
+type FunctionInput interface {
+	pulumi.Input
+
+	ToFunctionOutput() FunctionOutput
+	ToFunctionOutputWithContext(ctx context.Context) FunctionOutput
+}
+
+func (*Function) ElementType() reflect.Type {
+	return reflect.TypeOf((**Function)(nil)).Elem()
+}
+
+func (i *Function) ToFunctionOutput() FunctionOutput {
+	return i.ToFunctionOutputWithContext(context.Background())
+}
+
+func (i *Function) ToFunctionOutputWithContext(ctx context.Context) FunctionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FunctionOutput)
+}
+
+// FunctionArrayInput is an input type that accepts FunctionArray and FunctionArrayOutput values.
+// You can construct a concrete instance of `FunctionArrayInput` via:
+//
+//	FunctionArray{ FunctionArgs{...} }
+type FunctionArrayInput interface {
+	pulumi.Input
+
+	ToFunctionArrayOutput() FunctionArrayOutput
+	ToFunctionArrayOutputWithContext(context.Context) FunctionArrayOutput
+}
+
+type FunctionArray []FunctionInput
+
+func (FunctionArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Function)(nil)).Elem()
+}
+
+func (i FunctionArray) ToFunctionArrayOutput() FunctionArrayOutput {
+	return i.ToFunctionArrayOutputWithContext(context.Background())
+}
+
+func (i FunctionArray) ToFunctionArrayOutputWithContext(ctx context.Context) FunctionArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FunctionArrayOutput)
+}
+
+// FunctionMapInput is an input type that accepts FunctionMap and FunctionMapOutput values.
+// You can construct a concrete instance of `FunctionMapInput` via:
+//
+//	FunctionMap{ "key": FunctionArgs{...} }
+type FunctionMapInput interface {
+	pulumi.Input
+
+	ToFunctionMapOutput() FunctionMapOutput
+	ToFunctionMapOutputWithContext(context.Context) FunctionMapOutput
+}
+
+type FunctionMap map[string]FunctionInput
+
+func (FunctionMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Function)(nil)).Elem()
+}
+
+func (i FunctionMap) ToFunctionMapOutput() FunctionMapOutput {
+	return i.ToFunctionMapOutputWithContext(context.Background())
+}
+
+func (i FunctionMap) ToFunctionMapOutputWithContext(ctx context.Context) FunctionMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FunctionMapOutput)
+}
+
+type FunctionOutput struct{ *pulumi.OutputState }
+
+func (FunctionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Function)(nil)).Elem()
+}
+
+func (o FunctionOutput) ToFunctionOutput() FunctionOutput {
+	return o
+}
+
+func (o FunctionOutput) ToFunctionOutputWithContext(ctx context.Context) FunctionOutput {
+	return o
+}
+
+// Fully qualified implementing class name
+func (o FunctionOutput) ClassName() pulumi.StringOutput {
+	return o.ApplyT(func(v *Function) pulumi.StringOutput { return v.ClassName }).(pulumi.StringOutput)
+}
+
+func (o FunctionOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *Function) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// Implementation language (default JAVA)
+func (o FunctionOutput) Language() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Function) pulumi.StringPtrOutput { return v.Language }).(pulumi.StringPtrOutput)
+}
+
+// Function name used in SQL
+func (o FunctionOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *Function) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// Optional owning role. When set, statements execute as this role.
+func (o FunctionOutput) Owner() pulumi.StringOutput {
+	return o.ApplyT(func(v *Function) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
+}
+
+// Ordered function parameters
+func (o FunctionOutput) Parameters() FunctionParameterArrayOutput {
+	return o.ApplyT(func(v *Function) FunctionParameterArrayOutput { return v.Parameters }).(FunctionParameterArrayOutput)
+}
+
+// SQL return type, e.g. VARCHAR
+func (o FunctionOutput) ReturnType() pulumi.StringOutput {
+	return o.ApplyT(func(v *Function) pulumi.StringOutput { return v.ReturnType }).(pulumi.StringOutput)
+}
+
+// Function signature, e.g. toUpper(VARCHAR)
+func (o FunctionOutput) Signature() pulumi.StringOutput {
+	return o.ApplyT(func(v *Function) pulumi.StringOutput { return v.Signature }).(pulumi.StringOutput)
+}
+
+// Name of the function source (JAR) implementing the function
+func (o FunctionOutput) Source() pulumi.StringOutput {
+	return o.ApplyT(func(v *Function) pulumi.StringOutput { return v.Source }).(pulumi.StringOutput)
+}
+
+type FunctionArrayOutput struct{ *pulumi.OutputState }
+
+func (FunctionArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Function)(nil)).Elem()
+}
+
+func (o FunctionArrayOutput) ToFunctionArrayOutput() FunctionArrayOutput {
+	return o
+}
+
+func (o FunctionArrayOutput) ToFunctionArrayOutputWithContext(ctx context.Context) FunctionArrayOutput {
+	return o
+}
+
+func (o FunctionArrayOutput) Index(i pulumi.IntInput) FunctionOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Function {
+		return vs[0].([]*Function)[vs[1].(int)]
+	}).(FunctionOutput)
+}
+
+type FunctionMapOutput struct{ *pulumi.OutputState }
+
+func (FunctionMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Function)(nil)).Elem()
+}
+
+func (o FunctionMapOutput) ToFunctionMapOutput() FunctionMapOutput {
+	return o
+}
+
+func (o FunctionMapOutput) ToFunctionMapOutputWithContext(ctx context.Context) FunctionMapOutput {
+	return o
+}
+
+func (o FunctionMapOutput) MapIndex(k pulumi.StringInput) FunctionOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Function {
+		return vs[0].(map[string]*Function)[vs[1].(string)]
+	}).(FunctionOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*FunctionInput)(nil)).Elem(), &Function{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FunctionArrayInput)(nil)).Elem(), FunctionArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FunctionMapInput)(nil)).Elem(), FunctionMap{})
+	pulumi.RegisterOutputType(FunctionOutput{})
+	pulumi.RegisterOutputType(FunctionArrayOutput{})
+	pulumi.RegisterOutputType(FunctionMapOutput{})
+}
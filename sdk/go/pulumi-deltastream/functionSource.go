@@ -0,0 +1,271 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Function source resource uploading a JAR that provides the implementation of user-defined functions
+type FunctionSource struct {
+	pulumi.CustomResourceState
+
+	// Base64 encoded JAR bytes. Mutually exclusive with file.
+	Content pulumi.StringPtrOutput `pulumi:"content"`
+	// SHA-256 of the uploaded JAR; a change replaces the function source
+	ContentHash pulumi.StringOutput `pulumi:"contentHash"`
+	CreatedAt   pulumi.StringOutput `pulumi:"createdAt"`
+	// Optional description of the function source
+	Description pulumi.StringPtrOutput `pulumi:"description"`
+	// Path to the JAR file. Mutually exclusive with content.
+	File pulumi.StringPtrOutput `pulumi:"file"`
+	// Name of the function source
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringOutput `pulumi:"owner"`
+}
+
+// NewFunctionSource registers a new resource with the given unique name, arguments, and options.
+func NewFunctionSource(ctx *pulumi.Context,
+	name string, args *FunctionSourceArgs, opts ...pulumi.ResourceOption) (*FunctionSource, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource FunctionSource
+	err := ctx.RegisterResource("deltastream:index:FunctionSource", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetFunctionSource gets an existing FunctionSource resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetFunctionSource(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *FunctionSourceState, opts ...pulumi.ResourceOption) (*FunctionSource, error) {
+	var resource FunctionSource
+	err := ctx.ReadResource("deltastream:index:FunctionSource", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering FunctionSource resources.
+type functionSourceState struct {
+}
+
+type FunctionSourceState struct {
+}
+
+func (FunctionSourceState) ElementType() reflect.Type {
+	return reflect.TypeOf((*functionSourceState)(nil)).Elem()
+}
+
+type functionSourceArgs struct {
+	// Base64 encoded JAR bytes. Mutually exclusive with file.
+	Content *string `pulumi:"content"`
+	// Optional description of the function source
+	Description *string `pulumi:"description"`
+	// Path to the JAR file. Mutually exclusive with content.
+	File *string `pulumi:"file"`
+	// Name of the function source
+	Name string `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner *string `pulumi:"owner"`
+}
+
+// The set of arguments for constructing a FunctionSource resource.
+type FunctionSourceArgs struct {
+	// Base64 encoded JAR bytes. Mutually exclusive with file.
+	Content pulumi.StringPtrInput
+	// Optional description of the function source
+	Description pulumi.StringPtrInput
+	// Path to the JAR file. Mutually exclusive with content.
+	File pulumi.StringPtrInput
+	// Name of the function source
+	Name pulumi.StringInput
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringPtrInput
+}
+
+func (FunctionSourceArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*functionSourceArgs)(nil)).Elem()
+}
+
+type FunctionSourceInput interface {
+	pulumi.Input
+
+	ToFunctionSourceOutput() FunctionSourceOutput
+	ToFunctionSourceOutputWithContext(ctx context.Context) FunctionSourceOutput
+}
+
+func (*FunctionSource) ElementType() reflect.Type {
+	return reflect.TypeOf((**FunctionSource)(nil)).Elem()
+}
+
+func (i *FunctionSource) ToFunctionSourceOutput() FunctionSourceOutput {
+	return i.ToFunctionSourceOutputWithContext(context.Background())
+}
+
+func (i *FunctionSource) ToFunctionSourceOutputWithContext(ctx context.Context) FunctionSourceOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FunctionSourceOutput)
+}
+
+// FunctionSourceArrayInput is an input type that accepts FunctionSourceArray and FunctionSourceArrayOutput values.
+// You can construct a concrete instance of `FunctionSourceArrayInput` via:
+//
+//	FunctionSourceArray{ FunctionSourceArgs{...} }
+type FunctionSourceArrayInput interface {
+	pulumi.Input
+
+	ToFunctionSourceArrayOutput() FunctionSourceArrayOutput
+	ToFunctionSourceArrayOutputWithContext(context.Context) FunctionSourceArrayOutput
+}
+
+type FunctionSourceArray []FunctionSourceInput
+
+func (FunctionSourceArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*FunctionSource)(nil)).Elem()
+}
+
+func (i FunctionSourceArray) ToFunctionSourceArrayOutput() FunctionSourceArrayOutput {
+	return i.ToFunctionSourceArrayOutputWithContext(context.Background())
+}
+
+func (i FunctionSourceArray) ToFunctionSourceArrayOutputWithContext(ctx context.Context) FunctionSourceArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FunctionSourceArrayOutput)
+}
+
+// FunctionSourceMapInput is an input type that accepts FunctionSourceMap and FunctionSourceMapOutput values.
+// You can construct a concrete instance of `FunctionSourceMapInput` via:
+//
+//	FunctionSourceMap{ "key": FunctionSourceArgs{...} }
+type FunctionSourceMapInput interface {
+	pulumi.Input
+
+	ToFunctionSourceMapOutput() FunctionSourceMapOutput
+	ToFunctionSourceMapOutputWithContext(context.Context) FunctionSourceMapOutput
+}
+
+type FunctionSourceMap map[string]FunctionSourceInput
+
+func (FunctionSourceMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*FunctionSource)(nil)).Elem()
+}
+
+func (i FunctionSourceMap) ToFunctionSourceMapOutput() FunctionSourceMapOutput {
+	return i.ToFunctionSourceMapOutputWithContext(context.Background())
+}
+
+func (i FunctionSourceMap) ToFunctionSourceMapOutputWithContext(ctx context.Context) FunctionSourceMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FunctionSourceMapOutput)
+}
+
+type FunctionSourceOutput struct{ *pulumi.OutputState }
+
+func (FunctionSourceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**FunctionSource)(nil)).Elem()
+}
+
+func (o FunctionSourceOutput) ToFunctionSourceOutput() FunctionSourceOutput {
+	return o
+}
+
+func (o FunctionSourceOutput) ToFunctionSourceOutputWithContext(ctx context.Context) FunctionSourceOutput {
+	return o
+}
+
+// Base64 encoded JAR bytes. Mutually exclusive with file.
+func (o FunctionSourceOutput) Content() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FunctionSource) pulumi.StringPtrOutput { return v.Content }).(pulumi.StringPtrOutput)
+}
+
+// SHA-256 of the uploaded JAR; a change replaces the function source
+func (o FunctionSourceOutput) ContentHash() pulumi.StringOutput {
+	return o.ApplyT(func(v *FunctionSource) pulumi.StringOutput { return v.ContentHash }).(pulumi.StringOutput)
+}
+
+func (o FunctionSourceOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *FunctionSource) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// Optional description of the function source
+func (o FunctionSourceOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FunctionSource) pulumi.StringPtrOutput { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// Path to the JAR file. Mutually exclusive with content.
+func (o FunctionSourceOutput) File() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *FunctionSource) pulumi.StringPtrOutput { return v.File }).(pulumi.StringPtrOutput)
+}
+
+// Name of the function source
+func (o FunctionSourceOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *FunctionSource) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// Optional owning role. When set, statements execute as this role.
+func (o FunctionSourceOutput) Owner() pulumi.StringOutput {
+	return o.ApplyT(func(v *FunctionSource) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
+}
+
+type FunctionSourceArrayOutput struct{ *pulumi.OutputState }
+
+func (FunctionSourceArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*FunctionSource)(nil)).Elem()
+}
+
+func (o FunctionSourceArrayOutput) ToFunctionSourceArrayOutput() FunctionSourceArrayOutput {
+	return o
+}
+
+func (o FunctionSourceArrayOutput) ToFunctionSourceArrayOutputWithContext(ctx context.Context) FunctionSourceArrayOutput {
+	return o
+}
+
+func (o FunctionSourceArrayOutput) Index(i pulumi.IntInput) FunctionSourceOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *FunctionSource {
+		return vs[0].([]*FunctionSource)[vs[1].(int)]
+	}).(FunctionSourceOutput)
+}
+
+type FunctionSourceMapOutput struct{ *pulumi.OutputState }
+
+func (FunctionSourceMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*FunctionSource)(nil)).Elem()
+}
+
+func (o FunctionSourceMapOutput) ToFunctionSourceMapOutput() FunctionSourceMapOutput {
+	return o
+}
+
+func (o FunctionSourceMapOutput) ToFunctionSourceMapOutputWithContext(ctx context.Context) FunctionSourceMapOutput {
+	return o
+}
+
+func (o FunctionSourceMapOutput) MapIndex(k pulumi.StringInput) FunctionSourceOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *FunctionSource {
+		return vs[0].(map[string]*FunctionSource)[vs[1].(string)]
+	}).(FunctionSourceOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*FunctionSourceInput)(nil)).Elem(), &FunctionSource{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FunctionSourceArrayInput)(nil)).Elem(), FunctionSourceArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FunctionSourceMapInput)(nil)).Elem(), FunctionSourceMap{})
+	pulumi.RegisterOutputType(FunctionSourceOutput{})
+	pulumi.RegisterOutputType(FunctionSourceArrayOutput{})
+	pulumi.RegisterOutputType(FunctionSourceMapOutput{})
+}
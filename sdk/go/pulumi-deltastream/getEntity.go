@@ -0,0 +1,81 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func LookupEntity(ctx *pulumi.Context, args *LookupEntityArgs, opts ...pulumi.InvokeOption) (*LookupEntityResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupEntityResult
+	err := ctx.Invoke("deltastream:index:getEntity", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupEntityArgs struct {
+	Name  string `pulumi:"name"`
+	Store string `pulumi:"store"`
+}
+
+type LookupEntityResult struct {
+	IsLeaf bool   `pulumi:"isLeaf"`
+	Name   string `pulumi:"name"`
+	Store  string `pulumi:"store"`
+}
+
+func LookupEntityOutput(ctx *pulumi.Context, args LookupEntityOutputArgs, opts ...pulumi.InvokeOption) LookupEntityResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (LookupEntityResultOutput, error) {
+			args := v.(LookupEntityArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("deltastream:index:getEntity", args, LookupEntityResultOutput{}, options).(LookupEntityResultOutput), nil
+		}).(LookupEntityResultOutput)
+}
+
+type LookupEntityOutputArgs struct {
+	Name  pulumi.StringInput `pulumi:"name"`
+	Store pulumi.StringInput `pulumi:"store"`
+}
+
+func (LookupEntityOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupEntityArgs)(nil)).Elem()
+}
+
+type LookupEntityResultOutput struct{ *pulumi.OutputState }
+
+func (LookupEntityResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupEntityResult)(nil)).Elem()
+}
+
+func (o LookupEntityResultOutput) ToLookupEntityResultOutput() LookupEntityResultOutput {
+	return o
+}
+
+func (o LookupEntityResultOutput) ToLookupEntityResultOutputWithContext(ctx context.Context) LookupEntityResultOutput {
+	return o
+}
+
+func (o LookupEntityResultOutput) IsLeaf() pulumi.BoolOutput {
+	return o.ApplyT(func(v LookupEntityResult) bool { return v.IsLeaf }).(pulumi.BoolOutput)
+}
+
+func (o LookupEntityResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupEntityResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o LookupEntityResultOutput) Store() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupEntityResult) string { return v.Store }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupEntityResultOutput{})
+}
@@ -23,14 +23,32 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 	switch typ {
 	case "deltastream:index:Application":
 		r = &Application{}
+	case "deltastream:index:ComputePool":
+		r = &ComputePool{}
 	case "deltastream:index:Database":
 		r = &Database{}
 	case "deltastream:index:DeltaStreamObject":
 		r = &DeltaStreamObject{}
+	case "deltastream:index:DescriptorSource":
+		r = &DescriptorSource{}
+	case "deltastream:index:Entity":
+		r = &Entity{}
+	case "deltastream:index:Function":
+		r = &Function{}
+	case "deltastream:index:FunctionSource":
+		r = &FunctionSource{}
+	case "deltastream:index:Migration":
+		r = &Migration{}
 	case "deltastream:index:Namespace":
 		r = &Namespace{}
+	case "deltastream:index:Pipeline":
+		r = &Pipeline{}
 	case "deltastream:index:Query":
 		r = &Query{}
+	case "deltastream:index:Secret":
+		r = &Secret{}
+	case "deltastream:index:Statement":
+		r = &Statement{}
 	case "deltastream:index:Store":
 		r = &Store{}
 	default:
@@ -0,0 +1,266 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Migration resource applying an ordered list of named SQL scripts exactly once each. Applied scripts are recorded in state with their checksums; new scripts appended to the list are applied in order on update.
+type Migration struct {
+	pulumi.CustomResourceState
+
+	// Scripts applied so far, in order
+	Applied AppliedMigrationArrayOutput `pulumi:"applied"`
+	// Optional database the scripts run in
+	Database pulumi.StringPtrOutput `pulumi:"database"`
+	// Optional namespace the scripts run in
+	Namespace pulumi.StringPtrOutput `pulumi:"namespace"`
+	// Optional role the scripts execute as
+	Owner pulumi.StringPtrOutput `pulumi:"owner"`
+	// Ordered scripts. Scripts can only be appended: removing, reordering or editing an applied script is reported as an error.
+	Scripts MigrationScriptArrayOutput `pulumi:"scripts"`
+	// Optional default store for the scripts
+	Store pulumi.StringPtrOutput `pulumi:"store"`
+}
+
+// NewMigration registers a new resource with the given unique name, arguments, and options.
+func NewMigration(ctx *pulumi.Context,
+	name string, args *MigrationArgs, opts ...pulumi.ResourceOption) (*Migration, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Scripts == nil {
+		return nil, errors.New("invalid value for required argument 'Scripts'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Migration
+	err := ctx.RegisterResource("deltastream:index:Migration", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetMigration gets an existing Migration resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetMigration(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *MigrationState, opts ...pulumi.ResourceOption) (*Migration, error) {
+	var resource Migration
+	err := ctx.ReadResource("deltastream:index:Migration", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Migration resources.
+type migrationState struct {
+}
+
+type MigrationState struct {
+}
+
+func (MigrationState) ElementType() reflect.Type {
+	return reflect.TypeOf((*migrationState)(nil)).Elem()
+}
+
+type migrationArgs struct {
+	// Optional database the scripts run in
+	Database *string `pulumi:"database"`
+	// Optional namespace the scripts run in
+	Namespace *string `pulumi:"namespace"`
+	// Optional role the scripts execute as
+	Owner *string `pulumi:"owner"`
+	// Ordered scripts. Scripts can only be appended: removing, reordering or editing an applied script is reported as an error.
+	Scripts []MigrationScript `pulumi:"scripts"`
+	// Optional default store for the scripts
+	Store *string `pulumi:"store"`
+}
+
+// The set of arguments for constructing a Migration resource.
+type MigrationArgs struct {
+	// Optional database the scripts run in
+	Database pulumi.StringPtrInput
+	// Optional namespace the scripts run in
+	Namespace pulumi.StringPtrInput
+	// Optional role the scripts execute as
+	Owner pulumi.StringPtrInput
+	// Ordered scripts. Scripts can only be appended: removing, reordering or editing an applied script is reported as an error.
+	Scripts MigrationScriptArrayInput
+	// Optional default store for the scripts
+	Store pulumi.StringPtrInput
+}
+
+func (MigrationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*migrationArgs)(nil)).Elem()
+}
+
+type MigrationInput interface {
+	pulumi.Input
+
+	ToMigrationOutput() MigrationOutput
+	ToMigrationOutputWithContext(ctx context.Context) MigrationOutput
+}
+
+func (*Migration) ElementType() reflect.Type {
+	return reflect.TypeOf((**Migration)(nil)).Elem()
+}
+
+func (i *Migration) ToMigrationOutput() MigrationOutput {
+	return i.ToMigrationOutputWithContext(context.Background())
+}
+
+func (i *Migration) ToMigrationOutputWithContext(ctx context.Context) MigrationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MigrationOutput)
+}
+
+// MigrationArrayInput is an input type that accepts MigrationArray and MigrationArrayOutput values.
+// You can construct a concrete instance of `MigrationArrayInput` via:
+//
+//	MigrationArray{ MigrationArgs{...} }
+type MigrationArrayInput interface {
+	pulumi.Input
+
+	ToMigrationArrayOutput() MigrationArrayOutput
+	ToMigrationArrayOutputWithContext(context.Context) MigrationArrayOutput
+}
+
+type MigrationArray []MigrationInput
+
+func (MigrationArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Migration)(nil)).Elem()
+}
+
+func (i MigrationArray) ToMigrationArrayOutput() MigrationArrayOutput {
+	return i.ToMigrationArrayOutputWithContext(context.Background())
+}
+
+func (i MigrationArray) ToMigrationArrayOutputWithContext(ctx context.Context) MigrationArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MigrationArrayOutput)
+}
+
+// MigrationMapInput is an input type that accepts MigrationMap and MigrationMapOutput values.
+// You can construct a concrete instance of `MigrationMapInput` via:
+//
+//	MigrationMap{ "key": MigrationArgs{...} }
+type MigrationMapInput interface {
+	pulumi.Input
+
+	ToMigrationMapOutput() MigrationMapOutput
+	ToMigrationMapOutputWithContext(context.Context) MigrationMapOutput
+}
+
+type MigrationMap map[string]MigrationInput
+
+func (MigrationMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Migration)(nil)).Elem()
+}
+
+func (i MigrationMap) ToMigrationMapOutput() MigrationMapOutput {
+	return i.ToMigrationMapOutputWithContext(context.Background())
+}
+
+func (i MigrationMap) ToMigrationMapOutputWithContext(ctx context.Context) MigrationMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MigrationMapOutput)
+}
+
+type MigrationOutput struct{ *pulumi.OutputState }
+
+func (MigrationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Migration)(nil)).Elem()
+}
+
+func (o MigrationOutput) ToMigrationOutput() MigrationOutput {
+	return o
+}
+
+func (o MigrationOutput) ToMigrationOutputWithContext(ctx context.Context) MigrationOutput {
+	return o
+}
+
+// Scripts applied so far, in order
+func (o MigrationOutput) Applied() AppliedMigrationArrayOutput {
+	return o.ApplyT(func(v *Migration) AppliedMigrationArrayOutput { return v.Applied }).(AppliedMigrationArrayOutput)
+}
+
+// Optional database the scripts run in
+func (o MigrationOutput) Database() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Migration) pulumi.StringPtrOutput { return v.Database }).(pulumi.StringPtrOutput)
+}
+
+// Optional namespace the scripts run in
+func (o MigrationOutput) Namespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Migration) pulumi.StringPtrOutput { return v.Namespace }).(pulumi.StringPtrOutput)
+}
+
+// Optional role the scripts execute as
+func (o MigrationOutput) Owner() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Migration) pulumi.StringPtrOutput { return v.Owner }).(pulumi.StringPtrOutput)
+}
+
+// Ordered scripts. Scripts can only be appended: removing, reordering or editing an applied script is reported as an error.
+func (o MigrationOutput) Scripts() MigrationScriptArrayOutput {
+	return o.ApplyT(func(v *Migration) MigrationScriptArrayOutput { return v.Scripts }).(MigrationScriptArrayOutput)
+}
+
+// Optional default store for the scripts
+func (o MigrationOutput) Store() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Migration) pulumi.StringPtrOutput { return v.Store }).(pulumi.StringPtrOutput)
+}
+
+type MigrationArrayOutput struct{ *pulumi.OutputState }
+
+func (MigrationArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Migration)(nil)).Elem()
+}
+
+func (o MigrationArrayOutput) ToMigrationArrayOutput() MigrationArrayOutput {
+	return o
+}
+
+func (o MigrationArrayOutput) ToMigrationArrayOutputWithContext(ctx context.Context) MigrationArrayOutput {
+	return o
+}
+
+func (o MigrationArrayOutput) Index(i pulumi.IntInput) MigrationOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Migration {
+		return vs[0].([]*Migration)[vs[1].(int)]
+	}).(MigrationOutput)
+}
+
+type MigrationMapOutput struct{ *pulumi.OutputState }
+
+func (MigrationMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Migration)(nil)).Elem()
+}
+
+func (o MigrationMapOutput) ToMigrationMapOutput() MigrationMapOutput {
+	return o
+}
+
+func (o MigrationMapOutput) ToMigrationMapOutputWithContext(ctx context.Context) MigrationMapOutput {
+	return o
+}
+
+func (o MigrationMapOutput) MapIndex(k pulumi.StringInput) MigrationOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Migration {
+		return vs[0].(map[string]*Migration)[vs[1].(string)]
+	}).(MigrationOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*MigrationInput)(nil)).Elem(), &Migration{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MigrationArrayInput)(nil)).Elem(), MigrationArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MigrationMapInput)(nil)).Elem(), MigrationMap{})
+	pulumi.RegisterOutputType(MigrationOutput{})
+	pulumi.RegisterOutputType(MigrationArrayOutput{})
+	pulumi.RegisterOutputType(MigrationMapOutput{})
+}
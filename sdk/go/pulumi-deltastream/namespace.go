@@ -20,9 +20,11 @@ type Namespace struct {
 	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
 	// Name of the database containing the namespace
 	Database pulumi.StringOutput `pulumi:"database"`
+	// When true, deleting the namespace first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy pulumi.BoolPtrOutput `pulumi:"forceDestroy"`
 	// Name of the namespace
 	Name pulumi.StringOutput `pulumi:"name"`
-	// Optional owning role. When set, statements execute as this role during create.
+	// Optional owning role. When set, statements execute as this role during create; changing it transfers ownership in place.
 	Owner pulumi.StringPtrOutput `pulumi:"owner"`
 }
 
@@ -74,9 +76,11 @@ func (NamespaceState) ElementType() reflect.Type {
 type namespaceArgs struct {
 	// Name of the database containing the namespace
 	Database string `pulumi:"database"`
+	// When true, deleting the namespace first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy *bool `pulumi:"forceDestroy"`
 	// Name of the namespace
 	Name string `pulumi:"name"`
-	// Optional owning role. When set, statements execute as this role during create.
+	// Optional owning role. When set, statements execute as this role during create; changing it transfers ownership in place.
 	Owner *string `pulumi:"owner"`
 }
 
@@ -84,9 +88,11 @@ type namespaceArgs struct {
 type NamespaceArgs struct {
 	// Name of the database containing the namespace
 	Database pulumi.StringInput
+	// When true, deleting the namespace first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy pulumi.BoolPtrInput
 	// Name of the namespace
 	Name pulumi.StringInput
-	// Optional owning role. When set, statements execute as this role during create.
+	// Optional owning role. When set, statements execute as this role during create; changing it transfers ownership in place.
 	Owner pulumi.StringPtrInput
 }
 
@@ -187,12 +193,17 @@ func (o NamespaceOutput) Database() pulumi.StringOutput {
 	return o.ApplyT(func(v *Namespace) pulumi.StringOutput { return v.Database }).(pulumi.StringOutput)
 }
 
+// When true, deleting the namespace first terminates running queries that use its relations and drops those relations. When false (default), delete fails and lists them.
+func (o NamespaceOutput) ForceDestroy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Namespace) pulumi.BoolPtrOutput { return v.ForceDestroy }).(pulumi.BoolPtrOutput)
+}
+
 // Name of the namespace
 func (o NamespaceOutput) Name() pulumi.StringOutput {
 	return o.ApplyT(func(v *Namespace) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
 }
 
-// Optional owning role. When set, statements execute as this role during create.
+// Optional owning role. When set, statements execute as this role during create; changing it transfers ownership in place.
 func (o NamespaceOutput) Owner() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Namespace) pulumi.StringPtrOutput { return v.Owner }).(pulumi.StringPtrOutput)
 }
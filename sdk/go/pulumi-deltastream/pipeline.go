@@ -0,0 +1,257 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Pipeline component creating a source relation, a sink relation and the INSERT INTO query between them, with fully qualified names and dependencies wired automatically.
+type Pipeline struct {
+	pulumi.ResourceState
+
+	// healthy when the query is running, unhealthy when it errored, and pending while it is starting or stopped
+	Health pulumi.StringOutput `pulumi:"health"`
+	// ID of the query moving data from source to sink
+	QueryId pulumi.StringOutput `pulumi:"queryId"`
+	// Fully qualified name of the sink relation
+	SinkFqn pulumi.StringOutput `pulumi:"sinkFqn"`
+	// Fully qualified name of the source relation
+	SourceFqn pulumi.StringOutput `pulumi:"sourceFqn"`
+}
+
+// NewPipeline registers a new resource with the given unique name, arguments, and options.
+func NewPipeline(ctx *pulumi.Context,
+	name string, args *PipelineArgs, opts ...pulumi.ResourceOption) (*Pipeline, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Database == nil {
+		return nil, errors.New("invalid value for required argument 'Database'")
+	}
+	if args.Sink == nil {
+		return nil, errors.New("invalid value for required argument 'Sink'")
+	}
+	if args.SinkStore == nil {
+		return nil, errors.New("invalid value for required argument 'SinkStore'")
+	}
+	if args.Source == nil {
+		return nil, errors.New("invalid value for required argument 'Source'")
+	}
+	if args.SourceStore == nil {
+		return nil, errors.New("invalid value for required argument 'SourceStore'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Pipeline
+	err := ctx.RegisterRemoteComponentResource("deltastream:index:Pipeline", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type pipelineArgs struct {
+	// Optional compute pool the query runs on
+	ComputePool *string `pulumi:"computePool"`
+	// Database holding the source and sink relations
+	Database string `pulumi:"database"`
+	// Namespace holding the source and sink relations (default public)
+	Namespace *string `pulumi:"namespace"`
+	// Optional role owning the relations and the query
+	Owner *string `pulumi:"owner"`
+	// Sink relation definition
+	Sink PipelineRelation `pulumi:"sink"`
+	// Store the sink relation writes to, e.g. the name output of a Snowflake Store
+	SinkStore string `pulumi:"sinkStore"`
+	// Source relation definition
+	Source PipelineRelation `pulumi:"source"`
+	// Store the source relation reads from, e.g. the name output of a Kafka Store
+	SourceStore string `pulumi:"sourceStore"`
+	// Transformation query, e.g. SELECT ... FROM {{fqn:source}}. The pipeline prefixes it with INSERT INTO the sink and substitutes the source name.
+	Sql string `pulumi:"sql"`
+}
+
+// The set of arguments for constructing a Pipeline resource.
+type PipelineArgs struct {
+	// Optional compute pool the query runs on
+	ComputePool pulumi.StringPtrInput
+	// Database holding the source and sink relations
+	Database pulumi.StringInput
+	// Namespace holding the source and sink relations (default public)
+	Namespace pulumi.StringPtrInput
+	// Optional role owning the relations and the query
+	Owner pulumi.StringPtrInput
+	// Sink relation definition
+	Sink PipelineRelationInput
+	// Store the sink relation writes to, e.g. the name output of a Snowflake Store
+	SinkStore pulumi.StringInput
+	// Source relation definition
+	Source PipelineRelationInput
+	// Store the source relation reads from, e.g. the name output of a Kafka Store
+	SourceStore pulumi.StringInput
+	// Transformation query, e.g. SELECT ... FROM {{fqn:source}}. The pipeline prefixes it with INSERT INTO the sink and substitutes the source name.
+	Sql string
+}
+
+func (PipelineArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*pipelineArgs)(nil)).Elem()
+}
+
+type PipelineInput interface {
+	pulumi.Input
+
+	ToPipelineOutput() PipelineOutput
+	ToPipelineOutputWithContext(ctx context.Context) PipelineOutput
+}
+
+func (*Pipeline) ElementType() reflect.Type {
+	return reflect.TypeOf((**Pipeline)(nil)).Elem()
+}
+
+func (i *Pipeline) ToPipelineOutput() PipelineOutput {
+	return i.ToPipelineOutputWithContext(context.Background())
+}
+
+func (i *Pipeline) ToPipelineOutputWithContext(ctx context.Context) PipelineOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PipelineOutput)
+}
+
+// PipelineArrayInput is an input type that accepts PipelineArray and PipelineArrayOutput values.
+// You can construct a concrete instance of `PipelineArrayInput` via:
+//
+//	PipelineArray{ PipelineArgs{...} }
+type PipelineArrayInput interface {
+	pulumi.Input
+
+	ToPipelineArrayOutput() PipelineArrayOutput
+	ToPipelineArrayOutputWithContext(context.Context) PipelineArrayOutput
+}
+
+type PipelineArray []PipelineInput
+
+func (PipelineArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Pipeline)(nil)).Elem()
+}
+
+func (i PipelineArray) ToPipelineArrayOutput() PipelineArrayOutput {
+	return i.ToPipelineArrayOutputWithContext(context.Background())
+}
+
+func (i PipelineArray) ToPipelineArrayOutputWithContext(ctx context.Context) PipelineArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PipelineArrayOutput)
+}
+
+// PipelineMapInput is an input type that accepts PipelineMap and PipelineMapOutput values.
+// You can construct a concrete instance of `PipelineMapInput` via:
+//
+//	PipelineMap{ "key": PipelineArgs{...} }
+type PipelineMapInput interface {
+	pulumi.Input
+
+	ToPipelineMapOutput() PipelineMapOutput
+	ToPipelineMapOutputWithContext(context.Context) PipelineMapOutput
+}
+
+type PipelineMap map[string]PipelineInput
+
+func (PipelineMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Pipeline)(nil)).Elem()
+}
+
+func (i PipelineMap) ToPipelineMapOutput() PipelineMapOutput {
+	return i.ToPipelineMapOutputWithContext(context.Background())
+}
+
+func (i PipelineMap) ToPipelineMapOutputWithContext(ctx context.Context) PipelineMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PipelineMapOutput)
+}
+
+type PipelineOutput struct{ *pulumi.OutputState }
+
+func (PipelineOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Pipeline)(nil)).Elem()
+}
+
+func (o PipelineOutput) ToPipelineOutput() PipelineOutput {
+	return o
+}
+
+func (o PipelineOutput) ToPipelineOutputWithContext(ctx context.Context) PipelineOutput {
+	return o
+}
+
+// healthy when the query is running, unhealthy when it errored, and pending while it is starting or stopped
+func (o PipelineOutput) Health() pulumi.StringOutput {
+	return o.ApplyT(func(v *Pipeline) pulumi.StringOutput { return v.Health }).(pulumi.StringOutput)
+}
+
+// ID of the query moving data from source to sink
+func (o PipelineOutput) QueryId() pulumi.StringOutput {
+	return o.ApplyT(func(v *Pipeline) pulumi.StringOutput { return v.QueryId }).(pulumi.StringOutput)
+}
+
+// Fully qualified name of the sink relation
+func (o PipelineOutput) SinkFqn() pulumi.StringOutput {
+	return o.ApplyT(func(v *Pipeline) pulumi.StringOutput { return v.SinkFqn }).(pulumi.StringOutput)
+}
+
+// Fully qualified name of the source relation
+func (o PipelineOutput) SourceFqn() pulumi.StringOutput {
+	return o.ApplyT(func(v *Pipeline) pulumi.StringOutput { return v.SourceFqn }).(pulumi.StringOutput)
+}
+
+type PipelineArrayOutput struct{ *pulumi.OutputState }
+
+func (PipelineArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Pipeline)(nil)).Elem()
+}
+
+func (o PipelineArrayOutput) ToPipelineArrayOutput() PipelineArrayOutput {
+	return o
+}
+
+func (o PipelineArrayOutput) ToPipelineArrayOutputWithContext(ctx context.Context) PipelineArrayOutput {
+	return o
+}
+
+func (o PipelineArrayOutput) Index(i pulumi.IntInput) PipelineOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Pipeline {
+		return vs[0].([]*Pipeline)[vs[1].(int)]
+	}).(PipelineOutput)
+}
+
+type PipelineMapOutput struct{ *pulumi.OutputState }
+
+func (PipelineMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Pipeline)(nil)).Elem()
+}
+
+func (o PipelineMapOutput) ToPipelineMapOutput() PipelineMapOutput {
+	return o
+}
+
+func (o PipelineMapOutput) ToPipelineMapOutputWithContext(ctx context.Context) PipelineMapOutput {
+	return o
+}
+
+func (o PipelineMapOutput) MapIndex(k pulumi.StringInput) PipelineOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Pipeline {
+		return vs[0].(map[string]*Pipeline)[vs[1].(string)]
+	}).(PipelineOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*PipelineInput)(nil)).Elem(), &Pipeline{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PipelineArrayInput)(nil)).Elem(), PipelineArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PipelineMapInput)(nil)).Elem(), PipelineMap{})
+	pulumi.RegisterOutputType(PipelineOutput{})
+	pulumi.RegisterOutputType(PipelineArrayOutput{})
+	pulumi.RegisterOutputType(PipelineMapOutput{})
+}
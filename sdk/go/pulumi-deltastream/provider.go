@@ -16,6 +16,7 @@ type Provider struct {
 	pulumi.ProviderResourceState
 
 	ApiKey       pulumi.StringPtrOutput `pulumi:"apiKey"`
+	AuditLogPath pulumi.StringPtrOutput `pulumi:"auditLogPath"`
 	Organization pulumi.StringPtrOutput `pulumi:"organization"`
 	Role         pulumi.StringPtrOutput `pulumi:"role"`
 	Server       pulumi.StringOutput    `pulumi:"server"`
@@ -32,6 +33,13 @@ func NewProvider(ctx *pulumi.Context,
 	if args.Server == nil {
 		return nil, errors.New("invalid value for required argument 'Server'")
 	}
+	if args.ApiKey != nil {
+		args.ApiKey = pulumi.ToSecret(args.ApiKey).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"apiKey",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Provider
 	err := ctx.RegisterResource("pulumi:providers:deltastream", name, args, &resource, opts...)
@@ -43,7 +51,9 @@ func NewProvider(ctx *pulumi.Context,
 
 type providerArgs struct {
 	ApiKey             *string `pulumi:"apiKey"`
+	AuditLogPath       *string `pulumi:"auditLogPath"`
 	InsecureSkipVerify *bool   `pulumi:"insecureSkipVerify"`
+	LenientDelete      *bool   `pulumi:"lenientDelete"`
 	Organization       *string `pulumi:"organization"`
 	Role               *string `pulumi:"role"`
 	Server             string  `pulumi:"server"`
@@ -53,7 +63,9 @@ type providerArgs struct {
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
 	ApiKey             pulumi.StringPtrInput
+	AuditLogPath       pulumi.StringPtrInput
 	InsecureSkipVerify pulumi.BoolPtrInput
+	LenientDelete      pulumi.BoolPtrInput
 	Organization       pulumi.StringPtrInput
 	Role               pulumi.StringPtrInput
 	Server             pulumi.StringInput
@@ -101,6 +113,10 @@ func (o ProviderOutput) ApiKey() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ApiKey }).(pulumi.StringPtrOutput)
 }
 
+func (o ProviderOutput) AuditLogPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.AuditLogPath }).(pulumi.StringPtrOutput)
+}
+
 func (o ProviderOutput) Organization() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Organization }).(pulumi.StringPtrOutput)
 }
@@ -13,6 +13,329 @@ import (
 
 var _ = internal.GetEnvOrDefault
 
+type AppliedMigration struct {
+	AppliedAt string `pulumi:"appliedAt"`
+	// SHA-256 of the normalized script text when it was applied, or of its completed statements when it is partially applied
+	Checksum string `pulumi:"checksum"`
+	// Set when the script failed part-way: the number of leading statements that ran. The next update resumes after them.
+	CompletedStatements *int   `pulumi:"completedStatements"`
+	Name                string `pulumi:"name"`
+}
+
+type AppliedMigrationOutput struct{ *pulumi.OutputState }
+
+func (AppliedMigrationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*AppliedMigration)(nil)).Elem()
+}
+
+func (o AppliedMigrationOutput) ToAppliedMigrationOutput() AppliedMigrationOutput {
+	return o
+}
+
+func (o AppliedMigrationOutput) ToAppliedMigrationOutputWithContext(ctx context.Context) AppliedMigrationOutput {
+	return o
+}
+
+func (o AppliedMigrationOutput) AppliedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v AppliedMigration) string { return v.AppliedAt }).(pulumi.StringOutput)
+}
+
+// SHA-256 of the normalized script text when it was applied, or of its completed statements when it is partially applied
+func (o AppliedMigrationOutput) Checksum() pulumi.StringOutput {
+	return o.ApplyT(func(v AppliedMigration) string { return v.Checksum }).(pulumi.StringOutput)
+}
+
+// Set when the script failed part-way: the number of leading statements that ran. The next update resumes after them.
+func (o AppliedMigrationOutput) CompletedStatements() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v AppliedMigration) *int { return v.CompletedStatements }).(pulumi.IntPtrOutput)
+}
+
+func (o AppliedMigrationOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v AppliedMigration) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type AppliedMigrationArrayOutput struct{ *pulumi.OutputState }
+
+func (AppliedMigrationArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]AppliedMigration)(nil)).Elem()
+}
+
+func (o AppliedMigrationArrayOutput) ToAppliedMigrationArrayOutput() AppliedMigrationArrayOutput {
+	return o
+}
+
+func (o AppliedMigrationArrayOutput) ToAppliedMigrationArrayOutputWithContext(ctx context.Context) AppliedMigrationArrayOutput {
+	return o
+}
+
+func (o AppliedMigrationArrayOutput) Index(i pulumi.IntInput) AppliedMigrationOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) AppliedMigration {
+		return vs[0].([]AppliedMigration)[vs[1].(int)]
+	}).(AppliedMigrationOutput)
+}
+
+type EntityDescriptor struct {
+	// Fully qualified protobuf message name, e.g. com.example.PageView
+	Message string `pulumi:"message"`
+	// Name of the descriptor source
+	Source string `pulumi:"source"`
+}
+
+// EntityDescriptorInput is an input type that accepts EntityDescriptorArgs and EntityDescriptorOutput values.
+// You can construct a concrete instance of `EntityDescriptorInput` via:
+//
+//	EntityDescriptorArgs{...}
+type EntityDescriptorInput interface {
+	pulumi.Input
+
+	ToEntityDescriptorOutput() EntityDescriptorOutput
+	ToEntityDescriptorOutputWithContext(context.Context) EntityDescriptorOutput
+}
+
+type EntityDescriptorArgs struct {
+	// Fully qualified protobuf message name, e.g. com.example.PageView
+	Message pulumi.StringInput `pulumi:"message"`
+	// Name of the descriptor source
+	Source pulumi.StringInput `pulumi:"source"`
+}
+
+func (EntityDescriptorArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EntityDescriptor)(nil)).Elem()
+}
+
+func (i EntityDescriptorArgs) ToEntityDescriptorOutput() EntityDescriptorOutput {
+	return i.ToEntityDescriptorOutputWithContext(context.Background())
+}
+
+func (i EntityDescriptorArgs) ToEntityDescriptorOutputWithContext(ctx context.Context) EntityDescriptorOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EntityDescriptorOutput)
+}
+
+func (i EntityDescriptorArgs) ToEntityDescriptorPtrOutput() EntityDescriptorPtrOutput {
+	return i.ToEntityDescriptorPtrOutputWithContext(context.Background())
+}
+
+func (i EntityDescriptorArgs) ToEntityDescriptorPtrOutputWithContext(ctx context.Context) EntityDescriptorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EntityDescriptorOutput).ToEntityDescriptorPtrOutputWithContext(ctx)
+}
+
+// EntityDescriptorPtrInput is an input type that accepts EntityDescriptorArgs, EntityDescriptorPtr and EntityDescriptorPtrOutput values.
+// You can construct a concrete instance of `EntityDescriptorPtrInput` via:
+//
+//	        EntityDescriptorArgs{...}
+//
+//	or:
+//
+//	        nil
+type EntityDescriptorPtrInput interface {
+	pulumi.Input
+
+	ToEntityDescriptorPtrOutput() EntityDescriptorPtrOutput
+	ToEntityDescriptorPtrOutputWithContext(context.Context) EntityDescriptorPtrOutput
+}
+
+type entityDescriptorPtrType EntityDescriptorArgs
+
+func EntityDescriptorPtr(v *EntityDescriptorArgs) EntityDescriptorPtrInput {
+	return (*entityDescriptorPtrType)(v)
+}
+
+func (*entityDescriptorPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EntityDescriptor)(nil)).Elem()
+}
+
+func (i *entityDescriptorPtrType) ToEntityDescriptorPtrOutput() EntityDescriptorPtrOutput {
+	return i.ToEntityDescriptorPtrOutputWithContext(context.Background())
+}
+
+func (i *entityDescriptorPtrType) ToEntityDescriptorPtrOutputWithContext(ctx context.Context) EntityDescriptorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EntityDescriptorPtrOutput)
+}
+
+type EntityDescriptorOutput struct{ *pulumi.OutputState }
+
+func (EntityDescriptorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EntityDescriptor)(nil)).Elem()
+}
+
+func (o EntityDescriptorOutput) ToEntityDescriptorOutput() EntityDescriptorOutput {
+	return o
+}
+
+func (o EntityDescriptorOutput) ToEntityDescriptorOutputWithContext(ctx context.Context) EntityDescriptorOutput {
+	return o
+}
+
+func (o EntityDescriptorOutput) ToEntityDescriptorPtrOutput() EntityDescriptorPtrOutput {
+	return o.ToEntityDescriptorPtrOutputWithContext(context.Background())
+}
+
+func (o EntityDescriptorOutput) ToEntityDescriptorPtrOutputWithContext(ctx context.Context) EntityDescriptorPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EntityDescriptor) *EntityDescriptor {
+		return &v
+	}).(EntityDescriptorPtrOutput)
+}
+
+// Fully qualified protobuf message name, e.g. com.example.PageView
+func (o EntityDescriptorOutput) Message() pulumi.StringOutput {
+	return o.ApplyT(func(v EntityDescriptor) string { return v.Message }).(pulumi.StringOutput)
+}
+
+// Name of the descriptor source
+func (o EntityDescriptorOutput) Source() pulumi.StringOutput {
+	return o.ApplyT(func(v EntityDescriptor) string { return v.Source }).(pulumi.StringOutput)
+}
+
+type EntityDescriptorPtrOutput struct{ *pulumi.OutputState }
+
+func (EntityDescriptorPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EntityDescriptor)(nil)).Elem()
+}
+
+func (o EntityDescriptorPtrOutput) ToEntityDescriptorPtrOutput() EntityDescriptorPtrOutput {
+	return o
+}
+
+func (o EntityDescriptorPtrOutput) ToEntityDescriptorPtrOutputWithContext(ctx context.Context) EntityDescriptorPtrOutput {
+	return o
+}
+
+func (o EntityDescriptorPtrOutput) Elem() EntityDescriptorOutput {
+	return o.ApplyT(func(v *EntityDescriptor) EntityDescriptor {
+		if v != nil {
+			return *v
+		}
+		var ret EntityDescriptor
+		return ret
+	}).(EntityDescriptorOutput)
+}
+
+// Fully qualified protobuf message name, e.g. com.example.PageView
+func (o EntityDescriptorPtrOutput) Message() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EntityDescriptor) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Message
+	}).(pulumi.StringPtrOutput)
+}
+
+// Name of the descriptor source
+func (o EntityDescriptorPtrOutput) Source() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EntityDescriptor) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Source
+	}).(pulumi.StringPtrOutput)
+}
+
+type FunctionParameter struct {
+	// Parameter name
+	Name string `pulumi:"name"`
+	// SQL type of the parameter, e.g. VARCHAR
+	Type string `pulumi:"type"`
+}
+
+// FunctionParameterInput is an input type that accepts FunctionParameterArgs and FunctionParameterOutput values.
+// You can construct a concrete instance of `FunctionParameterInput` via:
+//
+//	FunctionParameterArgs{...}
+type FunctionParameterInput interface {
+	pulumi.Input
+
+	ToFunctionParameterOutput() FunctionParameterOutput
+	ToFunctionParameterOutputWithContext(context.Context) FunctionParameterOutput
+}
+
+type FunctionParameterArgs struct {
+	// Parameter name
+	Name pulumi.StringInput `pulumi:"name"`
+	// SQL type of the parameter, e.g. VARCHAR
+	Type pulumi.StringInput `pulumi:"type"`
+}
+
+func (FunctionParameterArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*FunctionParameter)(nil)).Elem()
+}
+
+func (i FunctionParameterArgs) ToFunctionParameterOutput() FunctionParameterOutput {
+	return i.ToFunctionParameterOutputWithContext(context.Background())
+}
+
+func (i FunctionParameterArgs) ToFunctionParameterOutputWithContext(ctx context.Context) FunctionParameterOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FunctionParameterOutput)
+}
+
+// FunctionParameterArrayInput is an input type that accepts FunctionParameterArray and FunctionParameterArrayOutput values.
+// You can construct a concrete instance of `FunctionParameterArrayInput` via:
+//
+//	FunctionParameterArray{ FunctionParameterArgs{...} }
+type FunctionParameterArrayInput interface {
+	pulumi.Input
+
+	ToFunctionParameterArrayOutput() FunctionParameterArrayOutput
+	ToFunctionParameterArrayOutputWithContext(context.Context) FunctionParameterArrayOutput
+}
+
+type FunctionParameterArray []FunctionParameterInput
+
+func (FunctionParameterArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]FunctionParameter)(nil)).Elem()
+}
+
+func (i FunctionParameterArray) ToFunctionParameterArrayOutput() FunctionParameterArrayOutput {
+	return i.ToFunctionParameterArrayOutputWithContext(context.Background())
+}
+
+func (i FunctionParameterArray) ToFunctionParameterArrayOutputWithContext(ctx context.Context) FunctionParameterArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(FunctionParameterArrayOutput)
+}
+
+type FunctionParameterOutput struct{ *pulumi.OutputState }
+
+func (FunctionParameterOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*FunctionParameter)(nil)).Elem()
+}
+
+func (o FunctionParameterOutput) ToFunctionParameterOutput() FunctionParameterOutput {
+	return o
+}
+
+func (o FunctionParameterOutput) ToFunctionParameterOutputWithContext(ctx context.Context) FunctionParameterOutput {
+	return o
+}
+
+// Parameter name
+func (o FunctionParameterOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v FunctionParameter) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// SQL type of the parameter, e.g. VARCHAR
+func (o FunctionParameterOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v FunctionParameter) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type FunctionParameterArrayOutput struct{ *pulumi.OutputState }
+
+func (FunctionParameterArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]FunctionParameter)(nil)).Elem()
+}
+
+func (o FunctionParameterArrayOutput) ToFunctionParameterArrayOutput() FunctionParameterArrayOutput {
+	return o
+}
+
+func (o FunctionParameterArrayOutput) ToFunctionParameterArrayOutputWithContext(ctx context.Context) FunctionParameterArrayOutput {
+	return o
+}
+
+func (o FunctionParameterArrayOutput) Index(i pulumi.IntInput) FunctionParameterOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) FunctionParameter {
+		return vs[0].([]FunctionParameter)[vs[1].(int)]
+	}).(FunctionParameterOutput)
+}
+
 type GetDatabaseResult struct {
 	CreatedAt string `pulumi:"createdAt"`
 	Name      string `pulumi:"name"`
@@ -65,6 +388,58 @@ func (o GetDatabaseResultArrayOutput) Index(i pulumi.IntInput) GetDatabaseResult
 	}).(GetDatabaseResultOutput)
 }
 
+type GetEntityResult struct {
+	IsLeaf bool   `pulumi:"isLeaf"`
+	Name   string `pulumi:"name"`
+	Store  string `pulumi:"store"`
+}
+
+type GetEntityResultOutput struct{ *pulumi.OutputState }
+
+func (GetEntityResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetEntityResult)(nil)).Elem()
+}
+
+func (o GetEntityResultOutput) ToGetEntityResultOutput() GetEntityResultOutput {
+	return o
+}
+
+func (o GetEntityResultOutput) ToGetEntityResultOutputWithContext(ctx context.Context) GetEntityResultOutput {
+	return o
+}
+
+func (o GetEntityResultOutput) IsLeaf() pulumi.BoolOutput {
+	return o.ApplyT(func(v GetEntityResult) bool { return v.IsLeaf }).(pulumi.BoolOutput)
+}
+
+func (o GetEntityResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v GetEntityResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+func (o GetEntityResultOutput) Store() pulumi.StringOutput {
+	return o.ApplyT(func(v GetEntityResult) string { return v.Store }).(pulumi.StringOutput)
+}
+
+type GetEntityResultArrayOutput struct{ *pulumi.OutputState }
+
+func (GetEntityResultArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]GetEntityResult)(nil)).Elem()
+}
+
+func (o GetEntityResultArrayOutput) ToGetEntityResultArrayOutput() GetEntityResultArrayOutput {
+	return o
+}
+
+func (o GetEntityResultArrayOutput) ToGetEntityResultArrayOutputWithContext(ctx context.Context) GetEntityResultArrayOutput {
+	return o
+}
+
+func (o GetEntityResultArrayOutput) Index(i pulumi.IntInput) GetEntityResultOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) GetEntityResult {
+		return vs[0].([]GetEntityResult)[vs[1].(int)]
+	}).(GetEntityResultOutput)
+}
+
 type GetNamespaceResult struct {
 	CreatedAt string `pulumi:"createdAt"`
 	Database  string `pulumi:"database"`
@@ -276,6 +651,7 @@ type KafkaInputs struct {
 	MskIamRoleArn           *string `pulumi:"mskIamRoleArn"`
 	SaslHashFunction        string  `pulumi:"saslHashFunction"`
 	SaslPassword            *string `pulumi:"saslPassword"`
+	SaslPasswordSecret      *string `pulumi:"saslPasswordSecret"`
 	SaslUsername            *string `pulumi:"saslUsername"`
 	SchemaRegistryName      *string `pulumi:"schemaRegistryName"`
 	TlsCaCertFile           *string `pulumi:"tlsCaCertFile"`
@@ -300,6 +676,7 @@ type KafkaInputsArgs struct {
 	MskIamRoleArn           pulumi.StringPtrInput `pulumi:"mskIamRoleArn"`
 	SaslHashFunction        pulumi.StringInput    `pulumi:"saslHashFunction"`
 	SaslPassword            pulumi.StringPtrInput `pulumi:"saslPassword"`
+	SaslPasswordSecret      pulumi.StringPtrInput `pulumi:"saslPasswordSecret"`
 	SaslUsername            pulumi.StringPtrInput `pulumi:"saslUsername"`
 	SchemaRegistryName      pulumi.StringPtrInput `pulumi:"schemaRegistryName"`
 	TlsCaCertFile           pulumi.StringPtrInput `pulumi:"tlsCaCertFile"`
@@ -401,6 +778,10 @@ func (o KafkaInputsOutput) SaslPassword() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v KafkaInputs) *string { return v.SaslPassword }).(pulumi.StringPtrOutput)
 }
 
+func (o KafkaInputsOutput) SaslPasswordSecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KafkaInputs) *string { return v.SaslPasswordSecret }).(pulumi.StringPtrOutput)
+}
+
 func (o KafkaInputsOutput) SaslUsername() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v KafkaInputs) *string { return v.SaslUsername }).(pulumi.StringPtrOutput)
 }
@@ -485,6 +866,15 @@ func (o KafkaInputsPtrOutput) SaslPassword() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
+func (o KafkaInputsPtrOutput) SaslPasswordSecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *KafkaInputs) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SaslPasswordSecret
+	}).(pulumi.StringPtrOutput)
+}
+
 func (o KafkaInputsPtrOutput) SaslUsername() pulumi.StringPtrOutput {
 	return o.ApplyT(func(v *KafkaInputs) *string {
 		if v == nil {
@@ -539,12 +929,340 @@ func (o KafkaInputsPtrOutput) Uris() pulumi.StringPtrOutput {
 	}).(pulumi.StringPtrOutput)
 }
 
+type MigrationScript struct {
+	// Path to a file holding the script. Mutually exclusive with sql.
+	File *string `pulumi:"file"`
+	// Unique name of the script, recorded once it has been applied
+	Name string `pulumi:"name"`
+	// Inline script. Mutually exclusive with file.
+	Sql *string `pulumi:"sql"`
+}
+
+// MigrationScriptInput is an input type that accepts MigrationScriptArgs and MigrationScriptOutput values.
+// You can construct a concrete instance of `MigrationScriptInput` via:
+//
+//	MigrationScriptArgs{...}
+type MigrationScriptInput interface {
+	pulumi.Input
+
+	ToMigrationScriptOutput() MigrationScriptOutput
+	ToMigrationScriptOutputWithContext(context.Context) MigrationScriptOutput
+}
+
+type MigrationScriptArgs struct {
+	// Path to a file holding the script. Mutually exclusive with sql.
+	File pulumi.StringPtrInput `pulumi:"file"`
+	// Unique name of the script, recorded once it has been applied
+	Name pulumi.StringInput `pulumi:"name"`
+	// Inline script. Mutually exclusive with file.
+	Sql pulumi.StringPtrInput `pulumi:"sql"`
+}
+
+func (MigrationScriptArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*MigrationScript)(nil)).Elem()
+}
+
+func (i MigrationScriptArgs) ToMigrationScriptOutput() MigrationScriptOutput {
+	return i.ToMigrationScriptOutputWithContext(context.Background())
+}
+
+func (i MigrationScriptArgs) ToMigrationScriptOutputWithContext(ctx context.Context) MigrationScriptOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MigrationScriptOutput)
+}
+
+// MigrationScriptArrayInput is an input type that accepts MigrationScriptArray and MigrationScriptArrayOutput values.
+// You can construct a concrete instance of `MigrationScriptArrayInput` via:
+//
+//	MigrationScriptArray{ MigrationScriptArgs{...} }
+type MigrationScriptArrayInput interface {
+	pulumi.Input
+
+	ToMigrationScriptArrayOutput() MigrationScriptArrayOutput
+	ToMigrationScriptArrayOutputWithContext(context.Context) MigrationScriptArrayOutput
+}
+
+type MigrationScriptArray []MigrationScriptInput
+
+func (MigrationScriptArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MigrationScript)(nil)).Elem()
+}
+
+func (i MigrationScriptArray) ToMigrationScriptArrayOutput() MigrationScriptArrayOutput {
+	return i.ToMigrationScriptArrayOutputWithContext(context.Background())
+}
+
+func (i MigrationScriptArray) ToMigrationScriptArrayOutputWithContext(ctx context.Context) MigrationScriptArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(MigrationScriptArrayOutput)
+}
+
+type MigrationScriptOutput struct{ *pulumi.OutputState }
+
+func (MigrationScriptOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*MigrationScript)(nil)).Elem()
+}
+
+func (o MigrationScriptOutput) ToMigrationScriptOutput() MigrationScriptOutput {
+	return o
+}
+
+func (o MigrationScriptOutput) ToMigrationScriptOutputWithContext(ctx context.Context) MigrationScriptOutput {
+	return o
+}
+
+// Path to a file holding the script. Mutually exclusive with sql.
+func (o MigrationScriptOutput) File() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v MigrationScript) *string { return v.File }).(pulumi.StringPtrOutput)
+}
+
+// Unique name of the script, recorded once it has been applied
+func (o MigrationScriptOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v MigrationScript) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Inline script. Mutually exclusive with file.
+func (o MigrationScriptOutput) Sql() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v MigrationScript) *string { return v.Sql }).(pulumi.StringPtrOutput)
+}
+
+type MigrationScriptArrayOutput struct{ *pulumi.OutputState }
+
+func (MigrationScriptArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]MigrationScript)(nil)).Elem()
+}
+
+func (o MigrationScriptArrayOutput) ToMigrationScriptArrayOutput() MigrationScriptArrayOutput {
+	return o
+}
+
+func (o MigrationScriptArrayOutput) ToMigrationScriptArrayOutputWithContext(ctx context.Context) MigrationScriptArrayOutput {
+	return o
+}
+
+func (o MigrationScriptArrayOutput) Index(i pulumi.IntInput) MigrationScriptOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) MigrationScript {
+		return vs[0].([]MigrationScript)[vs[1].(int)]
+	}).(MigrationScriptOutput)
+}
+
+type ObjectColumn struct {
+	// Name of the column
+	Name string `pulumi:"name"`
+	// Whether the column accepts NULL values (default true)
+	Nullable *bool `pulumi:"nullable"`
+	// SQL data type of the column, e.g. VARCHAR, BIGINT or STRUCT<...>
+	Type string `pulumi:"type"`
+}
+
+// ObjectColumnInput is an input type that accepts ObjectColumnArgs and ObjectColumnOutput values.
+// You can construct a concrete instance of `ObjectColumnInput` via:
+//
+//	ObjectColumnArgs{...}
+type ObjectColumnInput interface {
+	pulumi.Input
+
+	ToObjectColumnOutput() ObjectColumnOutput
+	ToObjectColumnOutputWithContext(context.Context) ObjectColumnOutput
+}
+
+type ObjectColumnArgs struct {
+	// Name of the column
+	Name pulumi.StringInput `pulumi:"name"`
+	// Whether the column accepts NULL values (default true)
+	Nullable pulumi.BoolPtrInput `pulumi:"nullable"`
+	// SQL data type of the column, e.g. VARCHAR, BIGINT or STRUCT<...>
+	Type pulumi.StringInput `pulumi:"type"`
+}
+
+func (ObjectColumnArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ObjectColumn)(nil)).Elem()
+}
+
+func (i ObjectColumnArgs) ToObjectColumnOutput() ObjectColumnOutput {
+	return i.ToObjectColumnOutputWithContext(context.Background())
+}
+
+func (i ObjectColumnArgs) ToObjectColumnOutputWithContext(ctx context.Context) ObjectColumnOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ObjectColumnOutput)
+}
+
+// ObjectColumnArrayInput is an input type that accepts ObjectColumnArray and ObjectColumnArrayOutput values.
+// You can construct a concrete instance of `ObjectColumnArrayInput` via:
+//
+//	ObjectColumnArray{ ObjectColumnArgs{...} }
+type ObjectColumnArrayInput interface {
+	pulumi.Input
+
+	ToObjectColumnArrayOutput() ObjectColumnArrayOutput
+	ToObjectColumnArrayOutputWithContext(context.Context) ObjectColumnArrayOutput
+}
+
+type ObjectColumnArray []ObjectColumnInput
+
+func (ObjectColumnArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ObjectColumn)(nil)).Elem()
+}
+
+func (i ObjectColumnArray) ToObjectColumnArrayOutput() ObjectColumnArrayOutput {
+	return i.ToObjectColumnArrayOutputWithContext(context.Background())
+}
+
+func (i ObjectColumnArray) ToObjectColumnArrayOutputWithContext(ctx context.Context) ObjectColumnArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ObjectColumnArrayOutput)
+}
+
+type ObjectColumnOutput struct{ *pulumi.OutputState }
+
+func (ObjectColumnOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ObjectColumn)(nil)).Elem()
+}
+
+func (o ObjectColumnOutput) ToObjectColumnOutput() ObjectColumnOutput {
+	return o
+}
+
+func (o ObjectColumnOutput) ToObjectColumnOutputWithContext(ctx context.Context) ObjectColumnOutput {
+	return o
+}
+
+// Name of the column
+func (o ObjectColumnOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ObjectColumn) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Whether the column accepts NULL values (default true)
+func (o ObjectColumnOutput) Nullable() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v ObjectColumn) *bool { return v.Nullable }).(pulumi.BoolPtrOutput)
+}
+
+// SQL data type of the column, e.g. VARCHAR, BIGINT or STRUCT<...>
+func (o ObjectColumnOutput) Type() pulumi.StringOutput {
+	return o.ApplyT(func(v ObjectColumn) string { return v.Type }).(pulumi.StringOutput)
+}
+
+type ObjectColumnArrayOutput struct{ *pulumi.OutputState }
+
+func (ObjectColumnArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ObjectColumn)(nil)).Elem()
+}
+
+func (o ObjectColumnArrayOutput) ToObjectColumnArrayOutput() ObjectColumnArrayOutput {
+	return o
+}
+
+func (o ObjectColumnArrayOutput) ToObjectColumnArrayOutputWithContext(ctx context.Context) ObjectColumnArrayOutput {
+	return o
+}
+
+func (o ObjectColumnArrayOutput) Index(i pulumi.IntInput) ObjectColumnOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ObjectColumn {
+		return vs[0].([]ObjectColumn)[vs[1].(int)]
+	}).(ObjectColumnOutput)
+}
+
+type PipelineRelation struct {
+	// Ordered column definitions for structured definitions
+	Columns []ObjectColumn `pulumi:"columns"`
+	// Relation kind for structured definitions (stream|changelog|table)
+	Kind *string `pulumi:"kind"`
+	// Primary key columns; required for changelogs and not allowed otherwise
+	PrimaryKey []string `pulumi:"primaryKey"`
+	// Relation name for structured definitions
+	RelationName *string `pulumi:"relationName"`
+	// CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with the structured fields.
+	Sql *string `pulumi:"sql"`
+	// WITH properties for structured definitions, e.g. topic, value.format, snowflake.db.name
+	With map[string]string `pulumi:"with"`
+}
+
+// PipelineRelationInput is an input type that accepts PipelineRelationArgs and PipelineRelationOutput values.
+// You can construct a concrete instance of `PipelineRelationInput` via:
+//
+//	PipelineRelationArgs{...}
+type PipelineRelationInput interface {
+	pulumi.Input
+
+	ToPipelineRelationOutput() PipelineRelationOutput
+	ToPipelineRelationOutputWithContext(context.Context) PipelineRelationOutput
+}
+
+type PipelineRelationArgs struct {
+	// Ordered column definitions for structured definitions
+	Columns ObjectColumnArrayInput `pulumi:"columns"`
+	// Relation kind for structured definitions (stream|changelog|table)
+	Kind pulumi.StringPtrInput `pulumi:"kind"`
+	// Primary key columns; required for changelogs and not allowed otherwise
+	PrimaryKey pulumi.StringArrayInput `pulumi:"primaryKey"`
+	// Relation name for structured definitions
+	RelationName pulumi.StringPtrInput `pulumi:"relationName"`
+	// CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with the structured fields.
+	Sql pulumi.StringPtrInput `pulumi:"sql"`
+	// WITH properties for structured definitions, e.g. topic, value.format, snowflake.db.name
+	With pulumi.StringMapInput `pulumi:"with"`
+}
+
+func (PipelineRelationArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*PipelineRelation)(nil)).Elem()
+}
+
+func (i PipelineRelationArgs) ToPipelineRelationOutput() PipelineRelationOutput {
+	return i.ToPipelineRelationOutputWithContext(context.Background())
+}
+
+func (i PipelineRelationArgs) ToPipelineRelationOutputWithContext(ctx context.Context) PipelineRelationOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PipelineRelationOutput)
+}
+
+type PipelineRelationOutput struct{ *pulumi.OutputState }
+
+func (PipelineRelationOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PipelineRelation)(nil)).Elem()
+}
+
+func (o PipelineRelationOutput) ToPipelineRelationOutput() PipelineRelationOutput {
+	return o
+}
+
+func (o PipelineRelationOutput) ToPipelineRelationOutputWithContext(ctx context.Context) PipelineRelationOutput {
+	return o
+}
+
+// Ordered column definitions for structured definitions
+func (o PipelineRelationOutput) Columns() ObjectColumnArrayOutput {
+	return o.ApplyT(func(v PipelineRelation) []ObjectColumn { return v.Columns }).(ObjectColumnArrayOutput)
+}
+
+// Relation kind for structured definitions (stream|changelog|table)
+func (o PipelineRelationOutput) Kind() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PipelineRelation) *string { return v.Kind }).(pulumi.StringPtrOutput)
+}
+
+// Primary key columns; required for changelogs and not allowed otherwise
+func (o PipelineRelationOutput) PrimaryKey() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v PipelineRelation) []string { return v.PrimaryKey }).(pulumi.StringArrayOutput)
+}
+
+// Relation name for structured definitions
+func (o PipelineRelationOutput) RelationName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PipelineRelation) *string { return v.RelationName }).(pulumi.StringPtrOutput)
+}
+
+// CREATE STREAM/CHANGELOG/TABLE statement. Mutually exclusive with the structured fields.
+func (o PipelineRelationOutput) Sql() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PipelineRelation) *string { return v.Sql }).(pulumi.StringPtrOutput)
+}
+
+// WITH properties for structured definitions, e.g. topic, value.format, snowflake.db.name
+func (o PipelineRelationOutput) With() pulumi.StringMapOutput {
+	return o.ApplyT(func(v PipelineRelation) map[string]string { return v.With }).(pulumi.StringMapOutput)
+}
+
 type PostgresInputs struct {
-	Password                string `pulumi:"password"`
-	TlsDisabled             *bool  `pulumi:"tlsDisabled"`
-	TlsVerifyServerHostname *bool  `pulumi:"tlsVerifyServerHostname"`
-	Uris                    string `pulumi:"uris"`
-	Username                string `pulumi:"username"`
+	Password                *string `pulumi:"password"`
+	PasswordSecret          *string `pulumi:"passwordSecret"`
+	TlsDisabled             *bool   `pulumi:"tlsDisabled"`
+	TlsVerifyServerHostname *bool   `pulumi:"tlsVerifyServerHostname"`
+	Uris                    string  `pulumi:"uris"`
+	Username                string  `pulumi:"username"`
 }
 
 // PostgresInputsInput is an input type that accepts PostgresInputsArgs and PostgresInputsOutput values.
@@ -559,11 +1277,12 @@ type PostgresInputsInput interface {
 }
 
 type PostgresInputsArgs struct {
-	Password                pulumi.StringInput  `pulumi:"password"`
-	TlsDisabled             pulumi.BoolPtrInput `pulumi:"tlsDisabled"`
-	TlsVerifyServerHostname pulumi.BoolPtrInput `pulumi:"tlsVerifyServerHostname"`
-	Uris                    pulumi.StringInput  `pulumi:"uris"`
-	Username                pulumi.StringInput  `pulumi:"username"`
+	Password                pulumi.StringPtrInput `pulumi:"password"`
+	PasswordSecret          pulumi.StringPtrInput `pulumi:"passwordSecret"`
+	TlsDisabled             pulumi.BoolPtrInput   `pulumi:"tlsDisabled"`
+	TlsVerifyServerHostname pulumi.BoolPtrInput   `pulumi:"tlsVerifyServerHostname"`
+	Uris                    pulumi.StringInput    `pulumi:"uris"`
+	Username                pulumi.StringInput    `pulumi:"username"`
 }
 
 func (PostgresInputsArgs) ElementType() reflect.Type {
@@ -643,8 +1362,12 @@ func (o PostgresInputsOutput) ToPostgresInputsPtrOutputWithContext(ctx context.C
 	}).(PostgresInputsPtrOutput)
 }
 
-func (o PostgresInputsOutput) Password() pulumi.StringOutput {
-	return o.ApplyT(func(v PostgresInputs) string { return v.Password }).(pulumi.StringOutput)
+func (o PostgresInputsOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PostgresInputs) *string { return v.Password }).(pulumi.StringPtrOutput)
+}
+
+func (o PostgresInputsOutput) PasswordSecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PostgresInputs) *string { return v.PasswordSecret }).(pulumi.StringPtrOutput)
 }
 
 func (o PostgresInputsOutput) TlsDisabled() pulumi.BoolPtrOutput {
@@ -692,7 +1415,16 @@ func (o PostgresInputsPtrOutput) Password() pulumi.StringPtrOutput {
 		if v == nil {
 			return nil
 		}
-		return &v.Password
+		return v.Password
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o PostgresInputsPtrOutput) PasswordSecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PostgresInputs) *string {
+		if v == nil {
+			return nil
+		}
+		return v.PasswordSecret
 	}).(pulumi.StringPtrOutput)
 }
 
@@ -733,13 +1465,14 @@ func (o PostgresInputsPtrOutput) Username() pulumi.StringPtrOutput {
 }
 
 type SnowflakeInputs struct {
-	AccountId     string `pulumi:"accountId"`
-	ClientKey     string `pulumi:"clientKey"`
-	CloudRegion   string `pulumi:"cloudRegion"`
-	RoleName      string `pulumi:"roleName"`
-	Uris          string `pulumi:"uris"`
-	Username      string `pulumi:"username"`
-	WarehouseName string `pulumi:"warehouseName"`
+	AccountId       string  `pulumi:"accountId"`
+	ClientKey       *string `pulumi:"clientKey"`
+	ClientKeySecret *string `pulumi:"clientKeySecret"`
+	CloudRegion     string  `pulumi:"cloudRegion"`
+	RoleName        string  `pulumi:"roleName"`
+	Uris            string  `pulumi:"uris"`
+	Username        string  `pulumi:"username"`
+	WarehouseName   string  `pulumi:"warehouseName"`
 }
 
 // SnowflakeInputsInput is an input type that accepts SnowflakeInputsArgs and SnowflakeInputsOutput values.
@@ -754,13 +1487,14 @@ type SnowflakeInputsInput interface {
 }
 
 type SnowflakeInputsArgs struct {
-	AccountId     pulumi.StringInput `pulumi:"accountId"`
-	ClientKey     pulumi.StringInput `pulumi:"clientKey"`
-	CloudRegion   pulumi.StringInput `pulumi:"cloudRegion"`
-	RoleName      pulumi.StringInput `pulumi:"roleName"`
-	Uris          pulumi.StringInput `pulumi:"uris"`
-	Username      pulumi.StringInput `pulumi:"username"`
-	WarehouseName pulumi.StringInput `pulumi:"warehouseName"`
+	AccountId       pulumi.StringInput    `pulumi:"accountId"`
+	ClientKey       pulumi.StringPtrInput `pulumi:"clientKey"`
+	ClientKeySecret pulumi.StringPtrInput `pulumi:"clientKeySecret"`
+	CloudRegion     pulumi.StringInput    `pulumi:"cloudRegion"`
+	RoleName        pulumi.StringInput    `pulumi:"roleName"`
+	Uris            pulumi.StringInput    `pulumi:"uris"`
+	Username        pulumi.StringInput    `pulumi:"username"`
+	WarehouseName   pulumi.StringInput    `pulumi:"warehouseName"`
 }
 
 func (SnowflakeInputsArgs) ElementType() reflect.Type {
@@ -844,8 +1578,12 @@ func (o SnowflakeInputsOutput) AccountId() pulumi.StringOutput {
 	return o.ApplyT(func(v SnowflakeInputs) string { return v.AccountId }).(pulumi.StringOutput)
 }
 
-func (o SnowflakeInputsOutput) ClientKey() pulumi.StringOutput {
-	return o.ApplyT(func(v SnowflakeInputs) string { return v.ClientKey }).(pulumi.StringOutput)
+func (o SnowflakeInputsOutput) ClientKey() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SnowflakeInputs) *string { return v.ClientKey }).(pulumi.StringPtrOutput)
+}
+
+func (o SnowflakeInputsOutput) ClientKeySecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SnowflakeInputs) *string { return v.ClientKeySecret }).(pulumi.StringPtrOutput)
 }
 
 func (o SnowflakeInputsOutput) CloudRegion() pulumi.StringOutput {
@@ -906,7 +1644,16 @@ func (o SnowflakeInputsPtrOutput) ClientKey() pulumi.StringPtrOutput {
 		if v == nil {
 			return nil
 		}
-		return &v.ClientKey
+		return v.ClientKey
+	}).(pulumi.StringPtrOutput)
+}
+
+func (o SnowflakeInputsPtrOutput) ClientKeySecret() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SnowflakeInputs) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClientKeySecret
 	}).(pulumi.StringPtrOutput)
 }
 
@@ -956,14 +1703,31 @@ func (o SnowflakeInputsPtrOutput) WarehouseName() pulumi.StringPtrOutput {
 }
 
 func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*EntityDescriptorInput)(nil)).Elem(), EntityDescriptorArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EntityDescriptorPtrInput)(nil)).Elem(), EntityDescriptorArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FunctionParameterInput)(nil)).Elem(), FunctionParameterArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*FunctionParameterArrayInput)(nil)).Elem(), FunctionParameterArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*KafkaInputsInput)(nil)).Elem(), KafkaInputsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*KafkaInputsPtrInput)(nil)).Elem(), KafkaInputsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MigrationScriptInput)(nil)).Elem(), MigrationScriptArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*MigrationScriptArrayInput)(nil)).Elem(), MigrationScriptArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ObjectColumnInput)(nil)).Elem(), ObjectColumnArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ObjectColumnArrayInput)(nil)).Elem(), ObjectColumnArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PipelineRelationInput)(nil)).Elem(), PipelineRelationArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*PostgresInputsInput)(nil)).Elem(), PostgresInputsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*PostgresInputsPtrInput)(nil)).Elem(), PostgresInputsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SnowflakeInputsInput)(nil)).Elem(), SnowflakeInputsArgs{})
 	pulumi.RegisterInputType(reflect.TypeOf((*SnowflakeInputsPtrInput)(nil)).Elem(), SnowflakeInputsArgs{})
+	pulumi.RegisterOutputType(AppliedMigrationOutput{})
+	pulumi.RegisterOutputType(AppliedMigrationArrayOutput{})
+	pulumi.RegisterOutputType(EntityDescriptorOutput{})
+	pulumi.RegisterOutputType(EntityDescriptorPtrOutput{})
+	pulumi.RegisterOutputType(FunctionParameterOutput{})
+	pulumi.RegisterOutputType(FunctionParameterArrayOutput{})
 	pulumi.RegisterOutputType(GetDatabaseResultOutput{})
 	pulumi.RegisterOutputType(GetDatabaseResultArrayOutput{})
+	pulumi.RegisterOutputType(GetEntityResultOutput{})
+	pulumi.RegisterOutputType(GetEntityResultArrayOutput{})
 	pulumi.RegisterOutputType(GetNamespaceResultOutput{})
 	pulumi.RegisterOutputType(GetNamespaceResultArrayOutput{})
 	pulumi.RegisterOutputType(GetObjectResultOutput{})
@@ -972,6 +1736,11 @@ func init() {
 	pulumi.RegisterOutputType(GetStoreResultArrayOutput{})
 	pulumi.RegisterOutputType(KafkaInputsOutput{})
 	pulumi.RegisterOutputType(KafkaInputsPtrOutput{})
+	pulumi.RegisterOutputType(MigrationScriptOutput{})
+	pulumi.RegisterOutputType(MigrationScriptArrayOutput{})
+	pulumi.RegisterOutputType(ObjectColumnOutput{})
+	pulumi.RegisterOutputType(ObjectColumnArrayOutput{})
+	pulumi.RegisterOutputType(PipelineRelationOutput{})
 	pulumi.RegisterOutputType(PostgresInputsOutput{})
 	pulumi.RegisterOutputType(PostgresInputsPtrOutput{})
 	pulumi.RegisterOutputType(SnowflakeInputsOutput{})
@@ -16,12 +16,16 @@ import (
 type Query struct {
 	pulumi.CustomResourceState
 
-	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
-	Owner     pulumi.StringOutput `pulumi:"owner"`
+	ComputePool pulumi.StringPtrOutput `pulumi:"computePool"`
+	CreatedAt   pulumi.StringOutput    `pulumi:"createdAt"`
+	Owner       pulumi.StringOutput    `pulumi:"owner"`
+	Parameters  pulumi.StringMapOutput `pulumi:"parameters"`
 	// System-generated query identifier
-	QueryId            pulumi.StringOutput      `pulumi:"queryId"`
-	QueryName          pulumi.StringPtrOutput   `pulumi:"queryName"`
-	QueryVersion       pulumi.IntPtrOutput      `pulumi:"queryVersion"`
+	QueryId      pulumi.StringOutput    `pulumi:"queryId"`
+	QueryName    pulumi.StringPtrOutput `pulumi:"queryName"`
+	QueryVersion pulumi.IntPtrOutput    `pulumi:"queryVersion"`
+	// SQL statement after substituting parameters; used for change detection
+	RenderedSql        pulumi.StringOutput      `pulumi:"renderedSql"`
 	SinkRelationFqn    pulumi.StringOutput      `pulumi:"sinkRelationFqn"`
 	SourceRelationFqns pulumi.StringArrayOutput `pulumi:"sourceRelationFqns"`
 	Sql                pulumi.StringOutput      `pulumi:"sql"`
@@ -79,15 +83,19 @@ func (QueryState) ElementType() reflect.Type {
 }
 
 type queryArgs struct {
-	Owner              *string  `pulumi:"owner"`
-	SinkRelationFqn    string   `pulumi:"sinkRelationFqn"`
-	SourceRelationFqns []string `pulumi:"sourceRelationFqns"`
-	Sql                string   `pulumi:"sql"`
+	ComputePool        *string           `pulumi:"computePool"`
+	Owner              *string           `pulumi:"owner"`
+	Parameters         map[string]string `pulumi:"parameters"`
+	SinkRelationFqn    string            `pulumi:"sinkRelationFqn"`
+	SourceRelationFqns []string          `pulumi:"sourceRelationFqns"`
+	Sql                string            `pulumi:"sql"`
 }
 
 // The set of arguments for constructing a Query resource.
 type QueryArgs struct {
+	ComputePool        pulumi.StringPtrInput
 	Owner              pulumi.StringPtrInput
+	Parameters         pulumi.StringMapInput
 	SinkRelationFqn    pulumi.StringInput
 	SourceRelationFqns pulumi.StringArrayInput
 	Sql                pulumi.StringInput
@@ -180,6 +188,10 @@ func (o QueryOutput) ToQueryOutputWithContext(ctx context.Context) QueryOutput {
 	return o
 }
 
+func (o QueryOutput) ComputePool() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Query) pulumi.StringPtrOutput { return v.ComputePool }).(pulumi.StringPtrOutput)
+}
+
 func (o QueryOutput) CreatedAt() pulumi.StringOutput {
 	return o.ApplyT(func(v *Query) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
 }
@@ -188,6 +200,10 @@ func (o QueryOutput) Owner() pulumi.StringOutput {
 	return o.ApplyT(func(v *Query) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
 }
 
+func (o QueryOutput) Parameters() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Query) pulumi.StringMapOutput { return v.Parameters }).(pulumi.StringMapOutput)
+}
+
 // System-generated query identifier
 func (o QueryOutput) QueryId() pulumi.StringOutput {
 	return o.ApplyT(func(v *Query) pulumi.StringOutput { return v.QueryId }).(pulumi.StringOutput)
@@ -201,6 +217,11 @@ func (o QueryOutput) QueryVersion() pulumi.IntPtrOutput {
 	return o.ApplyT(func(v *Query) pulumi.IntPtrOutput { return v.QueryVersion }).(pulumi.IntPtrOutput)
 }
 
+// SQL statement after substituting parameters; used for change detection
+func (o QueryOutput) RenderedSql() pulumi.StringOutput {
+	return o.ApplyT(func(v *Query) pulumi.StringOutput { return v.RenderedSql }).(pulumi.StringOutput)
+}
+
 func (o QueryOutput) SinkRelationFqn() pulumi.StringOutput {
 	return o.ApplyT(func(v *Query) pulumi.StringOutput { return v.SinkRelationFqn }).(pulumi.StringOutput)
 }
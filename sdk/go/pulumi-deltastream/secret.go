@@ -0,0 +1,290 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Secret resource storing a credential server-side. Rotating the value updates the secret in place.
+type Secret struct {
+	pulumi.CustomResourceState
+
+	// Optional region in which the secret is stored
+	AccessRegion pulumi.StringPtrOutput `pulumi:"accessRegion"`
+	CreatedAt    pulumi.StringOutput    `pulumi:"createdAt"`
+	// Optional description. Updated in place.
+	Description pulumi.StringPtrOutput `pulumi:"description"`
+	// Name of the secret
+	Name pulumi.StringOutput `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringOutput `pulumi:"owner"`
+	// Secret type (default GENERIC_STRING)
+	Type      pulumi.StringPtrOutput `pulumi:"type"`
+	UpdatedAt pulumi.StringOutput    `pulumi:"updatedAt"`
+	// Secret value. Changing it rotates the secret in place.
+	Value pulumi.StringOutput `pulumi:"value"`
+}
+
+// NewSecret registers a new resource with the given unique name, arguments, and options.
+func NewSecret(ctx *pulumi.Context,
+	name string, args *SecretArgs, opts ...pulumi.ResourceOption) (*Secret, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Name == nil {
+		return nil, errors.New("invalid value for required argument 'Name'")
+	}
+	if args.Value == nil {
+		return nil, errors.New("invalid value for required argument 'Value'")
+	}
+	if args.Value != nil {
+		args.Value = pulumi.ToSecret(args.Value).(pulumi.StringInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"value",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Secret
+	err := ctx.RegisterResource("deltastream:index:Secret", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetSecret gets an existing Secret resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetSecret(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *SecretState, opts ...pulumi.ResourceOption) (*Secret, error) {
+	var resource Secret
+	err := ctx.ReadResource("deltastream:index:Secret", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Secret resources.
+type secretState struct {
+}
+
+type SecretState struct {
+}
+
+func (SecretState) ElementType() reflect.Type {
+	return reflect.TypeOf((*secretState)(nil)).Elem()
+}
+
+type secretArgs struct {
+	// Optional region in which the secret is stored
+	AccessRegion *string `pulumi:"accessRegion"`
+	// Optional description. Updated in place.
+	Description *string `pulumi:"description"`
+	// Name of the secret
+	Name string `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role.
+	Owner *string `pulumi:"owner"`
+	// Secret type (default GENERIC_STRING)
+	Type *string `pulumi:"type"`
+	// Secret value. Changing it rotates the secret in place.
+	Value string `pulumi:"value"`
+}
+
+// The set of arguments for constructing a Secret resource.
+type SecretArgs struct {
+	// Optional region in which the secret is stored
+	AccessRegion pulumi.StringPtrInput
+	// Optional description. Updated in place.
+	Description pulumi.StringPtrInput
+	// Name of the secret
+	Name pulumi.StringInput
+	// Optional owning role. When set, statements execute as this role.
+	Owner pulumi.StringPtrInput
+	// Secret type (default GENERIC_STRING)
+	Type pulumi.StringPtrInput
+	// Secret value. Changing it rotates the secret in place.
+	Value pulumi.StringInput
+}
+
+func (SecretArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*secretArgs)(nil)).Elem()
+}
+
+type SecretInput interface {
+	pulumi.Input
+
+	ToSecretOutput() SecretOutput
+	ToSecretOutputWithContext(ctx context.Context) SecretOutput
+}
+
+func (*Secret) ElementType() reflect.Type {
+	return reflect.TypeOf((**Secret)(nil)).Elem()
+}
+
+func (i *Secret) ToSecretOutput() SecretOutput {
+	return i.ToSecretOutputWithContext(context.Background())
+}
+
+func (i *Secret) ToSecretOutputWithContext(ctx context.Context) SecretOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretOutput)
+}
+
+// SecretArrayInput is an input type that accepts SecretArray and SecretArrayOutput values.
+// You can construct a concrete instance of `SecretArrayInput` via:
+//
+//	SecretArray{ SecretArgs{...} }
+type SecretArrayInput interface {
+	pulumi.Input
+
+	ToSecretArrayOutput() SecretArrayOutput
+	ToSecretArrayOutputWithContext(context.Context) SecretArrayOutput
+}
+
+type SecretArray []SecretInput
+
+func (SecretArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Secret)(nil)).Elem()
+}
+
+func (i SecretArray) ToSecretArrayOutput() SecretArrayOutput {
+	return i.ToSecretArrayOutputWithContext(context.Background())
+}
+
+func (i SecretArray) ToSecretArrayOutputWithContext(ctx context.Context) SecretArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretArrayOutput)
+}
+
+// SecretMapInput is an input type that accepts SecretMap and SecretMapOutput values.
+// You can construct a concrete instance of `SecretMapInput` via:
+//
+//	SecretMap{ "key": SecretArgs{...} }
+type SecretMapInput interface {
+	pulumi.Input
+
+	ToSecretMapOutput() SecretMapOutput
+	ToSecretMapOutputWithContext(context.Context) SecretMapOutput
+}
+
+type SecretMap map[string]SecretInput
+
+func (SecretMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Secret)(nil)).Elem()
+}
+
+func (i SecretMap) ToSecretMapOutput() SecretMapOutput {
+	return i.ToSecretMapOutputWithContext(context.Background())
+}
+
+func (i SecretMap) ToSecretMapOutputWithContext(ctx context.Context) SecretMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretMapOutput)
+}
+
+type SecretOutput struct{ *pulumi.OutputState }
+
+func (SecretOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Secret)(nil)).Elem()
+}
+
+func (o SecretOutput) ToSecretOutput() SecretOutput {
+	return o
+}
+
+func (o SecretOutput) ToSecretOutputWithContext(ctx context.Context) SecretOutput {
+	return o
+}
+
+// Optional region in which the secret is stored
+func (o SecretOutput) AccessRegion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Secret) pulumi.StringPtrOutput { return v.AccessRegion }).(pulumi.StringPtrOutput)
+}
+
+func (o SecretOutput) CreatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *Secret) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
+}
+
+// Optional description. Updated in place.
+func (o SecretOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Secret) pulumi.StringPtrOutput { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// Name of the secret
+func (o SecretOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v *Secret) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
+}
+
+// Optional owning role. When set, statements execute as this role.
+func (o SecretOutput) Owner() pulumi.StringOutput {
+	return o.ApplyT(func(v *Secret) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
+}
+
+// Secret type (default GENERIC_STRING)
+func (o SecretOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Secret) pulumi.StringPtrOutput { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+func (o SecretOutput) UpdatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v *Secret) pulumi.StringOutput { return v.UpdatedAt }).(pulumi.StringOutput)
+}
+
+// Secret value. Changing it rotates the secret in place.
+func (o SecretOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v *Secret) pulumi.StringOutput { return v.Value }).(pulumi.StringOutput)
+}
+
+type SecretArrayOutput struct{ *pulumi.OutputState }
+
+func (SecretArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Secret)(nil)).Elem()
+}
+
+func (o SecretArrayOutput) ToSecretArrayOutput() SecretArrayOutput {
+	return o
+}
+
+func (o SecretArrayOutput) ToSecretArrayOutputWithContext(ctx context.Context) SecretArrayOutput {
+	return o
+}
+
+func (o SecretArrayOutput) Index(i pulumi.IntInput) SecretOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Secret {
+		return vs[0].([]*Secret)[vs[1].(int)]
+	}).(SecretOutput)
+}
+
+type SecretMapOutput struct{ *pulumi.OutputState }
+
+func (SecretMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Secret)(nil)).Elem()
+}
+
+func (o SecretMapOutput) ToSecretMapOutput() SecretMapOutput {
+	return o
+}
+
+func (o SecretMapOutput) ToSecretMapOutputWithContext(ctx context.Context) SecretMapOutput {
+	return o
+}
+
+func (o SecretMapOutput) MapIndex(k pulumi.StringInput) SecretOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Secret {
+		return vs[0].(map[string]*Secret)[vs[1].(string)]
+	}).(SecretOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*SecretInput)(nil)).Elem(), &Secret{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SecretArrayInput)(nil)).Elem(), SecretArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SecretMapInput)(nil)).Elem(), SecretMap{})
+	pulumi.RegisterOutputType(SecretOutput{})
+	pulumi.RegisterOutputType(SecretArrayOutput{})
+	pulumi.RegisterOutputType(SecretMapOutput{})
+}
@@ -0,0 +1,299 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package pulumideltastream
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/deltastreaminc/pulumi-deltastream/sdk/go/pulumi-deltastream/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Statement resource running arbitrary DeltaStream SQL for objects without a dedicated resource. createSql runs on create, updateSql (if set) applies createSql changes in place, deleteSql runs on delete and readSql probes existence and drift.
+type Statement struct {
+	pulumi.CustomResourceState
+
+	// Statement run on create. Changes replace the resource unless updateSql is set.
+	CreateSql pulumi.StringOutput `pulumi:"createSql"`
+	// Optional database the statements run in
+	Database pulumi.StringPtrOutput `pulumi:"database"`
+	// Optional statement run on delete. Without it deleting the resource only removes it from the stack.
+	DeleteSql pulumi.StringPtrOutput `pulumi:"deleteSql"`
+	// Optional namespace the statements run in
+	Namespace pulumi.StringPtrOutput `pulumi:"namespace"`
+	// Optional role the statements execute as
+	Owner pulumi.StringPtrOutput `pulumi:"owner"`
+	// Rows returned by readSql, keyed by lower-cased column name. Empty when readSql is not set.
+	ReadResult pulumi.StringMapArrayOutput `pulumi:"readResult"`
+	// Optional query run after create/update and on refresh. Its rows become the readResult output; no rows means the object no longer exists.
+	ReadSql pulumi.StringPtrOutput `pulumi:"readSql"`
+	// Optional default store for the statements
+	Store pulumi.StringPtrOutput `pulumi:"store"`
+	// Optional statement run when createSql changes, instead of replacing the resource
+	UpdateSql pulumi.StringPtrOutput `pulumi:"updateSql"`
+}
+
+// NewStatement registers a new resource with the given unique name, arguments, and options.
+func NewStatement(ctx *pulumi.Context,
+	name string, args *StatementArgs, opts ...pulumi.ResourceOption) (*Statement, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.CreateSql == nil {
+		return nil, errors.New("invalid value for required argument 'CreateSql'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource Statement
+	err := ctx.RegisterResource("deltastream:index:Statement", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetStatement gets an existing Statement resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetStatement(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *StatementState, opts ...pulumi.ResourceOption) (*Statement, error) {
+	var resource Statement
+	err := ctx.ReadResource("deltastream:index:Statement", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering Statement resources.
+type statementState struct {
+}
+
+type StatementState struct {
+}
+
+func (StatementState) ElementType() reflect.Type {
+	return reflect.TypeOf((*statementState)(nil)).Elem()
+}
+
+type statementArgs struct {
+	// Statement run on create. Changes replace the resource unless updateSql is set.
+	CreateSql string `pulumi:"createSql"`
+	// Optional database the statements run in
+	Database *string `pulumi:"database"`
+	// Optional statement run on delete. Without it deleting the resource only removes it from the stack.
+	DeleteSql *string `pulumi:"deleteSql"`
+	// Optional namespace the statements run in
+	Namespace *string `pulumi:"namespace"`
+	// Optional role the statements execute as
+	Owner *string `pulumi:"owner"`
+	// Optional query run after create/update and on refresh. Its rows become the readResult output; no rows means the object no longer exists.
+	ReadSql *string `pulumi:"readSql"`
+	// Optional default store for the statements
+	Store *string `pulumi:"store"`
+	// Optional statement run when createSql changes, instead of replacing the resource
+	UpdateSql *string `pulumi:"updateSql"`
+}
+
+// The set of arguments for constructing a Statement resource.
+type StatementArgs struct {
+	// Statement run on create. Changes replace the resource unless updateSql is set.
+	CreateSql pulumi.StringInput
+	// Optional database the statements run in
+	Database pulumi.StringPtrInput
+	// Optional statement run on delete. Without it deleting the resource only removes it from the stack.
+	DeleteSql pulumi.StringPtrInput
+	// Optional namespace the statements run in
+	Namespace pulumi.StringPtrInput
+	// Optional role the statements execute as
+	Owner pulumi.StringPtrInput
+	// Optional query run after create/update and on refresh. Its rows become the readResult output; no rows means the object no longer exists.
+	ReadSql pulumi.StringPtrInput
+	// Optional default store for the statements
+	Store pulumi.StringPtrInput
+	// Optional statement run when createSql changes, instead of replacing the resource
+	UpdateSql pulumi.StringPtrInput
+}
+
+func (StatementArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*statementArgs)(nil)).Elem()
+}
+
+type StatementInput interface {
+	pulumi.Input
+
+	ToStatementOutput() StatementOutput
+	ToStatementOutputWithContext(ctx context.Context) StatementOutput
+}
+
+func (*Statement) ElementType() reflect.Type {
+	return reflect.TypeOf((**Statement)(nil)).Elem()
+}
+
+func (i *Statement) ToStatementOutput() StatementOutput {
+	return i.ToStatementOutputWithContext(context.Background())
+}
+
+func (i *Statement) ToStatementOutputWithContext(ctx context.Context) StatementOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(StatementOutput)
+}
+
+// StatementArrayInput is an input type that accepts StatementArray and StatementArrayOutput values.
+// You can construct a concrete instance of `StatementArrayInput` via:
+//
+//	StatementArray{ StatementArgs{...} }
+type StatementArrayInput interface {
+	pulumi.Input
+
+	ToStatementArrayOutput() StatementArrayOutput
+	ToStatementArrayOutputWithContext(context.Context) StatementArrayOutput
+}
+
+type StatementArray []StatementInput
+
+func (StatementArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Statement)(nil)).Elem()
+}
+
+func (i StatementArray) ToStatementArrayOutput() StatementArrayOutput {
+	return i.ToStatementArrayOutputWithContext(context.Background())
+}
+
+func (i StatementArray) ToStatementArrayOutputWithContext(ctx context.Context) StatementArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(StatementArrayOutput)
+}
+
+// StatementMapInput is an input type that accepts StatementMap and StatementMapOutput values.
+// You can construct a concrete instance of `StatementMapInput` via:
+//
+//	StatementMap{ "key": StatementArgs{...} }
+type StatementMapInput interface {
+	pulumi.Input
+
+	ToStatementMapOutput() StatementMapOutput
+	ToStatementMapOutputWithContext(context.Context) StatementMapOutput
+}
+
+type StatementMap map[string]StatementInput
+
+func (StatementMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Statement)(nil)).Elem()
+}
+
+func (i StatementMap) ToStatementMapOutput() StatementMapOutput {
+	return i.ToStatementMapOutputWithContext(context.Background())
+}
+
+func (i StatementMap) ToStatementMapOutputWithContext(ctx context.Context) StatementMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(StatementMapOutput)
+}
+
+type StatementOutput struct{ *pulumi.OutputState }
+
+func (StatementOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Statement)(nil)).Elem()
+}
+
+func (o StatementOutput) ToStatementOutput() StatementOutput {
+	return o
+}
+
+func (o StatementOutput) ToStatementOutputWithContext(ctx context.Context) StatementOutput {
+	return o
+}
+
+// Statement run on create. Changes replace the resource unless updateSql is set.
+func (o StatementOutput) CreateSql() pulumi.StringOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringOutput { return v.CreateSql }).(pulumi.StringOutput)
+}
+
+// Optional database the statements run in
+func (o StatementOutput) Database() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringPtrOutput { return v.Database }).(pulumi.StringPtrOutput)
+}
+
+// Optional statement run on delete. Without it deleting the resource only removes it from the stack.
+func (o StatementOutput) DeleteSql() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringPtrOutput { return v.DeleteSql }).(pulumi.StringPtrOutput)
+}
+
+// Optional namespace the statements run in
+func (o StatementOutput) Namespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringPtrOutput { return v.Namespace }).(pulumi.StringPtrOutput)
+}
+
+// Optional role the statements execute as
+func (o StatementOutput) Owner() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringPtrOutput { return v.Owner }).(pulumi.StringPtrOutput)
+}
+
+// Rows returned by readSql, keyed by lower-cased column name. Empty when readSql is not set.
+func (o StatementOutput) ReadResult() pulumi.StringMapArrayOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringMapArrayOutput { return v.ReadResult }).(pulumi.StringMapArrayOutput)
+}
+
+// Optional query run after create/update and on refresh. Its rows become the readResult output; no rows means the object no longer exists.
+func (o StatementOutput) ReadSql() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringPtrOutput { return v.ReadSql }).(pulumi.StringPtrOutput)
+}
+
+// Optional default store for the statements
+func (o StatementOutput) Store() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringPtrOutput { return v.Store }).(pulumi.StringPtrOutput)
+}
+
+// Optional statement run when createSql changes, instead of replacing the resource
+func (o StatementOutput) UpdateSql() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Statement) pulumi.StringPtrOutput { return v.UpdateSql }).(pulumi.StringPtrOutput)
+}
+
+type StatementArrayOutput struct{ *pulumi.OutputState }
+
+func (StatementArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Statement)(nil)).Elem()
+}
+
+func (o StatementArrayOutput) ToStatementArrayOutput() StatementArrayOutput {
+	return o
+}
+
+func (o StatementArrayOutput) ToStatementArrayOutputWithContext(ctx context.Context) StatementArrayOutput {
+	return o
+}
+
+func (o StatementArrayOutput) Index(i pulumi.IntInput) StatementOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Statement {
+		return vs[0].([]*Statement)[vs[1].(int)]
+	}).(StatementOutput)
+}
+
+type StatementMapOutput struct{ *pulumi.OutputState }
+
+func (StatementMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Statement)(nil)).Elem()
+}
+
+func (o StatementMapOutput) ToStatementMapOutput() StatementMapOutput {
+	return o
+}
+
+func (o StatementMapOutput) ToStatementMapOutputWithContext(ctx context.Context) StatementMapOutput {
+	return o
+}
+
+func (o StatementMapOutput) MapIndex(k pulumi.StringInput) StatementOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Statement {
+		return vs[0].(map[string]*Statement)[vs[1].(string)]
+	}).(StatementOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*StatementInput)(nil)).Elem(), &Statement{})
+	pulumi.RegisterInputType(reflect.TypeOf((*StatementArrayInput)(nil)).Elem(), StatementArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*StatementMapInput)(nil)).Elem(), StatementMap{})
+	pulumi.RegisterOutputType(StatementOutput{})
+	pulumi.RegisterOutputType(StatementArrayOutput{})
+	pulumi.RegisterOutputType(StatementMapOutput{})
+}
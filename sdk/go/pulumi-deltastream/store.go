@@ -16,9 +16,12 @@ import (
 type Store struct {
 	pulumi.CustomResourceState
 
-	CreatedAt pulumi.StringOutput      `pulumi:"createdAt"`
-	Kafka     KafkaInputsPtrOutput     `pulumi:"kafka"`
-	Name      pulumi.StringOutput      `pulumi:"name"`
+	CreatedAt pulumi.StringOutput `pulumi:"createdAt"`
+	// When true, deleting the store first terminates running queries that use relations backed by it and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy pulumi.BoolPtrOutput `pulumi:"forceDestroy"`
+	Kafka        KafkaInputsPtrOutput `pulumi:"kafka"`
+	Name         pulumi.StringOutput  `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role; changing it transfers ownership in place.
 	Owner     pulumi.StringOutput      `pulumi:"owner"`
 	Postgres  PostgresInputsPtrOutput  `pulumi:"postgres"`
 	Snowflake SnowflakeInputsPtrOutput `pulumi:"snowflake"`
@@ -72,8 +75,11 @@ func (StoreState) ElementType() reflect.Type {
 }
 
 type storeArgs struct {
-	Kafka     *KafkaInputs     `pulumi:"kafka"`
-	Name      string           `pulumi:"name"`
+	// When true, deleting the store first terminates running queries that use relations backed by it and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy *bool        `pulumi:"forceDestroy"`
+	Kafka        *KafkaInputs `pulumi:"kafka"`
+	Name         string       `pulumi:"name"`
+	// Optional owning role. When set, statements execute as this role; changing it transfers ownership in place.
 	Owner     *string          `pulumi:"owner"`
 	Postgres  *PostgresInputs  `pulumi:"postgres"`
 	Snowflake *SnowflakeInputs `pulumi:"snowflake"`
@@ -81,8 +87,11 @@ type storeArgs struct {
 
 // The set of arguments for constructing a Store resource.
 type StoreArgs struct {
-	Kafka     KafkaInputsPtrInput
-	Name      pulumi.StringInput
+	// When true, deleting the store first terminates running queries that use relations backed by it and drops those relations. When false (default), delete fails and lists them.
+	ForceDestroy pulumi.BoolPtrInput
+	Kafka        KafkaInputsPtrInput
+	Name         pulumi.StringInput
+	// Optional owning role. When set, statements execute as this role; changing it transfers ownership in place.
 	Owner     pulumi.StringPtrInput
 	Postgres  PostgresInputsPtrInput
 	Snowflake SnowflakeInputsPtrInput
@@ -179,6 +188,11 @@ func (o StoreOutput) CreatedAt() pulumi.StringOutput {
 	return o.ApplyT(func(v *Store) pulumi.StringOutput { return v.CreatedAt }).(pulumi.StringOutput)
 }
 
+// When true, deleting the store first terminates running queries that use relations backed by it and drops those relations. When false (default), delete fails and lists them.
+func (o StoreOutput) ForceDestroy() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Store) pulumi.BoolPtrOutput { return v.ForceDestroy }).(pulumi.BoolPtrOutput)
+}
+
 func (o StoreOutput) Kafka() KafkaInputsPtrOutput {
 	return o.ApplyT(func(v *Store) KafkaInputsPtrOutput { return v.Kafka }).(KafkaInputsPtrOutput)
 }
@@ -187,6 +201,7 @@ func (o StoreOutput) Name() pulumi.StringOutput {
 	return o.ApplyT(func(v *Store) pulumi.StringOutput { return v.Name }).(pulumi.StringOutput)
 }
 
+// Optional owning role. When set, statements execute as this role; changing it transfers ownership in place.
 func (o StoreOutput) Owner() pulumi.StringOutput {
 	return o.ApplyT(func(v *Store) pulumi.StringOutput { return v.Owner }).(pulumi.StringOutput)
 }